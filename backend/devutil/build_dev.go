@@ -0,0 +1,7 @@
+//go:build dev
+// +build dev
+
+package devutil
+
+// Dev is true in dev builds.
+const Dev = true
@@ -0,0 +1,7 @@
+//go:build !dev
+// +build !dev
+
+package devutil
+
+// Dev is false in prod builds.
+const Dev = false
@@ -16,6 +16,7 @@ func EnableCORS(r chi.Router) {
 		AllowedOrigins:   allowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
 		AllowedHeaders:   []string{"*"},
+		ExposedHeaders:   []string{"ETag"},
 		AllowCredentials: true,
 	}))
 }
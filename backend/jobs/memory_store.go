@@ -0,0 +1,57 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps locks and history in memory. It only elects a leader
+// among schedulers of one process, so it suits tests and single replicas.
+type MemoryStore struct {
+	mu    sync.Mutex
+	locks map[string]memoryLock
+	runs  map[string][]Run
+	limit int
+}
+
+type memoryLock struct {
+	owner string
+	until time.Time
+}
+
+// NewMemoryStore returns a store keeping the last limit runs of each job
+func NewMemoryStore(limit int) *MemoryStore {
+	return &MemoryStore{locks: make(map[string]memoryLock), runs: make(map[string][]Run), limit: limit}
+}
+
+func (s *MemoryStore) Acquire(ctx context.Context, job, owner string, until time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lock, ok := s.locks[job]; ok && lock.owner != owner && lock.until.After(time.Now()) {
+		return false, nil
+	}
+	s.locks[job] = memoryLock{owner: owner, until: until}
+	return true, nil
+}
+
+func (s *MemoryStore) RecordRun(ctx context.Context, run Run) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs := append([]Run{run}, s.runs[run.Job]...)
+	if len(runs) > s.limit {
+		runs = runs[:s.limit]
+	}
+	s.runs[run.Job] = runs
+	return nil
+}
+
+func (s *MemoryStore) Runs(ctx context.Context, job string, limit int) ([]Run, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	runs := s.runs[job]
+	if len(runs) > limit {
+		runs = runs[:limit]
+	}
+	return append([]Run(nil), runs...), nil
+}
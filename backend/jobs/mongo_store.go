@@ -0,0 +1,93 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoStore keeps locks in the job_locks collection and history in job_runs,
+// where runs expire after the retention
+type MongoStore struct {
+	locks *mongo.Collection
+	runs  *mongo.Collection
+}
+
+type mongoLock struct {
+	Job   string    `bson:"_id"`
+	Owner string    `bson:"owner"`
+	Until time.Time `bson:"until"`
+}
+
+type mongoRun struct {
+	Job        string    `bson:"job"`
+	Owner      string    `bson:"owner"`
+	StartedAt  time.Time `bson:"startedAt"`
+	FinishedAt time.Time `bson:"finishedAt"`
+	Error      string    `bson:"error,omitempty"`
+}
+
+func NewMongoStore(ctx context.Context, db *mongo.Database, retention time.Duration) (*MongoStore, error) {
+	s := &MongoStore{locks: db.Collection("job_locks"), runs: db.Collection("job_runs")}
+
+	_, err := s.runs.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "job", Value: 1}, {Key: "startedAt", Value: -1}}},
+		{
+			Keys:    bson.D{{Key: "finishedAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(retention.Seconds())),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create job run indexes: %w", err)
+	}
+	return s, nil
+}
+
+func (s *MongoStore) Acquire(ctx context.Context, job, owner string, until time.Time) (bool, error) {
+	filter := bson.M{
+		"_id": job,
+		"$or": []bson.M{{"until": bson.M{"$lte": time.Now()}}, {"owner": owner}},
+	}
+	update := bson.M{"$set": bson.M{"owner": owner, "until": until}}
+	// A lock held by someone else doesn't match the filter, so the upsert
+	// tries to insert a second document with the same _id and fails
+	_, err := s.locks.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock of %s: %w", job, err)
+	}
+	return true, nil
+}
+
+func (s *MongoStore) RecordRun(ctx context.Context, run Run) error {
+	_, err := s.runs.InsertOne(ctx, mongoRun(run))
+	if err != nil {
+		return fmt.Errorf("failed to record run of %s: %w", run.Job, err)
+	}
+	return nil
+}
+
+func (s *MongoStore) Runs(ctx context.Context, job string, limit int) ([]Run, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "startedAt", Value: -1}}).SetLimit(int64(limit))
+	cursor, err := s.runs.Find(ctx, bson.M{"job": job}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs of %s: %w", job, err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []mongoRun
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode runs of %s: %w", job, err)
+	}
+	runs := make([]Run, 0, len(docs))
+	for _, doc := range docs {
+		runs = append(runs, Run(doc))
+	}
+	return runs, nil
+}
@@ -0,0 +1,90 @@
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// PostgresStore keeps locks in the job_locks table and history in job_runs,
+// where runs older than the retention are deleted as new ones are recorded.
+// It only uses database/sql; the service registers the driver.
+type PostgresStore struct {
+	db        *sql.DB
+	retention time.Duration
+}
+
+func NewPostgresStore(ctx context.Context, db *sql.DB, retention time.Duration) (*PostgresStore, error) {
+	s := &PostgresStore{db: db, retention: retention}
+
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS job_locks (
+			job TEXT PRIMARY KEY,
+			owner TEXT NOT NULL,
+			until TIMESTAMPTZ NOT NULL
+		)`,
+		`CREATE TABLE IF NOT EXISTS job_runs (
+			id BIGSERIAL PRIMARY KEY,
+			job TEXT NOT NULL,
+			owner TEXT NOT NULL,
+			started_at TIMESTAMPTZ NOT NULL,
+			finished_at TIMESTAMPTZ NOT NULL,
+			error TEXT NOT NULL DEFAULT ''
+		)`,
+		`CREATE INDEX IF NOT EXISTS job_runs_job_started_at_idx ON job_runs (job, started_at DESC)`,
+	}
+	for _, stmt := range statements {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("failed to migrate job tables: %w", err)
+		}
+	}
+	return s, nil
+}
+
+func (s *PostgresStore) Acquire(ctx context.Context, job, owner string, until time.Time) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `INSERT INTO job_locks (job, owner, until) VALUES ($1, $2, $3)
+		ON CONFLICT (job) DO UPDATE SET owner = EXCLUDED.owner, until = EXCLUDED.until
+		WHERE job_locks.until <= $4 OR job_locks.owner = EXCLUDED.owner`,
+		job, owner, until, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock of %s: %w", job, err)
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lock of %s: %w", job, err)
+	}
+	return n == 1, nil
+}
+
+func (s *PostgresStore) RecordRun(ctx context.Context, run Run) error {
+	_, err := s.db.ExecContext(ctx, `INSERT INTO job_runs (job, owner, started_at, finished_at, error) VALUES ($1, $2, $3, $4, $5)`,
+		run.Job, run.Owner, run.StartedAt, run.FinishedAt, run.Error)
+	if err != nil {
+		return fmt.Errorf("failed to record run of %s: %w", run.Job, err)
+	}
+	_, err = s.db.ExecContext(ctx, `DELETE FROM job_runs WHERE job = $1 AND started_at < $2`, run.Job, time.Now().Add(-s.retention))
+	if err != nil {
+		return fmt.Errorf("failed to prune runs of %s: %w", run.Job, err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) Runs(ctx context.Context, job string, limit int) ([]Run, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT job, owner, started_at, finished_at, error FROM job_runs
+		WHERE job = $1 ORDER BY started_at DESC LIMIT $2`, job, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list runs of %s: %w", job, err)
+	}
+	defer rows.Close()
+
+	var runs []Run
+	for rows.Next() {
+		var run Run
+		if err := rows.Scan(&run.Job, &run.Owner, &run.StartedAt, &run.FinishedAt, &run.Error); err != nil {
+			return nil, fmt.Errorf("failed to scan run of %s: %w", job, err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
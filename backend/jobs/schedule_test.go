@@ -0,0 +1,76 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	s := Every(time.Hour)
+	from := time.Date(2026, 3, 1, 10, 20, 0, 0, time.UTC)
+	if next := s.Next(from); !next.Equal(time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the next full hour, got %v", next)
+	}
+	if next := s.Next(time.Date(2026, 3, 1, 11, 0, 0, 0, time.UTC)); !next.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the run after a due one, got %v", next)
+	}
+}
+
+func TestParseCron(t *testing.T) {
+	from := time.Date(2026, 3, 1, 10, 20, 30, 0, time.UTC) // a Sunday
+
+	tests := []struct {
+		expr     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2026, 3, 1, 10, 21, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{"0 9 * * *", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)},
+		{"30 8-18/2 * * *", time.Date(2026, 3, 1, 10, 30, 0, 0, time.UTC)},
+		{"0 0 * * 1-5", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * * 7", time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 15 * 3", time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		s, err := ParseCron(tt.expr)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.expr, err)
+			continue
+		}
+		if next := s.Next(from); !next.Equal(tt.expected) {
+			t.Errorf("%q: expected %v, got %v", tt.expr, tt.expected, next)
+		}
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *"} {
+		if _, err := ParseCron(expr); err == nil {
+			t.Errorf("%q: expected an error", expr)
+		}
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	s, err := ParseSchedule("30m")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := s.(interval); !ok {
+		t.Errorf("Expected an interval, got %T", s)
+	}
+	if s, err = ParseSchedule("0 9 * * *"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, ok := s.(*cronSchedule); !ok {
+		t.Errorf("Expected a cron schedule, got %T", s)
+	}
+	if _, err := ParseSchedule("-1h"); err == nil {
+		t.Error("Expected an error for a negative interval")
+	}
+}
@@ -0,0 +1,226 @@
+// Package jobs runs periodic background work of the backend services. Every
+// replica runs the same scheduler; before each run a replica takes the job's
+// lock in the shared store until the next run is due, so only one replica
+// runs a job at a time, and records the run in the store's history.
+package jobs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// recordTimeout bounds writing a run to the history, which also happens after
+// the run was cancelled
+const recordTimeout = 5 * time.Second
+
+// Job is a named piece of periodic work
+type Job struct {
+	Name     string
+	Schedule Schedule
+	// Timeout bounds a run; by default it may last until the next one is due
+	Timeout time.Duration
+	// FailuresOnly keeps successful runs out of the history, for jobs that
+	// run every few seconds
+	FailuresOnly bool
+	Run          func(ctx context.Context) error
+}
+
+// Run is an entry of the job run history
+type Run struct {
+	Job        string    `json:"job"`
+	Owner      string    `json:"owner"`
+	StartedAt  time.Time `json:"startedAt"`
+	FinishedAt time.Time `json:"finishedAt"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// Store keeps job locks and run history shared by all replicas
+type Store interface {
+	// Acquire takes the lock of a job for owner until the given time. It
+	// succeeds when the lock is free, expired or already held by owner.
+	Acquire(ctx context.Context, job, owner string, until time.Time) (bool, error)
+	RecordRun(ctx context.Context, run Run) error
+	// Runs lists the latest runs of a job, newest first
+	Runs(ctx context.Context, job string, limit int) ([]Run, error)
+}
+
+// Scheduler runs jobs on their schedules until stopped
+type Scheduler struct {
+	store Store
+	owner string
+	now   func() time.Time
+
+	mu      sync.Mutex
+	jobs    []Job
+	started bool
+	// stopLoops stops scheduling new runs, cancelRuns cancels running ones
+	stopLoops  context.CancelFunc
+	cancelRuns context.CancelFunc
+	wg         sync.WaitGroup
+}
+
+// New returns a scheduler storing locks and history in store
+func New(store Store) *Scheduler {
+	return &Scheduler{store: store, owner: newOwnerID(), now: time.Now}
+}
+
+// newOwnerID identifies this replica in locks and history
+func newOwnerID() string {
+	host, err := os.Hostname()
+	if err != nil {
+		host = "unknown"
+	}
+	b := make([]byte, 4)
+	rand.Read(b)
+	return host + "-" + hex.EncodeToString(b)
+}
+
+// Add registers a job; jobs can only be added before Start
+func (s *Scheduler) Add(job Job) error {
+	if job.Name == "" || job.Schedule == nil || job.Run == nil {
+		return errors.New("jobs: job needs a name, a schedule and a run function")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return fmt.Errorf("jobs: cannot add %s after start", job.Name)
+	}
+	for _, j := range s.jobs {
+		if j.Name == job.Name {
+			return fmt.Errorf("jobs: duplicate job %s", job.Name)
+		}
+	}
+	s.jobs = append(s.jobs, job)
+	return nil
+}
+
+// Start runs every job on its schedule until Stop or until ctx is done
+func (s *Scheduler) Start(ctx context.Context) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.started {
+		return
+	}
+	s.started = true
+
+	loopCtx, stopLoops := context.WithCancel(ctx)
+	runCtx, cancelRuns := context.WithCancel(ctx)
+	s.stopLoops, s.cancelRuns = stopLoops, cancelRuns
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(loopCtx, runCtx, job)
+	}
+	slog.Info("job scheduler started", "owner", s.owner, "jobs", len(s.jobs))
+}
+
+// Stop stops scheduling runs and waits for running ones to finish. When ctx
+// is done first they are cancelled, and Stop still waits for them to return.
+func (s *Scheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.started {
+		s.mu.Unlock()
+		return nil
+	}
+	s.stopLoops()
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cancelRuns()
+		slog.Info("job scheduler stopped", "owner", s.owner)
+		return nil
+	case <-ctx.Done():
+		s.cancelRuns()
+		<-done
+		slog.Warn("job scheduler stopped, cancelled running jobs", "owner", s.owner)
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) loop(loopCtx, runCtx context.Context, job Job) {
+	defer s.wg.Done()
+
+	next := job.Schedule.Next(s.now())
+	for !next.IsZero() {
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-loopCtx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		following := job.Schedule.Next(next)
+		s.runOnce(runCtx, job, following)
+		next = job.Schedule.Next(s.now())
+	}
+	slog.Warn("job schedule has no further runs", "job", job.Name)
+}
+
+// runOnce runs a job if this replica gets its lock until the following run
+func (s *Scheduler) runOnce(ctx context.Context, job Job, following time.Time) {
+	acquired, err := s.store.Acquire(ctx, job.Name, s.owner, following)
+	if err != nil {
+		slog.Error("failed to acquire job lock", "job", job.Name, "error", err)
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	timeout := job.Timeout
+	if timeout <= 0 {
+		timeout = time.Until(following)
+	}
+	runCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	run := Run{Job: job.Name, Owner: s.owner, StartedAt: s.now()}
+	err = safeRun(runCtx, job.Run)
+	run.FinishedAt = s.now()
+	if err != nil {
+		run.Error = err.Error()
+	}
+	slog.Log(ctx, runLevel(err), "job run finished",
+		"job", job.Name, "duration_ms", float64(run.FinishedAt.Sub(run.StartedAt).Microseconds())/1000, "error", run.Error)
+	if err == nil && job.FailuresOnly {
+		return
+	}
+
+	recordCtx, cancelRecord := context.WithTimeout(context.WithoutCancel(ctx), recordTimeout)
+	defer cancelRecord()
+	if err := s.store.RecordRun(recordCtx, run); err != nil {
+		slog.Error("failed to record job run", "job", job.Name, "error", err)
+	}
+}
+
+func runLevel(err error) slog.Level {
+	if err != nil {
+		return slog.LevelError
+	}
+	return slog.LevelDebug
+}
+
+// safeRun keeps a panicking job from taking the service down
+func safeRun(ctx context.Context, run func(context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return run(ctx)
+}
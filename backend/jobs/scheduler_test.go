@@ -0,0 +1,183 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSchedulerRunsEachSlotOnce(t *testing.T) {
+	const step = 50 * time.Millisecond
+	store := NewMemoryStore(100)
+
+	var mu sync.Mutex
+	slots := make(map[time.Time]int)
+	job := Job{
+		Name:     "count",
+		Schedule: Every(step),
+		Run: func(ctx context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			slots[time.Now().Truncate(step)]++
+			return nil
+		},
+	}
+
+	replicas := []*Scheduler{New(store), New(store)}
+	for _, s := range replicas {
+		if err := s.Add(job); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		s.Start(context.Background())
+	}
+	time.Sleep(6 * step)
+	for _, s := range replicas {
+		if err := s.Stop(context.Background()); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(slots) < 3 {
+		t.Errorf("Expected at least 3 runs, got %d", len(slots))
+	}
+	for slot, n := range slots {
+		if n != 1 {
+			t.Errorf("Expected one run at %v, got %d", slot, n)
+		}
+	}
+
+	runs, _ := store.Runs(context.Background(), "count", 100)
+	if len(runs) != len(slots) {
+		t.Errorf("Expected %d runs in history, got %d", len(slots), len(runs))
+	}
+}
+
+func TestSchedulerStopWaitsForRunningJobs(t *testing.T) {
+	store := NewMemoryStore(10)
+	s := New(store)
+	started := make(chan struct{})
+	s.Add(Job{
+		Name:     "slow",
+		Schedule: Every(20 * time.Millisecond),
+		Run: func(ctx context.Context) error {
+			close(started)
+			time.Sleep(50 * time.Millisecond)
+			return ctx.Err()
+		},
+		Timeout: time.Second,
+	})
+	s.Start(context.Background())
+	<-started
+
+	if err := s.Stop(context.Background()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	runs, _ := store.Runs(context.Background(), "slow", 10)
+	if len(runs) != 1 || runs[0].Error != "" {
+		t.Errorf("Expected one finished run, got %+v", runs)
+	}
+}
+
+func TestSchedulerStopCancelsRunningJobs(t *testing.T) {
+	store := NewMemoryStore(10)
+	s := New(store)
+	started := make(chan struct{})
+	s.Add(Job{
+		Name:     "stuck",
+		Schedule: Every(20 * time.Millisecond),
+		Run: func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		Timeout: time.Minute,
+	})
+	s.Start(context.Background())
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := s.Stop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+	runs, _ := store.Runs(context.Background(), "stuck", 10)
+	if len(runs) != 1 || runs[0].Error != context.Canceled.Error() {
+		t.Errorf("Expected one cancelled run, got %+v", runs)
+	}
+}
+
+func TestSchedulerAdd(t *testing.T) {
+	s := New(NewMemoryStore(10))
+	job := Job{Name: "a", Schedule: Every(time.Hour), Run: func(context.Context) error { return nil }}
+	if err := s.Add(job); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := s.Add(job); err == nil {
+		t.Error("Expected an error for a duplicate job")
+	}
+	if err := s.Add(Job{Name: "b"}); err == nil {
+		t.Error("Expected an error for a job without schedule")
+	}
+	s.Start(context.Background())
+	defer s.Stop(context.Background())
+	if err := s.Add(Job{Name: "c", Schedule: Every(time.Hour), Run: job.Run}); err == nil {
+		t.Error("Expected an error when adding after start")
+	}
+}
+
+func TestSchedulerFailuresOnly(t *testing.T) {
+	store := NewMemoryStore(10)
+	s := New(store)
+	fail := false
+	job := Job{
+		Name:         "frequent",
+		Schedule:     Every(time.Second),
+		FailuresOnly: true,
+		Run: func(context.Context) error {
+			if fail {
+				return errors.New("sink down")
+			}
+			return nil
+		},
+	}
+
+	s.runOnce(context.Background(), job, time.Now().Add(time.Second))
+	fail = true
+	s.runOnce(context.Background(), job, time.Now().Add(time.Second))
+
+	runs, _ := store.Runs(context.Background(), "frequent", 10)
+	if len(runs) != 1 || runs[0].Error != "sink down" {
+		t.Errorf("Expected only the failed run in history, got %+v", runs)
+	}
+}
+
+func TestMemoryStoreAcquire(t *testing.T) {
+	store := NewMemoryStore(10)
+	ctx := context.Background()
+	until := time.Now().Add(time.Hour)
+
+	if ok, _ := store.Acquire(ctx, "job", "a", until); !ok {
+		t.Error("Expected a free lock to be acquired")
+	}
+	if ok, _ := store.Acquire(ctx, "job", "b", until); ok {
+		t.Error("Expected a held lock not to be acquired")
+	}
+	if ok, _ := store.Acquire(ctx, "job", "a", until); !ok {
+		t.Error("Expected the holder to renew its lock")
+	}
+	store.Acquire(ctx, "job", "a", time.Now().Add(-time.Second))
+	if ok, _ := store.Acquire(ctx, "job", "b", until); !ok {
+		t.Error("Expected an expired lock to be acquired")
+	}
+}
+
+func TestSafeRunRecoversPanics(t *testing.T) {
+	err := safeRun(context.Background(), func(context.Context) error { panic("boom") })
+	if err == nil || err.Error() != "panic: boom" {
+		t.Errorf("Expected the panic as an error, got %v", err)
+	}
+}
@@ -2,3 +2,6 @@ module github.com/theseems/wili/backend/services/telegram-bot
 
 go 1.24
 
+replace github.com/theseems/wili/backend/telemetry => ../../telemetry
+
+require github.com/theseems/wili/backend/telemetry v0.0.0-00010101000000-000000000000
@@ -7,14 +7,19 @@ import (
 	"html"
 	"io"
 	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"path"
 	"regexp"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/theseems/wili/backend/telemetry"
 )
 
 type wishlistResponse struct {
@@ -116,6 +121,7 @@ type config struct {
 	bindAddr     string
 	webhookPath  string
 	webhookToken string
+	notifyToken  string
 }
 
 func mustEnv(key string) string {
@@ -141,6 +147,7 @@ func loadConfig() config {
 		bindAddr:     envOrDefault("BIND_ADDR", ":8080"),
 		webhookPath:  envOrDefault("WEBHOOK_PATH", "webhook"),
 		webhookToken: envOrDefault("WEBHOOK_SECRET_TOKEN", ""),
+		notifyToken:  envOrDefault("NOTIFY_TOKEN", ""),
 	}
 }
 
@@ -171,11 +178,44 @@ func newBot(cfg config) *bot {
 	return &bot{
 		cfg: cfg,
 		client: &http.Client{
-			Timeout: 8 * time.Second,
+			Timeout:   8 * time.Second,
+			Transport: telegramTransport{traced: &telemetry.Transport{}, direct: http.DefaultTransport},
 		},
 	}
 }
 
+// telegramTransport records calls to the Telegram Bot API by method, such as
+// sendMessage, in metrics and a span of its own. Their URLs hold the bot token,
+// so they skip the traced transport, which records URLs; requests to our
+// services go through it.
+type telegramTransport struct {
+	traced http.RoundTripper
+	direct http.RoundTripper
+}
+
+func (t telegramTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != "api.telegram.org" {
+		return t.traced.RoundTrip(req)
+	}
+	// The path is /bot<token>/<method>; only the method is recorded
+	method := path.Base(req.URL.Path)
+	start := time.Now()
+	ctx, span := telemetry.StartClientSpan(req.Context(), "telegram "+method)
+	resp, err := t.direct.RoundTrip(req.WithContext(ctx))
+
+	failure := err
+	if err == nil && resp.StatusCode >= 400 {
+		failure = fmt.Errorf("telegram %s returned status %d", method, resp.StatusCode)
+	}
+	telemetry.EndSpan(span, failure)
+	outcome := "ok"
+	if failure != nil {
+		outcome = "error"
+	}
+	telemetry.ObserveClientCall("telegram", method, outcome, start)
+	return resp, err
+}
+
 func normalizeLang(code string) string {
 	c := strings.ToLower(strings.TrimSpace(code))
 	if strings.HasPrefix(c, "en") {
@@ -207,6 +247,17 @@ const (
 	keyWebAuthButton       = "webauth.button"
 	keyWebAuthNotLinked    = "webauth.not_linked"
 	keyWebAuthOpenMiniApp  = "webauth.open_miniapp"
+
+	keyNotifyBooked           = "notify.booked"
+	keyNotifyBookedSurprise   = "notify.booked.surprise"
+	keyNotifyUnbooked         = "notify.unbooked"
+	keyNotifyUnbookedSurprise = "notify.unbooked.surprise"
+	keyNotifyBooker           = "notify.booker"
+	keyNotifyMessage          = "notify.message"
+	keyNotifyEventSoon        = "notify.event_soon"
+	keyNotifyViews            = "notify.views"
+	keyNotifyGiftReminder     = "notify.gift_reminder"
+	keyNotifyGiftReminderAny  = "notify.gift_reminder.any"
 )
 
 var botDict = map[string]map[string]string{
@@ -237,6 +288,17 @@ var botDict = map[string]map[string]string{
 		keyWebAuthButton:       "Войти на сайт",
 		keyWebAuthNotLinked:    "Чтобы войти на сайт через Telegram, сначала откройте Mini App и создайте аккаунт.",
 		keyWebAuthOpenMiniApp:  "Открыть Wili",
+
+		keyNotifyBooked:           "🎁 В вишлисте <b>«%s»</b> забронировали <b>%s</b>.",
+		keyNotifyBookedSurprise:   "🎁 В вишлисте <b>«%s»</b> забронировали подарок. Какой — пусть будет сюрпризом!",
+		keyNotifyUnbooked:         "↩️ В вишлисте <b>«%s»</b> сняли бронь с <b>%s</b>.",
+		keyNotifyUnbookedSurprise: "↩️ В вишлисте <b>«%s»</b> сняли бронь с одного из подарков.",
+		keyNotifyBooker:           "Кто: %s",
+		keyNotifyMessage:          "Сообщение: %s",
+		keyNotifyEventSoon:        "📅 До <b>«%s»</b> осталось %s. Поделитесь вишлистом, чтобы гости знали, что подарить.",
+		keyNotifyViews:            "Вишлист открывали: %d",
+		keyNotifyGiftReminder:     "⏰ До <b>«%s»</b> осталось %s. Вы забронировали <b>%s</b> — самое время купить подарок. Если не получается, отмените бронь.",
+		keyNotifyGiftReminderAny:  "⏰ До <b>«%s»</b> осталось %s. Не забудьте про подарок, который вы забронировали.",
 	},
 	"en": {
 		keyMiniAppEntryText:    "Open Wili in Telegram Mini App.",
@@ -265,6 +327,17 @@ var botDict = map[string]map[string]string{
 		keyWebAuthButton:       "Log in to website",
 		keyWebAuthNotLinked:    "To log in via Telegram, first open the Mini App and create an account.",
 		keyWebAuthOpenMiniApp:  "Open Wili",
+
+		keyNotifyBooked:           "🎁 <b>%[2]s</b> from <b>«%[1]s»</b> has been booked.",
+		keyNotifyBookedSurprise:   "🎁 A gift from <b>«%s»</b> has been booked. Which one is a surprise!",
+		keyNotifyUnbooked:         "↩️ <b>%[2]s</b> from <b>«%[1]s»</b> is no longer booked.",
+		keyNotifyUnbookedSurprise: "↩️ A gift from <b>«%s»</b> is no longer booked.",
+		keyNotifyBooker:           "By: %s",
+		keyNotifyMessage:          "Message: %s",
+		keyNotifyEventSoon:        "📅 <b>«%s»</b> is in %s. Share the wishlist so guests know what to bring.",
+		keyNotifyViews:            "Times opened: %d",
+		keyNotifyGiftReminder:     "⏰ <b>«%s»</b> is in %s. You booked <b>%s</b> — time to get it. Can't make it? Cancel the booking.",
+		keyNotifyGiftReminderAny:  "⏰ <b>«%s»</b> is in %s. Don't forget the gift you booked.",
 	},
 }
 
@@ -306,20 +379,20 @@ func (b *bot) handleWebhook(w http.ResponseWriter, r *http.Request) {
 	var upd telegramUpdate
 	if err := json.NewDecoder(r.Body).Decode(&upd); err != nil {
 		http.Error(w, "bad request", http.StatusBadRequest)
-		log.Printf("webhook decode failed: %v", err)
+		slog.InfoContext(ctx, "webhook decode failed", "error", err)
 		return
 	}
 
 	if upd.InlineQuery != nil {
 		if err := b.handleInlineQuery(ctx, upd.InlineQuery); err != nil {
-			log.Printf("inline query failed: id=%s err=%v", upd.InlineQuery.ID, err)
+			slog.ErrorContext(ctx, "inline query failed", "inline_query_id", upd.InlineQuery.ID, "error", err)
 		}
 		w.WriteHeader(http.StatusOK)
 		return
 	}
 
 	if upd.Message == nil {
-		log.Printf("webhook ignored: no message or inline query")
+		slog.DebugContext(ctx, "webhook ignored: no message or inline query")
 		w.WriteHeader(http.StatusOK)
 		return
 	}
@@ -327,12 +400,12 @@ func (b *bot) handleWebhook(w http.ResponseWriter, r *http.Request) {
 	startParam := extractStartParam(upd.Message)
 	if startParam == "" {
 		if strings.HasPrefix(strings.TrimSpace(upd.Message.Text), "/start") {
-			log.Printf("webhook start: chat=%d start_param=<empty>", upd.Message.Chat.ID)
+			slog.InfoContext(ctx, "webhook start", "chat_id", upd.Message.Chat.ID)
 			if err := b.sendMiniAppEntry(ctx, upd.Message.Chat.ID, upd.Message.From.LanguageCode); err != nil {
-				log.Printf("send miniapp entry failed: chat=%d err=%v", upd.Message.Chat.ID, err)
+				slog.ErrorContext(ctx, "send miniapp entry failed", "chat_id", upd.Message.Chat.ID, "error", err)
 			}
 		} else {
-			log.Printf("webhook ignored: no start param (chat=%d)", upd.Message.Chat.ID)
+			slog.DebugContext(ctx, "webhook ignored: no start param", "chat_id", upd.Message.Chat.ID)
 		}
 		w.WriteHeader(http.StatusOK)
 		return
@@ -340,18 +413,18 @@ func (b *bot) handleWebhook(w http.ResponseWriter, r *http.Request) {
 
 	shareListID := parseShareListID(startParam)
 	if shareListID != "" {
-		log.Printf("webhook share: chat=%d start_param=%s list=%s", upd.Message.Chat.ID, startParam, shareListID)
+		slog.InfoContext(ctx, "webhook share", "chat_id", upd.Message.Chat.ID, "start_param", startParam, "wishlist_id", shareListID)
 		if err := b.sendSharePrompt(ctx, upd.Message.Chat.ID, shareListID, upd.Message.From.LanguageCode); err != nil {
-			log.Printf("send share prompt failed: chat=%d list=%s err=%v", upd.Message.Chat.ID, shareListID, err)
+			slog.ErrorContext(ctx, "send share prompt failed", "chat_id", upd.Message.Chat.ID, "wishlist_id", shareListID, "error", err)
 		}
 		w.WriteHeader(http.StatusOK)
 		return
 	}
 
 	if state, ok := parseWebAuth(startParam); ok {
-		log.Printf("webhook webauth: chat=%d state=%s", upd.Message.Chat.ID, state)
+		slog.InfoContext(ctx, "webhook webauth", "chat_id", upd.Message.Chat.ID, "state", state)
 		if err := b.sendWebAuth(ctx, upd.Message.Chat.ID, upd.Message.From.ID, state, upd.Message.From.LanguageCode); err != nil {
-			log.Printf("send webauth failed: chat=%d err=%v", upd.Message.Chat.ID, err)
+			slog.ErrorContext(ctx, "send webauth failed", "chat_id", upd.Message.Chat.ID, "error", err)
 		}
 		w.WriteHeader(http.StatusOK)
 		return
@@ -359,15 +432,15 @@ func (b *bot) handleWebhook(w http.ResponseWriter, r *http.Request) {
 
 	listID := parseListID(startParam)
 	if listID == "" {
-		log.Printf("webhook ignored: bad start param=%s (chat=%d)", startParam, upd.Message.Chat.ID)
+		slog.InfoContext(ctx, "webhook ignored: bad start param", "chat_id", upd.Message.Chat.ID, "start_param", startParam)
 		w.WriteHeader(http.StatusOK)
 		return
 	}
 
-	log.Printf("webhook start: chat=%d start_param=%s list=%s", upd.Message.Chat.ID, startParam, listID)
+	slog.InfoContext(ctx, "webhook start", "chat_id", upd.Message.Chat.ID, "start_param", startParam, "wishlist_id", listID)
 
 	if err := b.sendWishlistPreview(ctx, upd.Message.Chat.ID, listID, upd.Message.From.LanguageCode); err != nil {
-		log.Printf("send preview failed: chat=%d list=%s err=%v", upd.Message.Chat.ID, listID, err)
+		slog.ErrorContext(ctx, "send preview failed", "chat_id", upd.Message.Chat.ID, "wishlist_id", listID, "error", err)
 	}
 	w.WriteHeader(http.StatusOK)
 }
@@ -414,8 +487,11 @@ func esc(s string) string {
 	return html.EscapeString(s)
 }
 
-func (b *bot) fetchTelegramJWT(ctx context.Context, telegramID int64) (string, error) {
-	body := fmt.Sprintf(`{"telegramId":%d}`, telegramID)
+// fetchTelegramJWT gets a token of the user linked to telegramID. Tokens for
+// web login links start a session the user can see and revoke; the others are
+// for the bot's own calls.
+func (b *bot) fetchTelegramJWT(ctx context.Context, telegramID int64, webLogin bool) (string, error) {
+	body := fmt.Sprintf(`{"telegramId":%d,"webLogin":%t}`, telegramID, webLogin)
 	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/auth/telegram-bot", b.cfg.userAPIBase), strings.NewReader(body))
 	if err != nil {
 		return "", err
@@ -476,13 +552,13 @@ func (b *bot) handleInlineQuery(ctx context.Context, q *telegramInlineQuery) err
 		return nil
 	}
 
-	log.Printf("inline query: from=%d id=%s q=%q", q.From.ID, q.ID, q.Query)
+	slog.DebugContext(ctx, "inline query", "from", q.From.ID, "inline_query_id", q.ID, "query", q.Query)
 	lang := q.From.LanguageCode
 
 	queryText := strings.TrimSpace(q.Query)
 	listID := parseInlineQueryListID(queryText)
 	if listID == "" && queryText == "" {
-		jwt, err := b.fetchTelegramJWT(ctx, q.From.ID)
+		jwt, err := b.fetchTelegramJWT(ctx, q.From.ID, false)
 		if err != nil {
 			msg := tr(lang, "inline.my.notLinked")
 			card := map[string]interface{}{
@@ -506,7 +582,7 @@ func (b *bot) handleInlineQuery(ctx context.Context, q *telegramInlineQuery) err
 
 		lists, err := b.fetchMyWishlists(ctx, jwt)
 		if err != nil {
-			log.Printf("inline query my wishlists failed: from=%d err=%v", q.From.ID, err)
+			slog.ErrorContext(ctx, "inline query my wishlists failed", "from", q.From.ID, "error", err)
 			return b.answerInlineQuery(ctx, q.ID, []interface{}{})
 		}
 		if lists == nil || len(lists.Wishlists) == 0 {
@@ -581,7 +657,7 @@ func (b *bot) handleInlineQuery(ctx context.Context, q *telegramInlineQuery) err
 
 	wl, err := b.fetchWishlist(ctx, listID)
 	if err != nil {
-		log.Printf("inline query wishlist fetch failed: list=%s err=%v", listID, err)
+		slog.ErrorContext(ctx, "inline query wishlist fetch failed", "wishlist_id", listID, "error", err)
 		errCard := map[string]interface{}{
 			"type":        "article",
 			"id":          "error",
@@ -596,7 +672,7 @@ func (b *bot) handleInlineQuery(ctx context.Context, q *telegramInlineQuery) err
 
 	webAppURL := b.miniAppDeepLink(listID)
 	fallbackURL := fmt.Sprintf("%s/wishlists/%s", b.cfg.webFallback, listID)
-	log.Printf("inline query resolved: id=%s list=%s url=%s", q.ID, listID, webAppURL)
+	slog.DebugContext(ctx, "inline query resolved", "inline_query_id", q.ID, "wishlist_id", listID, "url", webAppURL)
 
 	baseDescription := tr(lang, keyInlineBaseDesc)
 	wlDesc := strings.TrimSpace(func() string {
@@ -663,7 +739,7 @@ func (b *bot) answerInlineQuery(ctx context.Context, inlineQueryID string, resul
 		bb, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("telegram answerInlineQuery status %d body=%s", resp.StatusCode, strings.TrimSpace(string(bb)))
 	}
-	log.Printf("inline query answered: id=%s results=%d", inlineQueryID, len(results))
+	slog.DebugContext(ctx, "inline query answered", "inline_query_id", inlineQueryID, "results", len(results))
 	return nil
 }
 
@@ -806,7 +882,7 @@ func (b *bot) sendWishlistPreview(ctx context.Context, chatID int64, listID stri
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("telegram sendMessage status %d", resp.StatusCode)
 	}
-	log.Printf("preview sent: chat=%d list=%s status=%d", chatID, listID, resp.StatusCode)
+	slog.InfoContext(ctx, "preview sent", "chat_id", chatID, "wishlist_id", listID, "status", resp.StatusCode)
 	return nil
 }
 
@@ -861,9 +937,9 @@ func (b *bot) sendSharePrompt(ctx context.Context, chatID int64, listID string,
 }
 
 func (b *bot) sendWebAuth(ctx context.Context, chatID int64, telegramID int64, state string, lang string) error {
-	jwt, err := b.fetchTelegramJWT(ctx, telegramID)
+	jwt, err := b.fetchTelegramJWT(ctx, telegramID, true)
 	if err != nil {
-		log.Printf("webauth fetch JWT failed: telegram_id=%d err=%v", telegramID, err)
+		slog.ErrorContext(ctx, "webauth fetch JWT failed", "telegram_id", telegramID, "error", err)
 		text := tr(lang, keyWebAuthNotLinked)
 		msg := sendMessageRequest{
 			ChatID:    chatID,
@@ -928,7 +1004,7 @@ func (b *bot) sendWebAuth(ctx context.Context, chatID int64, telegramID int64, s
 		bb, _ := io.ReadAll(resp.Body)
 		return fmt.Errorf("telegram sendMessage status %d body=%s", resp.StatusCode, strings.TrimSpace(string(bb)))
 	}
-	log.Printf("webauth sent: chat=%d state=%s", chatID, state)
+	slog.InfoContext(ctx, "webauth sent", "chat_id", chatID, "state", state)
 	return nil
 }
 
@@ -937,19 +1013,45 @@ func main() {
 		log.Printf("warning: .env not found: %v", err)
 	}
 
+	telemetry.SetupLogging("telegram-bot")
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), "telegram-bot")
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
 	cfg := loadConfig()
 	b := newBot(cfg)
-	log.Printf("telegram-bot starting bind=%s webhook=/%s api=%s webapp=%s fallback=%s", cfg.bindAddr, cfg.webhookPath, cfg.apiBaseURL, cfg.webAppURL, cfg.webFallback)
+	slog.Info("telegram-bot starting", "bind", cfg.bindAddr, "api", cfg.apiBaseURL, "webapp", cfg.webAppURL, "fallback", cfg.webFallback)
 
+	// The bot has no dependency it could check cheaply; readiness only
+	// reports draining during shutdown
+	probes := telemetry.NewProbes()
 	mux := http.NewServeMux()
+	mux.Handle("/livez", probes.Live())
+	mux.Handle("/readyz", probes.Ready())
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
 		w.Write([]byte(`{"status":"ok"}`))
 	})
 	mux.HandleFunc("/"+strings.TrimPrefix(cfg.webhookPath, "/"), b.handleWebhook)
-
-	log.Printf("telegram-bot listening on %s", cfg.bindAddr)
-	if err := http.ListenAndServe(cfg.bindAddr, mux); err != nil {
-		log.Fatalf("server failed: %v", err)
+	mux.HandleFunc("/internal/notify", b.handleNotify)
+	mux.Handle("/debug/log-level", telemetry.LevelHandler(cfg.notifyToken))
+	if metricsAddr := envOrDefault("METRICS_ADDR", ""); metricsAddr != "" {
+		go telemetry.ServeMetrics(metricsAddr)
+	} else {
+		mux.Handle("/metrics", telemetry.MetricsHandler())
+	}
+
+	slog.Info("telegram-bot listening", "addr", cfg.bindAddr)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	handler := telemetry.RequestID(telemetry.Tracing(telemetry.AccessLog(telemetry.Metrics(mux))))
+	if err := telemetry.Serve(ctx, telemetry.NewServer(cfg.bindAddr, handler), probes); err != nil {
+		slog.Error("server stopped", "error", err)
+	}
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracing(flushCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
 	}
 }
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// userNotification is posted by the wishlist service when something happens
+// to an owner's wishlist or an event is coming up. Fields the owner asked not
+// to know are omitted.
+type userNotification struct {
+	UserID        string  `json:"userId"`
+	Type          string  `json:"type"`
+	WishlistID    string  `json:"wishlistId"`
+	WishlistTitle string  `json:"wishlistTitle"`
+	ItemName      *string `json:"itemName,omitempty"`
+	BookerName    *string `json:"bookerName,omitempty"`
+	Message       *string `json:"message,omitempty"`
+	DaysLeft      *int    `json:"daysLeft,omitempty"`
+	Views         *int64  `json:"views,omitempty"`
+}
+
+type telegramLink struct {
+	TelegramID   int64   `json:"telegramId"`
+	LanguageCode *string `json:"languageCode"`
+}
+
+// errTelegramUnreachable means the chat cannot receive messages (blocked bot, deleted account)
+var errTelegramUnreachable = fmt.Errorf("telegram chat unreachable")
+
+// handleNotify sends a notification of the wishlist service. That service
+// records which ones were delivered and does not post those again.
+func (b *bot) handleNotify(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	got := r.Header.Get("X-Wili-Notify-Token")
+	if b.cfg.notifyToken == "" || subtle.ConstantTimeCompare([]byte(got), []byte(b.cfg.notifyToken)) != 1 {
+		http.Error(w, "forbidden", http.StatusForbidden)
+		return
+	}
+
+	var n userNotification
+	if err := json.NewDecoder(r.Body).Decode(&n); err != nil || n.UserID == "" || n.WishlistID == "" {
+		http.Error(w, "bad request", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	link, err := b.fetchTelegramLink(ctx, n.UserID)
+	if err != nil {
+		slog.ErrorContext(ctx, "notify lookup failed", "user_id", n.UserID, "error", err)
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+		return
+	}
+	// 404 tells the wishlist service to reach the user another way
+	if link == nil {
+		slog.InfoContext(ctx, "notify skipped: no telegram linked", "user_id", n.UserID)
+		http.Error(w, "not linked", http.StatusNotFound)
+		return
+	}
+
+	lang := "ru"
+	if link.LanguageCode != nil {
+		lang = *link.LanguageCode
+	}
+	if err := b.sendNotification(ctx, link.TelegramID, n, lang); err != nil {
+		if err == errTelegramUnreachable {
+			slog.InfoContext(ctx, "notify skipped: chat unreachable", "user_id", n.UserID)
+			http.Error(w, "unreachable", http.StatusNotFound)
+			return
+		}
+		slog.ErrorContext(ctx, "notify send failed", "user_id", n.UserID, "error", err)
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+		return
+	}
+
+	slog.InfoContext(ctx, "notify sent", "user_id", n.UserID, "type", n.Type, "wishlist_id", n.WishlistID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// fetchTelegramLink returns nil when the user has no Telegram account linked
+func (b *bot) fetchTelegramLink(ctx context.Context, userID string) (*telegramLink, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/users/%s/telegram", b.cfg.userAPIBase, url.PathEscape(userID)), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Wili-Bot-Token", b.cfg.botToken)
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user-service telegram link status %d", resp.StatusCode)
+	}
+
+	var link telegramLink
+	if err := json.NewDecoder(resp.Body).Decode(&link); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+func daysText(lang string, n int) string {
+	if normalizeLang(lang) == "ru" {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return fmt.Sprintf("%d день", n)
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return fmt.Sprintf("%d дня", n)
+		default:
+			return fmt.Sprintf("%d дней", n)
+		}
+	}
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+func notificationText(n userNotification, lang string) string {
+	title := esc(n.WishlistTitle)
+	days := 0
+	if n.DaysLeft != nil {
+		days = *n.DaysLeft
+	}
+
+	var text string
+	switch {
+	case n.Type == "event_soon":
+		text = trf(lang, keyNotifyEventSoon, title, daysText(lang, days))
+		if n.Views != nil {
+			text += "\n" + trf(lang, keyNotifyViews, *n.Views)
+		}
+		return text
+	case n.Type == "gift_reminder" && n.ItemName != nil:
+		return trf(lang, keyNotifyGiftReminder, title, daysText(lang, days), esc(*n.ItemName))
+	case n.Type == "gift_reminder":
+		return trf(lang, keyNotifyGiftReminderAny, title, daysText(lang, days))
+	case n.Type == "item_booked" && n.ItemName != nil:
+		text = trf(lang, keyNotifyBooked, title, esc(*n.ItemName))
+	case n.Type == "item_booked":
+		text = trf(lang, keyNotifyBookedSurprise, title)
+	case n.ItemName != nil:
+		text = trf(lang, keyNotifyUnbooked, title, esc(*n.ItemName))
+	default:
+		text = trf(lang, keyNotifyUnbookedSurprise, title)
+	}
+
+	if n.BookerName != nil && strings.TrimSpace(*n.BookerName) != "" {
+		text += "\n" + trf(lang, keyNotifyBooker, esc(*n.BookerName))
+	}
+	if n.Message != nil && strings.TrimSpace(*n.Message) != "" {
+		text += "\n" + trf(lang, keyNotifyMessage, esc(*n.Message))
+	}
+	return text
+}
+
+func (b *bot) sendNotification(ctx context.Context, chatID int64, n userNotification, lang string) error {
+	msg := sendMessageRequest{
+		ChatID:    chatID,
+		Text:      notificationText(n, lang),
+		ParseMode: "HTML",
+		ReplyMarkup: &inlineKeyboardMarkup{
+			InlineKeyboard: [][]inlineKeyboardButton{
+				{
+					{Text: tr(lang, keyPreviewOpen), URL: b.miniAppDeepLink(n.WishlistID)},
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", b.cfg.botToken), strings.NewReader(string(body)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusForbidden {
+		return errTelegramUnreachable
+	}
+	if resp.StatusCode != http.StatusOK {
+		bb, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("telegram sendMessage status %d body=%s", resp.StatusCode, strings.TrimSpace(string(bb)))
+	}
+	return nil
+}
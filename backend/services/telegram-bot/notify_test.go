@@ -0,0 +1,195 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func strPtr(s string) *string { return &s }
+
+func intPtr(n int) *int { return &n }
+
+func int64Ptr(n int64) *int64 { return &n }
+
+func TestNotificationText(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        userNotification
+		lang     string
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "visible_booking",
+			n:        userNotification{Type: "item_booked", WishlistTitle: "Birthday", ItemName: strPtr("Kettle"), BookerName: strPtr("Anna"), Message: strPtr("Happy birthday!")},
+			lang:     "en",
+			contains: []string{"<b>Kettle</b> from <b>«Birthday»</b> has been booked", "By: Anna", "Message: Happy birthday!"},
+		},
+		{
+			name:     "anonymous_booking",
+			n:        userNotification{Type: "item_booked", WishlistTitle: "Birthday", ItemName: strPtr("Kettle")},
+			lang:     "en",
+			contains: []string{"<b>Kettle</b>"},
+			excludes: []string{"By:", "Message:"},
+		},
+		{
+			name:     "blank_booker",
+			n:        userNotification{Type: "item_booked", WishlistTitle: "Birthday", ItemName: strPtr("Kettle"), BookerName: strPtr("  "), Message: strPtr("")},
+			lang:     "en",
+			excludes: []string{"By:", "Message:"},
+		},
+		{
+			name:     "surprise_booking",
+			n:        userNotification{Type: "item_booked", WishlistTitle: "Birthday"},
+			lang:     "en",
+			contains: []string{"A gift from <b>«Birthday»</b> has been booked. Which one is a surprise!"},
+			excludes: []string{"By:"},
+		},
+		{
+			name:     "unbooked",
+			n:        userNotification{Type: "item_unbooked_by_token", WishlistTitle: "Birthday", ItemName: strPtr("Kettle")},
+			lang:     "ru",
+			contains: []string{"сняли бронь с <b>Kettle</b>"},
+		},
+		{
+			name:     "surprise_unbooked",
+			n:        userNotification{Type: "item_unbooked_by_token", WishlistTitle: "Birthday"},
+			lang:     "ru",
+			contains: []string{"сняли бронь с одного из подарков"},
+		},
+		{
+			name:     "escapes_html",
+			n:        userNotification{Type: "item_booked", WishlistTitle: "<b>Party</b>", ItemName: strPtr("Tea & cake"), BookerName: strPtr("<script>")},
+			lang:     "en",
+			contains: []string{"«&lt;b&gt;Party&lt;/b&gt;»", "Tea &amp; cake", "By: &lt;script&gt;"},
+			excludes: []string{"<script>"},
+		},
+		{
+			name:     "event_soon",
+			n:        userNotification{Type: "event_soon", WishlistTitle: "Birthday", DaysLeft: intPtr(7), Views: int64Ptr(3)},
+			lang:     "ru",
+			contains: []string{"осталось 7 дней", "Вишлист открывали: 3"},
+		},
+		{
+			name:     "gift_reminder",
+			n:        userNotification{Type: "gift_reminder", WishlistTitle: "Birthday", DaysLeft: intPtr(1), ItemName: strPtr("Kettle")},
+			lang:     "en",
+			contains: []string{"is in 1 day. You booked <b>Kettle</b>"},
+		},
+		{
+			name:     "gift_reminder_without_item",
+			n:        userNotification{Type: "gift_reminder", WishlistTitle: "Birthday", DaysLeft: intPtr(2)},
+			lang:     "en",
+			contains: []string{"is in 2 days. Don't forget the gift you booked."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text := notificationText(tt.n, tt.lang)
+			for _, want := range tt.contains {
+				if !strings.Contains(text, want) {
+					t.Errorf("Expected %q in %q", want, text)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(text, unwanted) {
+					t.Errorf("Expected no %q in %q", unwanted, text)
+				}
+			}
+		})
+	}
+}
+
+func TestDaysText(t *testing.T) {
+	tests := []struct {
+		lang string
+		n    int
+		want string
+	}{
+		{"ru", 1, "1 день"},
+		{"ru", 3, "3 дня"},
+		{"ru", 5, "5 дней"},
+		{"ru", 11, "11 дней"},
+		{"ru", 21, "21 день"},
+		{"ru", 22, "22 дня"},
+		{"en", 1, "1 day"},
+		{"en", 7, "7 days"},
+	}
+	for _, tt := range tests {
+		if got := daysText(tt.lang, tt.n); got != tt.want {
+			t.Errorf("daysText(%q, %d): expected %q, got %q", tt.lang, tt.n, tt.want, got)
+		}
+	}
+}
+
+// stubTransport answers requests of the bot with handler, whatever their host
+type stubTransport struct {
+	handler http.Handler
+}
+
+func (s stubTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	s.handler.ServeHTTP(rec, r)
+	return rec.Result(), nil
+}
+
+func TestHandleNotify(t *testing.T) {
+	var sent []sendMessageRequest
+	linked := true
+	b := &bot{
+		cfg: config{notifyToken: "secret", botToken: "bot-token", userAPIBase: "http://user"},
+		client: &http.Client{Transport: stubTransport{http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case r.URL.Host == "user" && r.URL.Path == "/users/owner-1/telegram":
+				if !linked {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				json.NewEncoder(w).Encode(telegramLink{TelegramID: 42, LanguageCode: strPtr("en")})
+			case r.URL.Host == "api.telegram.org" && strings.HasSuffix(r.URL.Path, "/sendMessage"):
+				var msg sendMessageRequest
+				body, _ := io.ReadAll(r.Body)
+				json.Unmarshal(body, &msg)
+				sent = append(sent, msg)
+				w.Write([]byte(`{"ok":true}`))
+			default:
+				w.WriteHeader(http.StatusTeapot)
+			}
+		})}},
+	}
+	notify := func(token, body string) int {
+		req := httptest.NewRequest(http.MethodPost, "/internal/notify", strings.NewReader(body))
+		req.Header.Set("X-Wili-Notify-Token", token)
+		rec := httptest.NewRecorder()
+		b.handleNotify(rec, req)
+		return rec.Code
+	}
+	surprise := `{"userId":"owner-1","type":"item_booked","wishlistId":"w-1","wishlistTitle":"Birthday"}`
+
+	if code := notify("wrong", surprise); code != http.StatusForbidden {
+		t.Errorf("Expected status %d for a wrong token, got %d", http.StatusForbidden, code)
+	}
+	if code := notify("secret", `{"type":"item_booked"}`); code != http.StatusBadRequest {
+		t.Errorf("Expected status %d without user, got %d", http.StatusBadRequest, code)
+	}
+
+	if code := notify("secret", surprise); code != http.StatusNoContent {
+		t.Fatalf("Expected status %d, got %d", http.StatusNoContent, code)
+	}
+	if len(sent) != 1 || sent[0].ChatID != 42 || !strings.Contains(sent[0].Text, "Which one is a surprise!") {
+		t.Errorf("Expected the surprise message in chat 42, got %+v", sent)
+	}
+
+	linked = false
+	if code := notify("secret", surprise); code != http.StatusNotFound {
+		t.Errorf("Expected status %d without a linked account, got %d", http.StatusNotFound, code)
+	}
+	if len(sent) != 1 {
+		t.Errorf("Expected no message without a linked account, got %d", len(sent))
+	}
+}
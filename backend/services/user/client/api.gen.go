@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -21,19 +22,153 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKCrv.
+const (
+	Ed25519 JWKCrv = "Ed25519"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for JWKUse.
+const (
+	Sig JWKUse = "sig"
+)
+
+// Defines values for SessionMethod.
+const (
+	Telegram    SessionMethod = "telegram"
+	TelegramBot SessionMethod = "telegram_bot"
+	Yandex      SessionMethod = "yandex"
+)
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	// AccessToken JWT access token
 	AccessToken string `json:"accessToken"`
 
-	// ExpiresIn Token lifetime in seconds
+	// ExpiresIn Access token lifetime in seconds
 	ExpiresIn int64 `json:"expiresIn"`
-	User      User  `json:"user"`
+
+	// RefreshToken Opaque token for `POST /auth/refresh`, valid for as long as the
+	// session is used. Missing for tokens issued to the Telegram bot.
+	RefreshToken *string `json:"refreshToken,omitempty"`
+	User         User    `json:"user"`
+}
+
+// JWK Public key; RSA keys have `n` and `e`, Ed25519 keys (`kty` OKP) have `crv` and `x`.
+type JWK struct {
+	Alg JWKAlg  `json:"alg"`
+	Crv *JWKCrv `json:"crv,omitempty"`
+
+	// E RSA public exponent, base64url
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty JWKKty  `json:"kty"`
+
+	// N RSA modulus, base64url
+	N   *string `json:"n,omitempty"`
+	Use JWKUse  `json:"use"`
+
+	// X Ed25519 public key, base64url
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKCrv defines model for JWK.Crv.
+type JWKCrv string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKUse defines model for JWK.Use.
+type JWKUse string
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
 }
 
 // PublicUserProfile defines model for PublicUserProfile.
 type PublicUserProfile = User
 
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether this is the session of the token that made the request.
+	Current bool `json:"current"`
+
+	// ExpiresAt When the session ends unless it is refreshed before.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Ip IP address of the device when it last logged in or refreshed its tokens.
+	Ip *string `json:"ip,omitempty"`
+
+	// LastSeenAt Last time the session was refreshed or used with the user service,
+	// to the minute.
+	LastSeenAt time.Time `json:"lastSeenAt"`
+
+	// Method How the session started: Yandex ID on the web, the Telegram Mini App,
+	// or a web login link sent by the Telegram bot.
+	Method SessionMethod `json:"method"`
+
+	// UserAgent User agent of the device when it last logged in or refreshed its tokens.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// SessionMethod How the session started: Yandex ID on the web, the Telegram Mini App,
+// or a web login link sent by the Telegram bot.
+type SessionMethod string
+
+// SessionList defines model for SessionList.
+type SessionList struct {
+	Sessions []Session `json:"sessions"`
+}
+
+// TelegramAuthRequest defines model for TelegramAuthRequest.
+type TelegramAuthRequest struct {
+	// InitData Raw Telegram Mini App initData string (querystring format).
+	InitData string `json:"initData"`
+}
+
+// TelegramBotAuthRequest defines model for TelegramBotAuthRequest.
+type TelegramBotAuthRequest struct {
+	// TelegramId Telegram user id (`from.id`) from Bot API updates.
+	TelegramId int64 `json:"telegramId"`
+
+	// WebLogin The token is for a web login link rather than the bot's own calls. It
+	// then starts a session that is listed and can be revoked, but has no
+	// refresh token and ends when the access token expires.
+	WebLogin *bool `json:"webLogin,omitempty"`
+}
+
+// TelegramLink defines model for TelegramLink.
+type TelegramLink struct {
+	// LanguageCode Telegram client language (`language_code`) seen at the last Mini App login.
+	LanguageCode *string `json:"languageCode"`
+
+	// TelegramId Telegram user id, which is also the private chat id with the bot.
+	TelegramId int64 `json:"telegramId"`
+}
+
 // UpdateUserRequest defines model for UpdateUserRequest.
 type UpdateUserRequest struct {
 	AvatarUrl   *string `json:"avatarUrl,omitempty"`
@@ -48,6 +183,11 @@ type User struct {
 	Id          openapi_types.UUID   `json:"id"`
 }
 
+// UserEmail defines model for UserEmail.
+type UserEmail struct {
+	Email openapi_types.Email `json:"email"`
+}
+
 // ValidateTokenRequest defines model for ValidateTokenRequest.
 type ValidateTokenRequest struct {
 	// Token JWT token to validate
@@ -68,6 +208,36 @@ type YandexAuthRequest struct {
 	Code string `json:"code"`
 }
 
+// PostAuthTelegramBotParams defines parameters for PostAuthTelegramBot.
+type PostAuthTelegramBotParams struct {
+	// XWiliBotToken Shared secret token used by internal Telegram bot calls.
+	XWiliBotToken string `json:"X-Wili-Bot-Token"`
+}
+
+// GetUsersUserIdEmailParams defines parameters for GetUsersUserIdEmail.
+type GetUsersUserIdEmailParams struct {
+	// XWiliServiceToken Shared secret token used by internal service calls.
+	XWiliServiceToken string `json:"X-Wili-Service-Token"`
+}
+
+// GetUsersUserIdTelegramParams defines parameters for GetUsersUserIdTelegram.
+type GetUsersUserIdTelegramParams struct {
+	// XWiliBotToken Shared secret token used by internal Telegram bot calls.
+	XWiliBotToken string `json:"X-Wili-Bot-Token"`
+}
+
+// PostAuthLogoutJSONRequestBody defines body for PostAuthLogout for application/json ContentType.
+type PostAuthLogoutJSONRequestBody = RefreshTokenRequest
+
+// PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
+type PostAuthRefreshJSONRequestBody = RefreshTokenRequest
+
+// PostAuthTelegramJSONRequestBody defines body for PostAuthTelegram for application/json ContentType.
+type PostAuthTelegramJSONRequestBody = TelegramAuthRequest
+
+// PostAuthTelegramBotJSONRequestBody defines body for PostAuthTelegramBot for application/json ContentType.
+type PostAuthTelegramBotJSONRequestBody = TelegramBotAuthRequest
+
 // PostAuthValidateJSONRequestBody defines body for PostAuthValidate for application/json ContentType.
 type PostAuthValidateJSONRequestBody = ValidateTokenRequest
 
@@ -150,6 +320,32 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthLogoutWithBody request with any body
+	PostAuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthLogout(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthLogoutAll request
+	PostAuthLogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthRefreshWithBody request with any body
+	PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthTelegramWithBody request with any body
+	PostAuthTelegramWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthTelegram(ctx context.Context, body PostAuthTelegramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthTelegramBotWithBody request with any body
+	PostAuthTelegramBotWithBody(ctx context.Context, params *PostAuthTelegramBotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthTelegramBot(ctx context.Context, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAuthValidateWithBody request with any body
 	PostAuthValidateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -168,8 +364,140 @@ type ClientInterface interface {
 
 	PutUsersMe(ctx context.Context, body PutUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetUsersMeSessions request
+	GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeSessionsSessionId request
+	DeleteUsersMeSessionsSessionId(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsersUserId request
 	GetUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersUserIdEmail request
+	GetUsersUserIdEmail(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersUserIdTelegram request
+	GetUsersUserIdTelegram(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogout(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutAllRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthTelegramWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthTelegramRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthTelegram(ctx context.Context, body PostAuthTelegramJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthTelegramRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthTelegramBotWithBody(ctx context.Context, params *PostAuthTelegramBotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthTelegramBotRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthTelegramBot(ctx context.Context, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthTelegramBotRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) PostAuthValidateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -256,6 +584,30 @@ func (c *Client) PutUsersMe(ctx context.Context, body PutUsersMeJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeSessionsSessionId(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeSessionsSessionIdRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetUsersUserIdRequest(c.Server, userId)
 	if err != nil {
@@ -268,19 +620,32 @@ func (c *Client) GetUsersUserId(ctx context.Context, userId openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-// NewPostAuthValidateRequest calls the generic PostAuthValidate builder with application/json body
-func NewPostAuthValidateRequest(server string, body PostAuthValidateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetUsersUserIdEmail(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersUserIdEmailRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthValidateRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthValidateRequestWithBody generates requests for PostAuthValidate with any type of body
-func NewPostAuthValidateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func (c *Client) GetUsersUserIdTelegram(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersUserIdTelegramRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -288,7 +653,7 @@ func NewPostAuthValidateRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/auth/validate")
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -298,29 +663,27 @@ func NewPostAuthValidateRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostAuthYandexRequest calls the generic PostAuthYandex builder with application/json body
-func NewPostAuthYandexRequest(server string, body PostAuthYandexJSONRequestBody) (*http.Request, error) {
+// NewPostAuthLogoutRequest calls the generic PostAuthLogout builder with application/json body
+func NewPostAuthLogoutRequest(server string, body PostAuthLogoutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthYandexRequestWithBody(server, "application/json", bodyReader)
+	return NewPostAuthLogoutRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostAuthYandexRequestWithBody generates requests for PostAuthYandex with any type of body
-func NewPostAuthYandexRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthLogoutRequestWithBody generates requests for PostAuthLogout with any type of body
+func NewPostAuthLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -328,7 +691,7 @@ func NewPostAuthYandexRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/auth/yandex")
+	operationPath := fmt.Sprintf("/auth/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -348,8 +711,8 @@ func NewPostAuthYandexRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewGetUsersMeRequest generates requests for GetUsersMe
-func NewGetUsersMeRequest(server string) (*http.Request, error) {
+// NewPostAuthLogoutAllRequest generates requests for PostAuthLogoutAll
+func NewPostAuthLogoutAllRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -357,7 +720,7 @@ func NewGetUsersMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/me")
+	operationPath := fmt.Sprintf("/auth/logout-all")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -367,7 +730,7 @@ func NewGetUsersMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -375,19 +738,19 @@ func NewGetUsersMeRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewPutUsersMeRequest calls the generic PutUsersMe builder with application/json body
-func NewPutUsersMeRequest(server string, body PutUsersMeJSONRequestBody) (*http.Request, error) {
+// NewPostAuthRefreshRequest calls the generic PostAuthRefresh builder with application/json body
+func NewPostAuthRefreshRequest(server string, body PostAuthRefreshJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutUsersMeRequestWithBody(server, "application/json", bodyReader)
+	return NewPostAuthRefreshRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPutUsersMeRequestWithBody generates requests for PutUsersMe with any type of body
-func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthRefreshRequestWithBody generates requests for PostAuthRefresh with any type of body
+func NewPostAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -395,7 +758,7 @@ func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/me")
+	operationPath := fmt.Sprintf("/auth/refresh")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -405,7 +768,7 @@ func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -415,23 +778,27 @@ func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Rea
 	return req, nil
 }
 
-// NewGetUsersUserIdRequest generates requests for GetUsersUserId
-func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+// NewPostAuthTelegramRequest calls the generic PostAuthTelegram builder with application/json body
+func NewPostAuthTelegramRequest(server string, body PostAuthTelegramJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthTelegramRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthTelegramRequestWithBody generates requests for PostAuthTelegram with any type of body
+func NewPostAuthTelegramRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	operationPath := fmt.Sprintf("/auth/telegram")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -441,11 +808,402 @@ func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.R
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthTelegramBotRequest calls the generic PostAuthTelegramBot builder with application/json body
+func NewPostAuthTelegramBotRequest(server string, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthTelegramBotRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostAuthTelegramBotRequestWithBody generates requests for PostAuthTelegramBot with any type of body
+func NewPostAuthTelegramBotRequestWithBody(server string, params *PostAuthTelegramBotParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/telegram-bot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Bot-Token", runtime.ParamLocationHeader, params.XWiliBotToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Wili-Bot-Token", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostAuthValidateRequest calls the generic PostAuthValidate builder with application/json body
+func NewPostAuthValidateRequest(server string, body PostAuthValidateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthValidateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthValidateRequestWithBody generates requests for PostAuthValidate with any type of body
+func NewPostAuthValidateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthYandexRequest calls the generic PostAuthYandex builder with application/json body
+func NewPostAuthYandexRequest(server string, body PostAuthYandexJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthYandexRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthYandexRequestWithBody generates requests for PostAuthYandex with any type of body
+func NewPostAuthYandexRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/yandex")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersMeRequest generates requests for GetUsersMe
+func NewGetUsersMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutUsersMeRequest calls the generic PutUsersMe builder with application/json body
+func NewPutUsersMeRequest(server string, body PutUsersMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutUsersMeRequestWithBody generates requests for PutUsersMe with any type of body
+func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersMeSessionsRequest generates requests for GetUsersMeSessions
+func NewGetUsersMeSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersMeSessionsSessionIdRequest generates requests for DeleteUsersMeSessionsSessionId
+func NewDeleteUsersMeSessionsSessionIdRequest(server string, sessionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersUserIdRequest generates requests for GetUsersUserId
+func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersUserIdEmailRequest generates requests for GetUsersUserIdEmail
+func NewGetUsersUserIdEmailRequest(server string, userId openapi_types.UUID, params *GetUsersUserIdEmailParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/email", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Service-Token", runtime.ParamLocationHeader, params.XWiliServiceToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Wili-Service-Token", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersUserIdTelegramRequest generates requests for GetUsersUserIdTelegram
+func NewGetUsersUserIdTelegramRequest(server string, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/telegram", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Bot-Token", runtime.ParamLocationHeader, params.XWiliBotToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Wili-Bot-Token", headerParam0)
+
+	}
+
 	return req, nil
 }
 
@@ -492,6 +1250,32 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// PostAuthLogoutWithBodyWithResponse request with any body
+	PostAuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+
+	PostAuthLogoutWithResponse(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+
+	// PostAuthLogoutAllWithResponse request
+	PostAuthLogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutAllResponse, error)
+
+	// PostAuthRefreshWithBodyWithResponse request with any body
+	PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	// PostAuthTelegramWithBodyWithResponse request with any body
+	PostAuthTelegramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthTelegramResponse, error)
+
+	PostAuthTelegramWithResponse(ctx context.Context, body PostAuthTelegramJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthTelegramResponse, error)
+
+	// PostAuthTelegramBotWithBodyWithResponse request with any body
+	PostAuthTelegramBotWithBodyWithResponse(ctx context.Context, params *PostAuthTelegramBotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthTelegramBotResponse, error)
+
+	PostAuthTelegramBotWithResponse(ctx context.Context, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthTelegramBotResponse, error)
+
 	// PostAuthValidateWithBodyWithResponse request with any body
 	PostAuthValidateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthValidateResponse, error)
 
@@ -510,8 +1294,150 @@ type ClientWithResponsesInterface interface {
 
 	PutUsersMeWithResponse(ctx context.Context, body PutUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersMeResponse, error)
 
+	// GetUsersMeSessionsWithResponse request
+	GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error)
+
+	// DeleteUsersMeSessionsSessionIdWithResponse request
+	DeleteUsersMeSessionsSessionIdWithResponse(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIdResponse, error)
+
 	// GetUsersUserIdWithResponse request
 	GetUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetUsersUserIdResponse, error)
+
+	// GetUsersUserIdEmailWithResponse request
+	GetUsersUserIdEmailWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdEmailResponse, error)
+
+	// GetUsersUserIdTelegramWithResponse request
+	GetUsersUserIdTelegramWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdTelegramResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthTelegramResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthTelegramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthTelegramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthTelegramBotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthTelegramBotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthTelegramBotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type PostAuthValidateResponse struct {
@@ -586,42 +1512,215 @@ type PutUsersMeResponse struct {
 	JSON200      *User
 }
 
-// Status returns HTTPResponse.Status
-func (r PutUsersMeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r PutUsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutUsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SessionList
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteUsersMeSessionsSessionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteUsersMeSessionsSessionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteUsersMeSessionsSessionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PublicUserProfile
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersUserIdEmailResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserEmail
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersUserIdEmailResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersUserIdEmailResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersUserIdTelegramResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TelegramLink
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersUserIdTelegramResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersUserIdTelegramResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// PostAuthLogoutWithBodyWithResponse request with arbitrary body returning *PostAuthLogoutResponse
+func (c *ClientWithResponses) PostAuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
+	rsp, err := c.PostAuthLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthLogoutWithResponse(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
+	rsp, err := c.PostAuthLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutResponse(rsp)
+}
+
+// PostAuthLogoutAllWithResponse request returning *PostAuthLogoutAllResponse
+func (c *ClientWithResponses) PostAuthLogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutAllResponse, error) {
+	rsp, err := c.PostAuthLogoutAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutAllResponse(rsp)
+}
+
+// PostAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshResponse
+func (c *ClientWithResponses) PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostAuthRefreshResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutUsersMeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostAuthRefreshResponse(rsp)
 }
 
-type GetUsersUserIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *PublicUserProfile
+// PostAuthTelegramWithBodyWithResponse request with arbitrary body returning *PostAuthTelegramResponse
+func (c *ClientWithResponses) PostAuthTelegramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthTelegramResponse, error) {
+	rsp, err := c.PostAuthTelegramWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthTelegramResponse(rsp)
 }
 
-// Status returns HTTPResponse.Status
-func (r GetUsersUserIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+func (c *ClientWithResponses) PostAuthTelegramWithResponse(ctx context.Context, body PostAuthTelegramJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthTelegramResponse, error) {
+	rsp, err := c.PostAuthTelegram(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParsePostAuthTelegramResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersUserIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// PostAuthTelegramBotWithBodyWithResponse request with arbitrary body returning *PostAuthTelegramBotResponse
+func (c *ClientWithResponses) PostAuthTelegramBotWithBodyWithResponse(ctx context.Context, params *PostAuthTelegramBotParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthTelegramBotResponse, error) {
+	rsp, err := c.PostAuthTelegramBotWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParsePostAuthTelegramBotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthTelegramBotWithResponse(ctx context.Context, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthTelegramBotResponse, error) {
+	rsp, err := c.PostAuthTelegramBot(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthTelegramBotResponse(rsp)
 }
 
 // PostAuthValidateWithBodyWithResponse request with arbitrary body returning *PostAuthValidateResponse
@@ -684,6 +1783,24 @@ func (c *ClientWithResponses) PutUsersMeWithResponse(ctx context.Context, body P
 	return ParsePutUsersMeResponse(rsp)
 }
 
+// GetUsersMeSessionsWithResponse request returning *GetUsersMeSessionsResponse
+func (c *ClientWithResponses) GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error) {
+	rsp, err := c.GetUsersMeSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeSessionsResponse(rsp)
+}
+
+// DeleteUsersMeSessionsSessionIdWithResponse request returning *DeleteUsersMeSessionsSessionIdResponse
+func (c *ClientWithResponses) DeleteUsersMeSessionsSessionIdWithResponse(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIdResponse, error) {
+	rsp, err := c.DeleteUsersMeSessionsSessionId(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeSessionsSessionIdResponse(rsp)
+}
+
 // GetUsersUserIdWithResponse request returning *GetUsersUserIdResponse
 func (c *ClientWithResponses) GetUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetUsersUserIdResponse, error) {
 	rsp, err := c.GetUsersUserId(ctx, userId, reqEditors...)
@@ -693,6 +1810,160 @@ func (c *ClientWithResponses) GetUsersUserIdWithResponse(ctx context.Context, us
 	return ParseGetUsersUserIdResponse(rsp)
 }
 
+// GetUsersUserIdEmailWithResponse request returning *GetUsersUserIdEmailResponse
+func (c *ClientWithResponses) GetUsersUserIdEmailWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdEmailResponse, error) {
+	rsp, err := c.GetUsersUserIdEmail(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersUserIdEmailResponse(rsp)
+}
+
+// GetUsersUserIdTelegramWithResponse request returning *GetUsersUserIdTelegramResponse
+func (c *ClientWithResponses) GetUsersUserIdTelegramWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdTelegramResponse, error) {
+	rsp, err := c.GetUsersUserIdTelegram(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersUserIdTelegramResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLogoutResponse parses an HTTP response from a PostAuthLogoutWithResponse call
+func ParsePostAuthLogoutResponse(rsp *http.Response) (*PostAuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLogoutAllResponse parses an HTTP response from a PostAuthLogoutAllWithResponse call
+func ParsePostAuthLogoutAllResponse(rsp *http.Response) (*PostAuthLogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
+func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthTelegramResponse parses an HTTP response from a PostAuthTelegramWithResponse call
+func ParsePostAuthTelegramResponse(rsp *http.Response) (*PostAuthTelegramResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthTelegramResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthTelegramBotResponse parses an HTTP response from a PostAuthTelegramBotWithResponse call
+func ParsePostAuthTelegramBotResponse(rsp *http.Response) (*PostAuthTelegramBotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthTelegramBotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostAuthValidateResponse parses an HTTP response from a PostAuthValidateWithResponse call
 func ParsePostAuthValidateResponse(rsp *http.Response) (*PostAuthValidateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -797,6 +2068,48 @@ func ParsePutUsersMeResponse(rsp *http.Response) (*PutUsersMeResponse, error) {
 	return response, nil
 }
 
+// ParseGetUsersMeSessionsResponse parses an HTTP response from a GetUsersMeSessionsWithResponse call
+func ParseGetUsersMeSessionsResponse(rsp *http.Response) (*GetUsersMeSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SessionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteUsersMeSessionsSessionIdResponse parses an HTTP response from a DeleteUsersMeSessionsSessionIdWithResponse call
+func ParseDeleteUsersMeSessionsSessionIdResponse(rsp *http.Response) (*DeleteUsersMeSessionsSessionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteUsersMeSessionsSessionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
 // ParseGetUsersUserIdResponse parses an HTTP response from a GetUsersUserIdWithResponse call
 func ParseGetUsersUserIdResponse(rsp *http.Response) (*GetUsersUserIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -822,3 +2135,55 @@ func ParseGetUsersUserIdResponse(rsp *http.Response) (*GetUsersUserIdResponse, e
 
 	return response, nil
 }
+
+// ParseGetUsersUserIdEmailResponse parses an HTTP response from a GetUsersUserIdEmailWithResponse call
+func ParseGetUsersUserIdEmailResponse(rsp *http.Response) (*GetUsersUserIdEmailResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersUserIdEmailResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserEmail
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersUserIdTelegramResponse parses an HTTP response from a GetUsersUserIdTelegramWithResponse call
+func ParseGetUsersUserIdTelegramResponse(rsp *http.Response) (*GetUsersUserIdTelegramResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersUserIdTelegramResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TelegramLink
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -1,6 +1,6 @@
 // Package user_server provides primitives to interact with the openapi HTTP API.
 //
-// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.4.1 DO NOT EDIT.
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
 package user_server
 
 import (
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/oapi-codegen/runtime"
@@ -22,19 +23,127 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKCrv.
+const (
+	Ed25519 JWKCrv = "Ed25519"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for JWKUse.
+const (
+	Sig JWKUse = "sig"
+)
+
+// Defines values for SessionMethod.
+const (
+	Telegram    SessionMethod = "telegram"
+	TelegramBot SessionMethod = "telegram_bot"
+	Yandex      SessionMethod = "yandex"
+)
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	// AccessToken JWT access token
 	AccessToken string `json:"accessToken"`
 
-	// ExpiresIn Token lifetime in seconds
+	// ExpiresIn Access token lifetime in seconds
 	ExpiresIn int64 `json:"expiresIn"`
-	User      User  `json:"user"`
+
+	// RefreshToken Opaque token for `POST /auth/refresh`, valid for as long as the
+	// session is used. Missing for tokens issued to the Telegram bot.
+	RefreshToken *string `json:"refreshToken,omitempty"`
+	User         User    `json:"user"`
+}
+
+// JWK Public key; RSA keys have `n` and `e`, Ed25519 keys (`kty` OKP) have `crv` and `x`.
+type JWK struct {
+	Alg JWKAlg  `json:"alg"`
+	Crv *JWKCrv `json:"crv,omitempty"`
+
+	// E RSA public exponent, base64url
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty JWKKty  `json:"kty"`
+
+	// N RSA modulus, base64url
+	N   *string `json:"n,omitempty"`
+	Use JWKUse  `json:"use"`
+
+	// X Ed25519 public key, base64url
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKCrv defines model for JWK.Crv.
+type JWKCrv string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKUse defines model for JWK.Use.
+type JWKUse string
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
 }
 
 // PublicUserProfile defines model for PublicUserProfile.
 type PublicUserProfile = User
 
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Current Whether this is the session of the token that made the request.
+	Current bool `json:"current"`
+
+	// ExpiresAt When the session ends unless it is refreshed before.
+	ExpiresAt time.Time          `json:"expiresAt"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Ip IP address of the device when it last logged in or refreshed its tokens.
+	Ip *string `json:"ip,omitempty"`
+
+	// LastSeenAt Last time the session was refreshed or used with the user service,
+	// to the minute.
+	LastSeenAt time.Time `json:"lastSeenAt"`
+
+	// Method How the session started: Yandex ID on the web, the Telegram Mini App,
+	// or a web login link sent by the Telegram bot.
+	Method SessionMethod `json:"method"`
+
+	// UserAgent User agent of the device when it last logged in or refreshed its tokens.
+	UserAgent *string `json:"userAgent,omitempty"`
+}
+
+// SessionMethod How the session started: Yandex ID on the web, the Telegram Mini App,
+// or a web login link sent by the Telegram bot.
+type SessionMethod string
+
+// SessionList defines model for SessionList.
+type SessionList struct {
+	Sessions []Session `json:"sessions"`
+}
+
 // TelegramAuthRequest defines model for TelegramAuthRequest.
 type TelegramAuthRequest struct {
 	// InitData Raw Telegram Mini App initData string (querystring format).
@@ -45,6 +154,20 @@ type TelegramAuthRequest struct {
 type TelegramBotAuthRequest struct {
 	// TelegramId Telegram user id (`from.id`) from Bot API updates.
 	TelegramId int64 `json:"telegramId"`
+
+	// WebLogin The token is for a web login link rather than the bot's own calls. It
+	// then starts a session that is listed and can be revoked, but has no
+	// refresh token and ends when the access token expires.
+	WebLogin *bool `json:"webLogin,omitempty"`
+}
+
+// TelegramLink defines model for TelegramLink.
+type TelegramLink struct {
+	// LanguageCode Telegram client language (`language_code`) seen at the last Mini App login.
+	LanguageCode *string `json:"languageCode"`
+
+	// TelegramId Telegram user id, which is also the private chat id with the bot.
+	TelegramId int64 `json:"telegramId"`
 }
 
 // UpdateUserRequest defines model for UpdateUserRequest.
@@ -61,6 +184,11 @@ type User struct {
 	Id          openapi_types.UUID   `json:"id"`
 }
 
+// UserEmail defines model for UserEmail.
+type UserEmail struct {
+	Email openapi_types.Email `json:"email"`
+}
+
 // ValidateTokenRequest defines model for ValidateTokenRequest.
 type ValidateTokenRequest struct {
 	// Token JWT token to validate
@@ -87,6 +215,24 @@ type PostAuthTelegramBotParams struct {
 	XWiliBotToken string `json:"X-Wili-Bot-Token"`
 }
 
+// GetUsersUserIdEmailParams defines parameters for GetUsersUserIdEmail.
+type GetUsersUserIdEmailParams struct {
+	// XWiliServiceToken Shared secret token used by internal service calls.
+	XWiliServiceToken string `json:"X-Wili-Service-Token"`
+}
+
+// GetUsersUserIdTelegramParams defines parameters for GetUsersUserIdTelegram.
+type GetUsersUserIdTelegramParams struct {
+	// XWiliBotToken Shared secret token used by internal Telegram bot calls.
+	XWiliBotToken string `json:"X-Wili-Bot-Token"`
+}
+
+// PostAuthLogoutJSONRequestBody defines body for PostAuthLogout for application/json ContentType.
+type PostAuthLogoutJSONRequestBody = RefreshTokenRequest
+
+// PostAuthRefreshJSONRequestBody defines body for PostAuthRefresh for application/json ContentType.
+type PostAuthRefreshJSONRequestBody = RefreshTokenRequest
+
 // PostAuthTelegramJSONRequestBody defines body for PostAuthTelegram for application/json ContentType.
 type PostAuthTelegramJSONRequestBody = TelegramAuthRequest
 
@@ -175,6 +321,22 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthLogoutWithBody request with any body
+	PostAuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthLogout(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthLogoutAll request
+	PostAuthLogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthRefreshWithBody request with any body
+	PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// PostAuthTelegramWithBody request with any body
 	PostAuthTelegramWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -203,8 +365,92 @@ type ClientInterface interface {
 
 	PutUsersMe(ctx context.Context, body PutUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetUsersMeSessions request
+	GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteUsersMeSessionsSessionId request
+	DeleteUsersMeSessionsSessionId(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetUsersUserId request
 	GetUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersUserIdEmail request
+	GetUsersUserIdEmail(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersUserIdTelegram request
+	GetUsersUserIdTelegram(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogout(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthLogoutAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthLogoutAllRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthRefreshWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthRefresh(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthRefreshRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) PostAuthTelegramWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -339,6 +585,30 @@ func (c *Client) PutUsersMe(ctx context.Context, body PutUsersMeJSONRequestBody,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetUsersMeSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersMeSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteUsersMeSessionsSessionId(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteUsersMeSessionsSessionIdRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetUsersUserId(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetUsersUserIdRequest(c.Server, userId)
 	if err != nil {
@@ -351,19 +621,32 @@ func (c *Client) GetUsersUserId(ctx context.Context, userId openapi_types.UUID,
 	return c.Client.Do(req)
 }
 
-// NewPostAuthTelegramRequest calls the generic PostAuthTelegram builder with application/json body
-func NewPostAuthTelegramRequest(server string, body PostAuthTelegramJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetUsersUserIdEmail(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersUserIdEmailRequest(c.Server, userId, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthTelegramRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostAuthTelegramRequestWithBody generates requests for PostAuthTelegram with any type of body
-func NewPostAuthTelegramRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+func (c *Client) GetUsersUserIdTelegram(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersUserIdTelegramRequest(c.Server, userId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -371,7 +654,7 @@ func NewPostAuthTelegramRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/auth/telegram")
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -381,29 +664,27 @@ func NewPostAuthTelegramRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostAuthTelegramBotRequest calls the generic PostAuthTelegramBot builder with application/json body
-func NewPostAuthTelegramBotRequest(server string, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody) (*http.Request, error) {
+// NewPostAuthLogoutRequest calls the generic PostAuthLogout builder with application/json body
+func NewPostAuthLogoutRequest(server string, body PostAuthLogoutJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthTelegramBotRequestWithBody(server, params, "application/json", bodyReader)
+	return NewPostAuthLogoutRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostAuthTelegramBotRequestWithBody generates requests for PostAuthTelegramBot with any type of body
-func NewPostAuthTelegramBotRequestWithBody(server string, params *PostAuthTelegramBotParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthLogoutRequestWithBody generates requests for PostAuthLogout with any type of body
+func NewPostAuthLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -411,7 +692,7 @@ func NewPostAuthTelegramBotRequestWithBody(server string, params *PostAuthTelegr
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/auth/telegram-bot")
+	operationPath := fmt.Sprintf("/auth/logout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -428,35 +709,11 @@ func NewPostAuthTelegramBotRequestWithBody(server string, params *PostAuthTelegr
 
 	req.Header.Add("Content-Type", contentType)
 
-	if params != nil {
-
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Bot-Token", runtime.ParamLocationHeader, params.XWiliBotToken)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("X-Wili-Bot-Token", headerParam0)
-
-	}
-
 	return req, nil
 }
 
-// NewPostAuthValidateRequest calls the generic PostAuthValidate builder with application/json body
-func NewPostAuthValidateRequest(server string, body PostAuthValidateJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthValidateRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewPostAuthValidateRequestWithBody generates requests for PostAuthValidate with any type of body
-func NewPostAuthValidateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthLogoutAllRequest generates requests for PostAuthLogoutAll
+func NewPostAuthLogoutAllRequest(server string) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -464,7 +721,7 @@ func NewPostAuthValidateRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/auth/validate")
+	operationPath := fmt.Sprintf("/auth/logout-all")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -474,29 +731,27 @@ func NewPostAuthValidateRequestWithBody(server string, contentType string, body
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewPostAuthYandexRequest calls the generic PostAuthYandex builder with application/json body
-func NewPostAuthYandexRequest(server string, body PostAuthYandexJSONRequestBody) (*http.Request, error) {
+// NewPostAuthRefreshRequest calls the generic PostAuthRefresh builder with application/json body
+func NewPostAuthRefreshRequest(server string, body PostAuthRefreshJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPostAuthYandexRequestWithBody(server, "application/json", bodyReader)
+	return NewPostAuthRefreshRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewPostAuthYandexRequestWithBody generates requests for PostAuthYandex with any type of body
-func NewPostAuthYandexRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthRefreshRequestWithBody generates requests for PostAuthRefresh with any type of body
+func NewPostAuthRefreshRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -504,7 +759,7 @@ func NewPostAuthYandexRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/auth/yandex")
+	operationPath := fmt.Sprintf("/auth/refresh")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -524,8 +779,19 @@ func NewPostAuthYandexRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewGetUsersMeRequest generates requests for GetUsersMe
-func NewGetUsersMeRequest(server string) (*http.Request, error) {
+// NewPostAuthTelegramRequest calls the generic PostAuthTelegram builder with application/json body
+func NewPostAuthTelegramRequest(server string, body PostAuthTelegramJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthTelegramRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthTelegramRequestWithBody generates requests for PostAuthTelegram with any type of body
+func NewPostAuthTelegramRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -533,7 +799,7 @@ func NewGetUsersMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/me")
+	operationPath := fmt.Sprintf("/auth/telegram")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -543,27 +809,29 @@ func NewGetUsersMeRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewPutUsersMeRequest calls the generic PutUsersMe builder with application/json body
-func NewPutUsersMeRequest(server string, body PutUsersMeJSONRequestBody) (*http.Request, error) {
+// NewPostAuthTelegramBotRequest calls the generic PostAuthTelegramBot builder with application/json body
+func NewPostAuthTelegramBotRequest(server string, params *PostAuthTelegramBotParams, body PostAuthTelegramBotJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewPutUsersMeRequestWithBody(server, "application/json", bodyReader)
+	return NewPostAuthTelegramBotRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewPutUsersMeRequestWithBody generates requests for PutUsersMe with any type of body
-func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewPostAuthTelegramBotRequestWithBody generates requests for PostAuthTelegramBot with any type of body
+func NewPostAuthTelegramBotRequestWithBody(server string, params *PostAuthTelegramBotParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -571,7 +839,7 @@ func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/users/me")
+	operationPath := fmt.Sprintf("/auth/telegram-bot")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -581,19 +849,240 @@ func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Rea
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Add("Content-Type", contentType)
 
-	return req, nil
-}
+	if params != nil {
 
-// NewGetUsersUserIdRequest generates requests for GetUsersUserId
-func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
-	var err error
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Bot-Token", runtime.ParamLocationHeader, params.XWiliBotToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Wili-Bot-Token", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewPostAuthValidateRequest calls the generic PostAuthValidate builder with application/json body
+func NewPostAuthValidateRequest(server string, body PostAuthValidateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthValidateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthValidateRequestWithBody generates requests for PostAuthValidate with any type of body
+func NewPostAuthValidateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/validate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAuthYandexRequest calls the generic PostAuthYandex builder with application/json body
+func NewPostAuthYandexRequest(server string, body PostAuthYandexJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthYandexRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthYandexRequestWithBody generates requests for PostAuthYandex with any type of body
+func NewPostAuthYandexRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/auth/yandex")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersMeRequest generates requests for GetUsersMe
+func NewGetUsersMeRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutUsersMeRequest calls the generic PutUsersMe builder with application/json body
+func NewPutUsersMeRequest(server string, body PutUsersMeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutUsersMeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutUsersMeRequestWithBody generates requests for PutUsersMe with any type of body
+func NewPutUsersMeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersMeSessionsRequest generates requests for GetUsersMeSessions
+func NewGetUsersMeSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteUsersMeSessionsSessionIdRequest generates requests for DeleteUsersMeSessionsSessionId
+func NewDeleteUsersMeSessionsSessionIdRequest(server string, sessionId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "sessionId", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/me/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetUsersUserIdRequest generates requests for GetUsersUserId
+func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.Request, error) {
+	var err error
 
 	var pathParam0 string
 
@@ -625,6 +1114,100 @@ func NewGetUsersUserIdRequest(server string, userId openapi_types.UUID) (*http.R
 	return req, nil
 }
 
+// NewGetUsersUserIdEmailRequest generates requests for GetUsersUserIdEmail
+func NewGetUsersUserIdEmailRequest(server string, userId openapi_types.UUID, params *GetUsersUserIdEmailParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/email", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Service-Token", runtime.ParamLocationHeader, params.XWiliServiceToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Wili-Service-Token", headerParam0)
+
+	}
+
+	return req, nil
+}
+
+// NewGetUsersUserIdTelegramRequest generates requests for GetUsersUserIdTelegram
+func NewGetUsersUserIdTelegramRequest(server string, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "userId", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/%s/telegram", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "X-Wili-Bot-Token", runtime.ParamLocationHeader, params.XWiliBotToken)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("X-Wili-Bot-Token", headerParam0)
+
+	}
+
+	return req, nil
+}
+
 func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
 	for _, r := range c.RequestEditors {
 		if err := r(ctx, req); err != nil {
@@ -668,6 +1251,22 @@ func WithBaseURL(baseURL string) ClientOption {
 
 // ClientWithResponsesInterface is the interface specification for the client with responses above.
 type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// PostAuthLogoutWithBodyWithResponse request with any body
+	PostAuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+
+	PostAuthLogoutWithResponse(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error)
+
+	// PostAuthLogoutAllWithResponse request
+	PostAuthLogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutAllResponse, error)
+
+	// PostAuthRefreshWithBodyWithResponse request with any body
+	PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
+	PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error)
+
 	// PostAuthTelegramWithBodyWithResponse request with any body
 	PostAuthTelegramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthTelegramResponse, error)
 
@@ -696,8 +1295,106 @@ type ClientWithResponsesInterface interface {
 
 	PutUsersMeWithResponse(ctx context.Context, body PutUsersMeJSONRequestBody, reqEditors ...RequestEditorFn) (*PutUsersMeResponse, error)
 
+	// GetUsersMeSessionsWithResponse request
+	GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error)
+
+	// DeleteUsersMeSessionsSessionIdWithResponse request
+	DeleteUsersMeSessionsSessionIdWithResponse(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIdResponse, error)
+
 	// GetUsersUserIdWithResponse request
 	GetUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetUsersUserIdResponse, error)
+
+	// GetUsersUserIdEmailWithResponse request
+	GetUsersUserIdEmailWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdEmailResponse, error)
+
+	// GetUsersUserIdTelegramWithResponse request
+	GetUsersUserIdTelegramWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdTelegramResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthLogoutAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthLogoutAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthLogoutAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthRefreshResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthRefreshResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthRefreshResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
 }
 
 type PostAuthTelegramResponse struct {
@@ -722,14 +1419,102 @@ func (r PostAuthTelegramResponse) StatusCode() int {
 	return 0
 }
 
-type PostAuthTelegramBotResponse struct {
+type PostAuthTelegramBotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthTelegramBotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthTelegramBotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthValidateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidateTokenResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthValidateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthValidateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthYandexResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthYandexResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthYandexResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersMeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersMeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersMeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutUsersMeResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AuthResponse
+	JSON200      *User
 }
 
 // Status returns HTTPResponse.Status
-func (r PostAuthTelegramBotResponse) Status() string {
+func (r PutUsersMeResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -737,21 +1522,21 @@ func (r PostAuthTelegramBotResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthTelegramBotResponse) StatusCode() int {
+func (r PutUsersMeResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostAuthValidateResponse struct {
+type GetUsersMeSessionsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *ValidateTokenResponse
+	JSON200      *SessionList
 }
 
 // Status returns HTTPResponse.Status
-func (r PostAuthValidateResponse) Status() string {
+func (r GetUsersMeSessionsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -759,21 +1544,20 @@ func (r PostAuthValidateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthValidateResponse) StatusCode() int {
+func (r GetUsersMeSessionsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostAuthYandexResponse struct {
+type DeleteUsersMeSessionsSessionIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *AuthResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PostAuthYandexResponse) Status() string {
+func (r DeleteUsersMeSessionsSessionIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -781,21 +1565,21 @@ func (r PostAuthYandexResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostAuthYandexResponse) StatusCode() int {
+func (r DeleteUsersMeSessionsSessionIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetUsersMeResponse struct {
+type GetUsersUserIdResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *User
+	JSON200      *PublicUserProfile
 }
 
 // Status returns HTTPResponse.Status
-func (r GetUsersMeResponse) Status() string {
+func (r GetUsersUserIdResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -803,21 +1587,21 @@ func (r GetUsersMeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersMeResponse) StatusCode() int {
+func (r GetUsersUserIdResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PutUsersMeResponse struct {
+type GetUsersUserIdEmailResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *User
+	JSON200      *UserEmail
 }
 
 // Status returns HTTPResponse.Status
-func (r PutUsersMeResponse) Status() string {
+func (r GetUsersUserIdEmailResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -825,21 +1609,21 @@ func (r PutUsersMeResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutUsersMeResponse) StatusCode() int {
+func (r GetUsersUserIdEmailResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetUsersUserIdResponse struct {
+type GetUsersUserIdTelegramResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *PublicUserProfile
+	JSON200      *TelegramLink
 }
 
 // Status returns HTTPResponse.Status
-func (r GetUsersUserIdResponse) Status() string {
+func (r GetUsersUserIdTelegramResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -847,13 +1631,65 @@ func (r GetUsersUserIdResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetUsersUserIdResponse) StatusCode() int {
+func (r GetUsersUserIdTelegramResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// PostAuthLogoutWithBodyWithResponse request with arbitrary body returning *PostAuthLogoutResponse
+func (c *ClientWithResponses) PostAuthLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
+	rsp, err := c.PostAuthLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthLogoutWithResponse(ctx context.Context, body PostAuthLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthLogoutResponse, error) {
+	rsp, err := c.PostAuthLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutResponse(rsp)
+}
+
+// PostAuthLogoutAllWithResponse request returning *PostAuthLogoutAllResponse
+func (c *ClientWithResponses) PostAuthLogoutAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAuthLogoutAllResponse, error) {
+	rsp, err := c.PostAuthLogoutAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthLogoutAllResponse(rsp)
+}
+
+// PostAuthRefreshWithBodyWithResponse request with arbitrary body returning *PostAuthRefreshResponse
+func (c *ClientWithResponses) PostAuthRefreshWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefreshWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthRefreshWithResponse(ctx context.Context, body PostAuthRefreshJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthRefreshResponse, error) {
+	rsp, err := c.PostAuthRefresh(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthRefreshResponse(rsp)
+}
+
 // PostAuthTelegramWithBodyWithResponse request with arbitrary body returning *PostAuthTelegramResponse
 func (c *ClientWithResponses) PostAuthTelegramWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthTelegramResponse, error) {
 	rsp, err := c.PostAuthTelegramWithBody(ctx, contentType, body, reqEditors...)
@@ -948,6 +1784,24 @@ func (c *ClientWithResponses) PutUsersMeWithResponse(ctx context.Context, body P
 	return ParsePutUsersMeResponse(rsp)
 }
 
+// GetUsersMeSessionsWithResponse request returning *GetUsersMeSessionsResponse
+func (c *ClientWithResponses) GetUsersMeSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUsersMeSessionsResponse, error) {
+	rsp, err := c.GetUsersMeSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersMeSessionsResponse(rsp)
+}
+
+// DeleteUsersMeSessionsSessionIdWithResponse request returning *DeleteUsersMeSessionsSessionIdResponse
+func (c *ClientWithResponses) DeleteUsersMeSessionsSessionIdWithResponse(ctx context.Context, sessionId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteUsersMeSessionsSessionIdResponse, error) {
+	rsp, err := c.DeleteUsersMeSessionsSessionId(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteUsersMeSessionsSessionIdResponse(rsp)
+}
+
 // GetUsersUserIdWithResponse request returning *GetUsersUserIdResponse
 func (c *ClientWithResponses) GetUsersUserIdWithResponse(ctx context.Context, userId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetUsersUserIdResponse, error) {
 	rsp, err := c.GetUsersUserId(ctx, userId, reqEditors...)
@@ -957,6 +1811,108 @@ func (c *ClientWithResponses) GetUsersUserIdWithResponse(ctx context.Context, us
 	return ParseGetUsersUserIdResponse(rsp)
 }
 
+// GetUsersUserIdEmailWithResponse request returning *GetUsersUserIdEmailResponse
+func (c *ClientWithResponses) GetUsersUserIdEmailWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdEmailParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdEmailResponse, error) {
+	rsp, err := c.GetUsersUserIdEmail(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersUserIdEmailResponse(rsp)
+}
+
+// GetUsersUserIdTelegramWithResponse request returning *GetUsersUserIdTelegramResponse
+func (c *ClientWithResponses) GetUsersUserIdTelegramWithResponse(ctx context.Context, userId openapi_types.UUID, params *GetUsersUserIdTelegramParams, reqEditors ...RequestEditorFn) (*GetUsersUserIdTelegramResponse, error) {
+	rsp, err := c.GetUsersUserIdTelegram(ctx, userId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersUserIdTelegramResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLogoutResponse parses an HTTP response from a PostAuthLogoutWithResponse call
+func ParsePostAuthLogoutResponse(rsp *http.Response) (*PostAuthLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthLogoutAllResponse parses an HTTP response from a PostAuthLogoutAllWithResponse call
+func ParsePostAuthLogoutAllResponse(rsp *http.Response) (*PostAuthLogoutAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthLogoutAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthRefreshResponse parses an HTTP response from a PostAuthRefreshWithResponse call
+func ParsePostAuthRefreshResponse(rsp *http.Response) (*PostAuthRefreshResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthRefreshResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParsePostAuthTelegramResponse parses an HTTP response from a PostAuthTelegramWithResponse call
 func ParsePostAuthTelegramResponse(rsp *http.Response) (*PostAuthTelegramResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -998,7 +1954,111 @@ func ParsePostAuthTelegramBotResponse(rsp *http.Response) (*PostAuthTelegramBotR
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthValidateResponse parses an HTTP response from a PostAuthValidateWithResponse call
+func ParsePostAuthValidateResponse(rsp *http.Response) (*PostAuthValidateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthValidateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ValidateTokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthYandexResponse parses an HTTP response from a PostAuthYandexWithResponse call
+func ParsePostAuthYandexResponse(rsp *http.Response) (*PostAuthYandexResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthYandexResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersMeResponse parses an HTTP response from a GetUsersMeWithResponse call
+func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutUsersMeResponse parses an HTTP response from a PutUsersMeWithResponse call
+func ParsePutUsersMeResponse(rsp *http.Response) (*PutUsersMeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutUsersMeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1009,22 +2069,22 @@ func ParsePostAuthTelegramBotResponse(rsp *http.Response) (*PostAuthTelegramBotR
 	return response, nil
 }
 
-// ParsePostAuthValidateResponse parses an HTTP response from a PostAuthValidateWithResponse call
-func ParsePostAuthValidateResponse(rsp *http.Response) (*PostAuthValidateResponse, error) {
+// ParseGetUsersMeSessionsResponse parses an HTTP response from a GetUsersMeSessionsWithResponse call
+func ParseGetUsersMeSessionsResponse(rsp *http.Response) (*GetUsersMeSessionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthValidateResponse{
+	response := &GetUsersMeSessionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ValidateTokenResponse
+		var dest SessionList
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1035,48 +2095,38 @@ func ParsePostAuthValidateResponse(rsp *http.Response) (*PostAuthValidateRespons
 	return response, nil
 }
 
-// ParsePostAuthYandexResponse parses an HTTP response from a PostAuthYandexWithResponse call
-func ParsePostAuthYandexResponse(rsp *http.Response) (*PostAuthYandexResponse, error) {
+// ParseDeleteUsersMeSessionsSessionIdResponse parses an HTTP response from a DeleteUsersMeSessionsSessionIdWithResponse call
+func ParseDeleteUsersMeSessionsSessionIdResponse(rsp *http.Response) (*DeleteUsersMeSessionsSessionIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostAuthYandexResponse{
+	response := &DeleteUsersMeSessionsSessionIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
-	}
-
 	return response, nil
 }
 
-// ParseGetUsersMeResponse parses an HTTP response from a GetUsersMeWithResponse call
-func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
+// ParseGetUsersUserIdResponse parses an HTTP response from a GetUsersUserIdWithResponse call
+func ParseGetUsersUserIdResponse(rsp *http.Response) (*GetUsersUserIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUsersMeResponse{
+	response := &GetUsersUserIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest PublicUserProfile
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1087,22 +2137,22 @@ func ParseGetUsersMeResponse(rsp *http.Response) (*GetUsersMeResponse, error) {
 	return response, nil
 }
 
-// ParsePutUsersMeResponse parses an HTTP response from a PutUsersMeWithResponse call
-func ParsePutUsersMeResponse(rsp *http.Response) (*PutUsersMeResponse, error) {
+// ParseGetUsersUserIdEmailResponse parses an HTTP response from a GetUsersUserIdEmailWithResponse call
+func ParseGetUsersUserIdEmailResponse(rsp *http.Response) (*GetUsersUserIdEmailResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutUsersMeResponse{
+	response := &GetUsersUserIdEmailResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest User
+		var dest UserEmail
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1113,22 +2163,22 @@ func ParsePutUsersMeResponse(rsp *http.Response) (*PutUsersMeResponse, error) {
 	return response, nil
 }
 
-// ParseGetUsersUserIdResponse parses an HTTP response from a GetUsersUserIdWithResponse call
-func ParseGetUsersUserIdResponse(rsp *http.Response) (*GetUsersUserIdResponse, error) {
+// ParseGetUsersUserIdTelegramResponse parses an HTTP response from a GetUsersUserIdTelegramWithResponse call
+func ParseGetUsersUserIdTelegramResponse(rsp *http.Response) (*GetUsersUserIdTelegramResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetUsersUserIdResponse{
+	response := &GetUsersUserIdTelegramResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest PublicUserProfile
+		var dest TelegramLink
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1141,6 +2191,18 @@ func ParseGetUsersUserIdResponse(rsp *http.Response) (*GetUsersUserIdResponse, e
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Public keys that access tokens are signed with
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request)
+	// End the session of a refresh token
+	// (POST /auth/logout)
+	PostAuthLogout(w http.ResponseWriter, r *http.Request)
+	// End every session of the current user
+	// (POST /auth/logout-all)
+	PostAuthLogoutAll(w http.ResponseWriter, r *http.Request)
+	// Exchange a refresh token for new tokens
+	// (POST /auth/refresh)
+	PostAuthRefresh(w http.ResponseWriter, r *http.Request)
 	// Authenticate a user via Telegram Mini App initData
 	// (POST /auth/telegram)
 	PostAuthTelegram(w http.ResponseWriter, r *http.Request)
@@ -1159,15 +2221,51 @@ type ServerInterface interface {
 	// Update current authenticated user's profile fields
 	// (PUT /users/me)
 	PutUsersMe(w http.ResponseWriter, r *http.Request)
+	// List where the current user is logged in
+	// (GET /users/me/sessions)
+	GetUsersMeSessions(w http.ResponseWriter, r *http.Request)
+	// Log out one session of the current user
+	// (DELETE /users/me/sessions/{sessionId})
+	DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request, sessionId openapi_types.UUID)
 	// Get public profile of a user
 	// (GET /users/{userId})
 	GetUsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID)
+	// Get the email address of a user (internal)
+	// (GET /users/{userId}/email)
+	GetUsersUserIdEmail(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetUsersUserIdEmailParams)
+	// Get the Telegram account linked to a user (internal)
+	// (GET /users/{userId}/telegram)
+	GetUsersUserIdTelegram(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetUsersUserIdTelegramParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Public keys that access tokens are signed with
+// (GET /.well-known/jwks.json)
+func (_ Unimplemented) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// End the session of a refresh token
+// (POST /auth/logout)
+func (_ Unimplemented) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// End every session of the current user
+// (POST /auth/logout-all)
+func (_ Unimplemented) PostAuthLogoutAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Exchange a refresh token for new tokens
+// (POST /auth/refresh)
+func (_ Unimplemented) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Authenticate a user via Telegram Mini App initData
 // (POST /auth/telegram)
 func (_ Unimplemented) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
@@ -1204,12 +2302,36 @@ func (_ Unimplemented) PutUsersMe(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List where the current user is logged in
+// (GET /users/me/sessions)
+func (_ Unimplemented) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Log out one session of the current user
+// (DELETE /users/me/sessions/{sessionId})
+func (_ Unimplemented) DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request, sessionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get public profile of a user
 // (GET /users/{userId})
 func (_ Unimplemented) GetUsersUserId(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the email address of a user (internal)
+// (GET /users/{userId}/email)
+func (_ Unimplemented) GetUsersUserIdEmail(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetUsersUserIdEmailParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the Telegram account linked to a user (internal)
+// (GET /users/{userId}/telegram)
+func (_ Unimplemented) GetUsersUserIdTelegram(w http.ResponseWriter, r *http.Request, userId openapi_types.UUID, params GetUsersUserIdTelegramParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -1219,6 +2341,68 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// GetWellKnownJwksJson operation middleware
+func (siw *ServerInterfaceWrapper) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWellKnownJwksJson(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthLogoutAll operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogoutAll(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthLogoutAll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // PostAuthTelegram operation middleware
 func (siw *ServerInterfaceWrapper) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
 
@@ -1363,6 +2547,57 @@ func (siw *ServerInterfaceWrapper) PutUsersMe(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
+// GetUsersMeSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersMeSessions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteUsersMeSessionsSessionId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", chi.URLParam(r, "sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sessionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteUsersMeSessionsSessionId(w, r, sessionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetUsersUserId operation middleware
 func (siw *ServerInterfaceWrapper) GetUsersUserId(w http.ResponseWriter, r *http.Request) {
 
@@ -1394,6 +2629,112 @@ func (siw *ServerInterfaceWrapper) GetUsersUserId(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
+// GetUsersUserIdEmail operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdEmail(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersUserIdEmailParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Wili-Service-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Wili-Service-Token")]; found {
+		var XWiliServiceToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Wili-Service-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Wili-Service-Token", valueList[0], &XWiliServiceToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Wili-Service-Token", Err: err})
+			return
+		}
+
+		params.XWiliServiceToken = XWiliServiceToken
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Wili-Service-Token is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Wili-Service-Token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersUserIdEmail(w, r, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersUserIdTelegram operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserIdTelegram(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersUserIdTelegramParams
+
+	headers := r.Header
+
+	// ------------- Required header parameter "X-Wili-Bot-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Wili-Bot-Token")]; found {
+		var XWiliBotToken string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Wili-Bot-Token", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Wili-Bot-Token", valueList[0], &XWiliBotToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Wili-Bot-Token", Err: err})
+			return
+		}
+
+		params.XWiliBotToken = XWiliBotToken
+
+	} else {
+		err := fmt.Errorf("Header parameter X-Wili-Bot-Token is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "X-Wili-Bot-Token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersUserIdTelegram(w, r, userId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1507,6 +2848,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout", wrapper.PostAuthLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/logout-all", wrapper.PostAuthLogoutAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/refresh", wrapper.PostAuthRefresh)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/auth/telegram", wrapper.PostAuthTelegram)
 	})
@@ -1525,9 +2878,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/me", wrapper.PutUsersMe)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/me/sessions", wrapper.GetUsersMeSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/me/sessions/{sessionId}", wrapper.DeleteUsersMeSessionsSessionId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{userId}", wrapper.GetUsersUserId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/email", wrapper.GetUsersUserIdEmail)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{userId}/telegram", wrapper.GetUsersUserIdTelegram)
+	})
 
 	return r
 }
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	usergen "github.com/theseems/wili/backend/services/user/gen"
+)
+
+// tokenKey is a key access tokens are signed with, or only verified with when
+// just its public half is known
+type tokenKey struct {
+	id      string
+	method  jwt.SigningMethod
+	private interface{}
+	public  interface{}
+}
+
+// tokenKeys signs and verifies the access tokens of the service. Tokens name
+// the key they are signed with in their kid header, so that keys can be
+// rotated: a new key is added and made the signing key, and the old one keeps
+// verifying the tokens it signed until they expire and it is removed.
+type tokenKeys struct {
+	issuer   string
+	audience string
+	signing  *tokenKey
+	keys     map[string]*tokenKey
+}
+
+// loadTokenKeys reads the PEM keys in dir, each named <kid>.pem: RSA keys
+// sign with RS256 and Ed25519 keys with EdDSA. Tokens are signed with the
+// private key signingID, or the last one by name when it is empty; public keys
+// only verify. Without dir, tokens are signed with HS256 and secret, which
+// every service verifying them must then hold.
+func loadTokenKeys(dir, signingID, secret, issuer, audience string) (*tokenKeys, error) {
+	k := &tokenKeys{issuer: issuer, audience: audience, keys: make(map[string]*tokenKey)}
+	if dir == "" {
+		if secret == "" {
+			return nil, errors.New("neither JWT_KEYS_DIR nor JWT_SECRET is set")
+		}
+		k.signing = &tokenKey{method: jwt.SigningMethodHS256, private: []byte(secret), public: []byte(secret)}
+		k.keys[""] = k.signing
+		return k, nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		key, err := parseTokenKey(strings.TrimSuffix(filepath.Base(file), ".pem"), data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		k.keys[key.id] = key
+		if key.private != nil && (signingID == "" || signingID == key.id) {
+			k.signing = key
+		}
+	}
+	if k.signing == nil {
+		return nil, fmt.Errorf("no private key %q in %s", signingID, dir)
+	}
+	return k, nil
+}
+
+func parseTokenKey(id string, data []byte) (*tokenKey, error) {
+	if private, err := jwt.ParseRSAPrivateKeyFromPEM(data); err == nil {
+		return &tokenKey{id: id, method: jwt.SigningMethodRS256, private: private, public: &private.PublicKey}, nil
+	}
+	if private, err := jwt.ParseEdPrivateKeyFromPEM(data); err == nil {
+		return &tokenKey{id: id, method: jwt.SigningMethodEdDSA, private: private, public: private.(ed25519.PrivateKey).Public()}, nil
+	}
+	if public, err := jwt.ParseRSAPublicKeyFromPEM(data); err == nil {
+		return &tokenKey{id: id, method: jwt.SigningMethodRS256, public: public}, nil
+	}
+	if public, err := jwt.ParseEdPublicKeyFromPEM(data); err == nil {
+		return &tokenKey{id: id, method: jwt.SigningMethodEdDSA, public: public}, nil
+	}
+	return nil, errors.New("not an RSA or Ed25519 key")
+}
+
+// accessClaims are the claims of an access token; sid names the session it
+// was issued for, and is missing from tokens issued without one
+type accessClaims struct {
+	jwt.RegisteredClaims
+	SessionID string `json:"sid,omitempty"`
+}
+
+// Sign issues a token for userID that expires after ttl; sessionID is
+// uuid.Nil for tokens without a session
+func (k *tokenKeys) Sign(userID, sessionID uuid.UUID, ttl time.Duration) (string, error) {
+	now := time.Now()
+	claims := accessClaims{RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   userID.String(),
+		Issuer:    k.issuer,
+		Audience:  jwt.ClaimStrings{k.audience},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+	}}
+	if sessionID != uuid.Nil {
+		claims.SessionID = sessionID.String()
+	}
+	token := jwt.NewWithClaims(k.signing.method, claims)
+	if k.signing.id != "" {
+		token.Header["kid"] = k.signing.id
+	}
+	return token.SignedString(k.signing.private)
+}
+
+// Parse verifies token with the key named by its kid header and returns the
+// user and the session it was issued for
+func (k *tokenKeys) Parse(token string) (userID, sessionID uuid.UUID, err error) {
+	claims := accessClaims{}
+	_, err = jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, ok := k.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+		if t.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return key.public, nil
+	}, jwt.WithIssuer(k.issuer), jwt.WithAudience(k.audience), jwt.WithExpirationRequired())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if userID, err = uuid.Parse(claims.Subject); err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	if claims.SessionID != "" {
+		if sessionID, err = uuid.Parse(claims.SessionID); err != nil {
+			return uuid.Nil, uuid.Nil, err
+		}
+	}
+	return userID, sessionID, nil
+}
+
+// JWKS returns the public keys in rotation; a shared secret is never published
+func (k *tokenKeys) JWKS() usergen.JWKSet {
+	set := usergen.JWKSet{Keys: []usergen.JWK{}}
+	ids := make([]string, 0, len(k.keys))
+	for id := range k.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		switch public := k.keys[id].public.(type) {
+		case *rsa.PublicKey:
+			n := base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			e := base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+			set.Keys = append(set.Keys, usergen.JWK{Kty: usergen.RSA, Kid: id, Use: usergen.Sig, Alg: usergen.RS256, N: &n, E: &e})
+		case ed25519.PublicKey:
+			crv := usergen.Ed25519
+			x := base64.RawURLEncoding.EncodeToString(public)
+			set.Keys = append(set.Keys, usergen.JWK{Kty: usergen.OKP, Kid: id, Use: usergen.Sig, Alg: usergen.EdDSA, Crv: &crv, X: &x})
+		}
+	}
+	return set
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func writeKey(t *testing.T, dir, kid, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	if err := os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTokenKeys_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	writeKey(t, dir, "2026-01", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey))
+
+	old, err := loadTokenKeys(dir, "", "", "wili-user-service", "wili")
+	if err != nil {
+		t.Fatalf("expected keys, got err=%v", err)
+	}
+	userID := uuid.New()
+	oldToken, err := old.Sign(userID, uuid.Nil, time.Hour)
+	if err != nil {
+		t.Fatalf("expected a token, got err=%v", err)
+	}
+
+	// rotate: a new Ed25519 key signs, the old one only verifies
+	_, edKey, _ := ed25519.GenerateKey(rand.Reader)
+	der, _ := x509.MarshalPKCS8PrivateKey(edKey)
+	writeKey(t, dir, "2026-02", "PRIVATE KEY", der)
+	publicDER, _ := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	writeKey(t, dir, "2026-01", "PUBLIC KEY", publicDER)
+
+	rotated, err := loadTokenKeys(dir, "", "", "wili-user-service", "wili")
+	if err != nil {
+		t.Fatalf("expected keys, got err=%v", err)
+	}
+	if rotated.signing.id != "2026-02" {
+		t.Fatalf("expected the newest private key to sign, got %q", rotated.signing.id)
+	}
+	newToken, _ := rotated.Sign(userID, uuid.Nil, time.Hour)
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		if id, _, err := rotated.Parse(token); err != nil || id != userID {
+			t.Errorf("expected %s token of %s, got %s err=%v", name, userID, id, err)
+		}
+	}
+	if _, _, err := old.Parse(newToken); err == nil {
+		t.Error("expected a token of an unknown key to be rejected")
+	}
+
+	jwks := rotated.JWKS()
+	if len(jwks.Keys) != 2 || jwks.Keys[0].Kid != "2026-01" || jwks.Keys[0].N == nil || jwks.Keys[1].X == nil {
+		t.Errorf("expected the RSA and Ed25519 keys in the JWKS, got %+v", jwks.Keys)
+	}
+}
+
+func TestTokenKeys_Claims(t *testing.T) {
+	keys, err := loadTokenKeys("", "", "secret", "wili-user-service", "wili")
+	if err != nil {
+		t.Fatalf("expected keys, got err=%v", err)
+	}
+	userID := uuid.New()
+
+	other, _ := loadTokenKeys("", "", "secret", "wili-user-service", "another-audience")
+	foreign, _ := other.Sign(userID, uuid.Nil, time.Hour)
+	if _, _, err := keys.Parse(foreign); err == nil {
+		t.Error("expected a token for another audience to be rejected")
+	}
+	expired, _ := keys.Sign(userID, uuid.Nil, -time.Minute)
+	if _, _, err := keys.Parse(expired); err == nil {
+		t.Error("expected an expired token to be rejected")
+	}
+	sessionID := uuid.New()
+	token, _ := keys.Sign(userID, sessionID, time.Hour)
+	if id, sid, err := keys.Parse(token); err != nil || id != userID || sid != sessionID {
+		t.Errorf("expected user %s in session %s, got %s in %s err=%v", userID, sessionID, id, sid, err)
+	}
+	if jwks := keys.JWKS(); len(jwks.Keys) != 0 {
+		t.Errorf("expected the shared secret to stay unpublished, got %+v", jwks.Keys)
+	}
+	if _, err := loadTokenKeys("", "", "", "wili-user-service", "wili"); err == nil {
+		t.Error("expected an error without keys")
+	}
+}
@@ -1,29 +1,43 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/theseems/wili/backend/devutil"
+	"github.com/theseems/wili/backend/jobs"
+	"github.com/theseems/wili/backend/telemetry"
 
 	usergen "github.com/theseems/wili/backend/services/user/gen"
 )
 
 func main() {
-	log.Printf("Starting Wili User Service (build tag dev=%v)...", isDevBuild())
-
 	if err := godotenv.Load(); err != nil {
 		log.Printf("Warning: .env file not found: %v", err)
 	}
 
+	telemetry.SetupLogging("user")
+	slog.Info("starting Wili User Service", "dev", isDevBuild())
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), "user")
+	if err != nil {
+		log.Fatalf("failed to set up tracing: %v", err)
+	}
+
 	r := chi.NewRouter()
 	devutil.EnableCORS(r)
+	r.Use(telemetry.RequestID, telemetry.Tracing, telemetry.AccessLog, telemetry.Metrics)
 
+	// Kept for probes configured before /livez and /readyz; it checks nothing
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -38,17 +52,80 @@ func main() {
 	if err != nil {
 		log.Fatalf("db: %v", err)
 	}
+	telemetry.RegisterDBStats(repo.db.DB, "users")
+
+	probes := telemetry.NewProbes()
+	probes.AddCheck("postgres", repo.db.PingContext)
+	r.Handle("/livez", probes.Live())
+	r.Handle("/readyz", probes.Ready())
+
+	r.Handle("/debug/log-level", telemetry.LevelHandler(getEnv("SERVICE_TOKEN", "")))
+	if metricsAddr := getEnv("METRICS_ADDR", ""); metricsAddr != "" {
+		go telemetry.ServeMetrics(metricsAddr)
+	} else {
+		r.Handle("/metrics", telemetry.MetricsHandler())
+	}
 
-	srv := newServer(repo)
+	tokens, err := loadTokenKeys(
+		getEnv("JWT_KEYS_DIR", ""),
+		getEnv("JWT_SIGNING_KEY_ID", ""),
+		strings.TrimSpace(getEnv("JWT_SECRET", "")),
+		getEnv("JWT_ISSUER", "wili-user-service"),
+		getEnv("JWT_AUDIENCE", "wili"),
+	)
+	if err != nil {
+		log.Fatalf("jwt keys: %v", err)
+	}
+	if tokens.signing.id == "" {
+		slog.Warn("signing tokens with JWT_SECRET; set JWT_KEYS_DIR so that other services verify them with public keys")
+	} else {
+		slog.Info("signing tokens", "kid", tokens.signing.id, "alg", tokens.signing.method.Alg(), "keys", len(tokens.keys))
+	}
+
+	srv := newServer(repo, repo, tokens)
+	srv.accessTokenTTL = getDurationEnv("ACCESS_TOKEN_TTL", defaultAccessTokenTTL)
+	srv.sessionTTL = getDurationEnv("SESSION_TTL", defaultSessionTTL)
 	r.Mount("/", usergen.Handler(srv))
 
 	devutil.MountSwagger(r, "Wili User Service API")
 
 	port := getEnv("PORT", "8080")
 	addr := ":" + port
-	log.Printf("User service listening on %s (cors=%s)", addr, corsProfile())
-	if err := http.ListenAndServe(addr, r); err != nil {
-		log.Fatalf("failed to start server: %v", err)
+	slog.Info("user service listening", "addr", addr, "cors", corsProfile())
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	jobStore, err := jobs.NewPostgresStore(ctx, repo.db.DB, getDurationEnv("JOB_RUN_RETENTION", 30*24*time.Hour))
+	if err != nil {
+		log.Fatalf("job store: %v", err)
+	}
+	scheduler := jobs.New(jobStore)
+	schedule, err := jobs.ParseSchedule(getEnv("SESSION_PURGE_INTERVAL", "1h"))
+	if err != nil {
+		log.Fatalf("Invalid schedule in SESSION_PURGE_INTERVAL: %v", err)
+	}
+	if err := scheduler.Add(jobs.Job{Name: "delete_ended_sessions", Schedule: schedule, Run: deleteEndedSessionsJob(repo)}); err != nil {
+		log.Fatalf("Failed to schedule delete_ended_sessions: %v", err)
+	}
+	scheduler.Start(ctx)
+
+	if err := telemetry.Serve(ctx, telemetry.NewServer(addr, r), probes); err != nil {
+		slog.Error("HTTP server stopped", "error", err)
+	}
+	// Serve also returns when listening fails; stop the jobs then too
+	stop()
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := scheduler.Stop(flushCtx); err != nil {
+		slog.Error("cancelled background jobs still running", "error", err)
+	}
+	if err := shutdownTracing(flushCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
+	}
+	if err := repo.db.Close(); err != nil {
+		slog.Error("failed to close database", "error", err)
 	}
 }
 
@@ -59,6 +136,18 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Fatalf("Invalid duration in %s: %q", key, value)
+	}
+	return d
+}
+
 func isDevBuild() bool {
 	// build tags are not directly detectable; rely on presence of dev CORS origins
 	return len(devutil.AllowedOrigins()) > 0 && devutil.AllowedOrigins()[0] == "http://localhost:5173"
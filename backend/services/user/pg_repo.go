@@ -2,13 +2,18 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"os"
+	"time"
 
+	"github.com/XSAM/otelsql"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
 	"github.com/oapi-codegen/runtime/types"
 	usergen "github.com/theseems/wili/backend/services/user/gen"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type pgRepo struct {
@@ -37,10 +42,20 @@ func newPGRepo() (*pgRepo, error) {
 	if dsn == "" {
 		panic("DATABASE_URL is not set")
 	}
-	db, err := sqlx.Connect("postgres", dsn)
+	// Every query is traced as a span of the request that ran it; spans carry
+	// the statement but never its arguments
+	sqlDB, err := otelsql.Open("postgres", dsn,
+		otelsql.WithAttributes(attribute.String("db.system.name", "postgresql")),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{OmitConnResetSession: true, OmitRows: true}),
+	)
 	if err != nil {
 		return nil, err
 	}
+	db := sqlx.NewDb(sqlDB, "postgres")
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
 	repo := &pgRepo{db: db}
 	if err := repo.migrate(); err != nil {
 		return nil, err
@@ -62,7 +77,38 @@ func (p *pgRepo) migrate() error {
 	if _, err := p.db.Exec(`ALTER TABLE users ADD COLUMN IF NOT EXISTS telegram_id BIGINT`); err != nil {
 		return err
 	}
-	_, err := p.db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS users_telegram_id_uq ON users (telegram_id) WHERE telegram_id IS NOT NULL`)
+	if _, err := p.db.Exec(`ALTER TABLE users ADD COLUMN IF NOT EXISTS telegram_language TEXT`); err != nil {
+		return err
+	}
+	if _, err := p.db.Exec(`CREATE UNIQUE INDEX IF NOT EXISTS users_telegram_id_uq ON users (telegram_id) WHERE telegram_id IS NOT NULL`); err != nil {
+		return err
+	}
+	if _, err := p.db.Exec(`CREATE TABLE IF NOT EXISTS sessions (
+		id UUID PRIMARY KEY,
+		user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+		created_at TIMESTAMPTZ NOT NULL,
+		last_used_at TIMESTAMPTZ NOT NULL,
+		expires_at TIMESTAMPTZ NOT NULL,
+		revoked_at TIMESTAMPTZ
+	)`); err != nil {
+		return err
+	}
+	if _, err := p.db.Exec(`CREATE INDEX IF NOT EXISTS sessions_user_id_idx ON sessions (user_id)`); err != nil {
+		return err
+	}
+	for _, column := range []string{"method", "user_agent", "ip"} {
+		if _, err := p.db.Exec(`ALTER TABLE sessions ADD COLUMN IF NOT EXISTS ` + column + ` TEXT NOT NULL DEFAULT ''`); err != nil {
+			return err
+		}
+	}
+	// Exchanged refresh tokens are kept with used_at set, so that presenting
+	// one again is recognised as reuse
+	_, err := p.db.Exec(`CREATE TABLE IF NOT EXISTS refresh_tokens (
+		token_hash BYTEA PRIMARY KEY,
+		session_id UUID NOT NULL REFERENCES sessions (id) ON DELETE CASCADE,
+		created_at TIMESTAMPTZ NOT NULL,
+		used_at TIMESTAMPTZ
+	)`)
 	return err
 }
 
@@ -83,12 +129,13 @@ func (p *pgRepo) UpsertWithEmail(ctx context.Context, u *usergen.User, email str
 	return err
 }
 
-func (p *pgRepo) UpsertWithTelegramID(ctx context.Context, u *usergen.User, telegramID int64) error {
-	_, err := p.db.ExecContext(ctx, `INSERT INTO users (id, display_name, avatar_url, telegram_id)
-		VALUES ($1,$2,$3,$4)
+func (p *pgRepo) UpsertWithTelegramID(ctx context.Context, u *usergen.User, telegramID int64, languageCode *string) error {
+	_, err := p.db.ExecContext(ctx, `INSERT INTO users (id, display_name, avatar_url, telegram_id, telegram_language)
+		VALUES ($1,$2,$3,$4,$5)
 		ON CONFLICT (telegram_id) WHERE telegram_id IS NOT NULL DO UPDATE SET
-			display_name=EXCLUDED.display_name, avatar_url=EXCLUDED.avatar_url`,
-		u.Id, u.DisplayName, u.AvatarUrl, telegramID)
+			display_name=EXCLUDED.display_name, avatar_url=EXCLUDED.avatar_url,
+			telegram_language=COALESCE(EXCLUDED.telegram_language, users.telegram_language)`,
+		u.Id, u.DisplayName, u.AvatarUrl, telegramID, languageCode)
 	return err
 }
 
@@ -127,3 +174,162 @@ func (p *pgRepo) GetByTelegramID(ctx context.Context, telegramID int64) (*userge
 	}
 	return u.toUsergen(), nil
 }
+
+func (p *pgRepo) GetTelegramLink(ctx context.Context, id uuid.UUID) (*usergen.TelegramLink, error) {
+	var row struct {
+		TelegramID *int64  `db:"telegram_id"`
+		Language   *string `db:"telegram_language"`
+	}
+	err := p.db.GetContext(ctx, &row, `SELECT telegram_id, telegram_language FROM users WHERE id=$1`, id)
+	if err != nil {
+		if err.Error() == "sql: no rows in result set" {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if row.TelegramID == nil {
+		return nil, ErrNotFound
+	}
+	return &usergen.TelegramLink{TelegramId: *row.TelegramID, LanguageCode: row.Language}, nil
+}
+
+func (p *pgRepo) CreateSession(ctx context.Context, userID uuid.UUID, method string, device Device, tokenHash []byte, expiresAt time.Time) (*Session, error) {
+	now := time.Now()
+	session := &Session{ID: uuid.New(), UserID: userID, Method: method, Device: device, CreatedAt: now, LastUsedAt: now, ExpiresAt: expiresAt}
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, `INSERT INTO sessions (id, user_id, method, user_agent, ip, created_at, last_used_at, expires_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$6,$7)`, session.ID, userID, method, device.UserAgent, device.IP, now, expiresAt); err != nil {
+		return nil, err
+	}
+	if tokenHash == nil {
+		return session, tx.Commit()
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO refresh_tokens (token_hash, session_id, created_at) VALUES ($1,$2,$3)`,
+		tokenHash, session.ID, now); err != nil {
+		return nil, err
+	}
+	return session, tx.Commit()
+}
+
+func (p *pgRepo) RotateRefreshToken(ctx context.Context, tokenHash, newHash []byte, device Device, expiresAt time.Time) (*Session, error) {
+	tx, err := p.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	// Locking the token makes concurrent exchanges of it wait, so that only
+	// the first succeeds
+	var row struct {
+		Session
+		UsedAt    *time.Time `db:"used_at"`
+		RevokedAt *time.Time `db:"revoked_at"`
+	}
+	err = tx.GetContext(ctx, &row, `SELECT s.id, s.user_id, s.method, s.created_at, s.last_used_at, s.expires_at, s.revoked_at, t.used_at
+		FROM refresh_tokens t JOIN sessions s ON s.id = t.session_id
+		WHERE t.token_hash=$1 FOR UPDATE`, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if row.RevokedAt != nil || !now.Before(row.ExpiresAt) {
+		return nil, ErrNotFound
+	}
+	if row.UsedAt != nil {
+		if _, err := tx.ExecContext(ctx, `UPDATE sessions SET revoked_at=$2 WHERE id=$1`, row.ID, now); err != nil {
+			return nil, err
+		}
+		if err := tx.Commit(); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	if _, err := tx.ExecContext(ctx, `UPDATE refresh_tokens SET used_at=$2 WHERE token_hash=$1`, tokenHash, now); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `INSERT INTO refresh_tokens (token_hash, session_id, created_at) VALUES ($1,$2,$3)`,
+		newHash, row.ID, now); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE sessions SET user_agent=$2, ip=$3, last_used_at=$4, expires_at=$5 WHERE id=$1`,
+		row.ID, device.UserAgent, device.IP, now, expiresAt); err != nil {
+		return nil, err
+	}
+	session := row.Session
+	session.Device, session.LastUsedAt, session.ExpiresAt = device, now, expiresAt
+	return &session, tx.Commit()
+}
+
+func (p *pgRepo) RevokeSessionByToken(ctx context.Context, tokenHash []byte) error {
+	_, err := p.db.ExecContext(ctx, `UPDATE sessions SET revoked_at=now()
+		WHERE id=(SELECT session_id FROM refresh_tokens WHERE token_hash=$1) AND revoked_at IS NULL`, tokenHash)
+	return err
+}
+
+func (p *pgRepo) RevokeSession(ctx context.Context, userID, id uuid.UUID) error {
+	res, err := p.db.ExecContext(ctx, `UPDATE sessions SET revoked_at=now() WHERE id=$1 AND user_id=$2 AND revoked_at IS NULL AND expires_at > now()`, id, userID)
+	if err != nil {
+		return err
+	}
+	revoked, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if revoked == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (p *pgRepo) RevokeUserSessions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	res, err := p.db.ExecContext(ctx, `UPDATE sessions SET revoked_at=now() WHERE user_id=$1 AND revoked_at IS NULL AND expires_at > now()`, userID)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func (p *pgRepo) TouchSession(ctx context.Context, id uuid.UUID) (bool, error) {
+	// Every authenticated request touches its session, so the row is only
+	// written once the stored last use is a minute old
+	var active bool
+	err := p.db.GetContext(ctx, &active, `WITH active AS (
+			SELECT id, last_used_at FROM sessions WHERE id=$1 AND revoked_at IS NULL AND expires_at > now()
+		), touched AS (
+			UPDATE sessions s SET last_used_at=now() FROM active a
+			WHERE s.id = a.id AND a.last_used_at < now() - interval '1 minute'
+		)
+		SELECT EXISTS (SELECT 1 FROM active)`, id)
+	return active, err
+}
+
+func (p *pgRepo) ListSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	var sessions []Session
+	err := p.db.SelectContext(ctx, &sessions, `SELECT id, user_id, method, user_agent, ip, created_at, last_used_at, expires_at
+		FROM sessions WHERE user_id=$1 AND revoked_at IS NULL AND expires_at > now()
+		ORDER BY last_used_at DESC`, userID)
+	return sessions, err
+}
+
+func (p *pgRepo) DeleteEndedSessions(ctx context.Context, before time.Time) (int64, error) {
+	res, err := p.db.ExecContext(ctx, `DELETE FROM sessions WHERE COALESCE(revoked_at, expires_at) < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := p.db.ExecContext(ctx, `DELETE FROM refresh_tokens WHERE used_at < $1`, before); err != nil {
+		return deleted, err
+	}
+	return deleted, nil
+}
@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/google/uuid"
 	usergen "github.com/theseems/wili/backend/services/user/gen"
@@ -15,8 +16,61 @@ var ErrNotFound = errors.New("not found")
 type UserRepo interface {
 	Upsert(ctx context.Context, u *User) error
 	UpsertWithEmail(ctx context.Context, u *User, email string) error
-	UpsertWithTelegramID(ctx context.Context, u *User, telegramID int64) error
+	UpsertWithTelegramID(ctx context.Context, u *User, telegramID int64, languageCode *string) error
 	Get(ctx context.Context, id uuid.UUID) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByTelegramID(ctx context.Context, telegramID int64) (*User, error)
+	// GetTelegramLink returns ErrNotFound when the user has no Telegram account linked
+	GetTelegramLink(ctx context.Context, id uuid.UUID) (*usergen.TelegramLink, error)
+}
+
+// ErrRefreshTokenReused is returned for a refresh token that was already
+// exchanged; its session is revoked by then
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
+// Device is what a session was last used from
+type Device struct {
+	UserAgent string `db:"user_agent"`
+	IP        string `db:"ip"`
+}
+
+// Session is a login on one device, kept alive by exchanging its refresh
+// token for new tokens before it expires. Sessions of web logins sent by the
+// Telegram bot have no refresh token and end with their access token.
+type Session struct {
+	ID         uuid.UUID `db:"id"`
+	UserID     uuid.UUID `db:"user_id"`
+	Method     string    `db:"method"`
+	CreatedAt  time.Time `db:"created_at"`
+	LastUsedAt time.Time `db:"last_used_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
+	Device
+}
+
+// SessionRepo stores sessions by the hashes of their refresh tokens
+type SessionRepo interface {
+	// CreateSession starts a session of userID whose refresh token hashes to
+	// tokenHash; a nil tokenHash starts one that can not be refreshed
+	CreateSession(ctx context.Context, userID uuid.UUID, method string, device Device, tokenHash []byte, expiresAt time.Time) (*Session, error)
+	// RotateRefreshToken replaces the refresh token hashing to tokenHash with
+	// the one hashing to newHash, records device and extends the session to
+	// expiresAt. It returns ErrNotFound when the token is unknown or its
+	// session ended, and ErrRefreshTokenReused when the token was already
+	// replaced.
+	RotateRefreshToken(ctx context.Context, tokenHash, newHash []byte, device Device, expiresAt time.Time) (*Session, error)
+	// RevokeSessionByToken ends the session of a refresh token, if any
+	RevokeSessionByToken(ctx context.Context, tokenHash []byte) error
+	// RevokeSession ends the session id of userID, or returns ErrNotFound when
+	// userID has no such active session
+	RevokeSession(ctx context.Context, userID, id uuid.UUID) error
+	// RevokeUserSessions ends every session of userID
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) (int64, error)
+	// TouchSession reports whether a session exists and has not ended, and
+	// marks it as used now. The last use is only stored about once a minute.
+	TouchSession(ctx context.Context, id uuid.UUID) (bool, error)
+	// ListSessions returns the active sessions of userID, most recently used first
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	// DeleteEndedSessions deletes sessions that ended, and exchanged refresh
+	// tokens that were used, before the given time
+	DeleteEndedSessions(ctx context.Context, before time.Time) (int64, error)
 }
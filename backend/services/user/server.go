@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/subtle"
@@ -8,7 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
@@ -17,14 +18,21 @@ import (
 	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/oapi-codegen/runtime/types"
 	usergen "github.com/theseems/wili/backend/services/user/gen"
+	"github.com/theseems/wili/backend/telemetry"
 )
 
 type server struct {
-	repo UserRepo
+	repo     UserRepo
+	sessions SessionRepo
+	tokens   *tokenKeys
+	// lifetimes of access tokens and of sessions that are not refreshed
+	accessTokenTTL time.Duration
+	sessionTTL     time.Duration
+	// httpClient calls Yandex OAuth
+	httpClient *http.Client
 }
 
 type yandexTokenResponse struct {
@@ -48,45 +56,45 @@ type telegramUser struct {
 	LastName  string `json:"last_name"`
 	Username  string `json:"username"`
 	PhotoURL  string `json:"photo_url"`
-}
-
-func newServer(r UserRepo) *server { return &server{repo: r} }
 
-var jwtKey = []byte(strings.TrimSpace(os.Getenv("JWT_SECRET")))
+	LanguageCode string `json:"language_code"`
+}
 
-func sign(userID string) (string, int64) {
-	if len(jwtKey) == 0 {
-		log.Fatalf("Missing required env: JWT_SECRET")
+func newServer(r UserRepo, sessions SessionRepo, tokens *tokenKeys) *server {
+	return &server{
+		repo:           r,
+		sessions:       sessions,
+		tokens:         tokens,
+		accessTokenTTL: defaultAccessTokenTTL,
+		sessionTTL:     defaultSessionTTL,
+		httpClient:     &http.Client{Timeout: 10 * time.Second, Transport: &telemetry.Transport{}},
 	}
-	exp := time.Hour * 24 * 365 * 10
-	claims := jwt.MapClaims{"sub": userID, "exp": time.Now().Add(exp).Unix()}
-	t, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(jwtKey)
-	return t, int64(exp.Seconds())
 }
 
-func parseBearer(r *http.Request) (uuid.UUID, bool) {
+// parseBearer returns the user of the access token in the Authorization
+// header, unless the token is invalid or its session ended
+func (s *server) parseBearer(r *http.Request) (uuid.UUID, bool) {
+	id, _, ok := s.authenticate(r)
+	return id, ok
+}
+
+// authenticate is parseBearer that also returns the session of the token,
+// uuid.Nil for tokens without one
+func (s *server) authenticate(r *http.Request) (userID, sessionID uuid.UUID, ok bool) {
 	h := r.Header.Get("Authorization")
 	if !strings.HasPrefix(h, "Bearer ") {
-		return uuid.UUID{}, false
+		return uuid.Nil, uuid.Nil, false
 	}
-	tok := strings.TrimPrefix(h, "Bearer ")
-	t, err := jwt.Parse(tok, func(t *jwt.Token) (interface{}, error) { return jwtKey, nil })
-	if err != nil || !t.Valid {
-		return uuid.UUID{}, false
+	userID, sessionID, err := s.tokens.Parse(strings.TrimPrefix(h, "Bearer "))
+	if err != nil || !s.sessionActive(r.Context(), sessionID) {
+		return uuid.Nil, uuid.Nil, false
 	}
-	idStr, ok := t.Claims.(jwt.MapClaims)["sub"].(string)
-	if !ok {
-		return uuid.UUID{}, false
-	}
-	id, err := uuid.Parse(idStr)
-	if err != nil {
-		return uuid.UUID{}, false
-	}
-	return id, true
+	telemetry.SetUserID(r.Context(), userID.String())
+	return userID, sessionID, true
 }
 
 func (s *server) PostAuthYandex(w http.ResponseWriter, r *http.Request) {
-	log.Printf("[AUTH] Yandex auth request from %s", r.RemoteAddr)
+	slog.DebugContext(r.Context(), "yandex auth request", "remote", r.RemoteAddr)
 
 	type yandexAuthRequest struct {
 		Code        string `json:"code"`
@@ -95,7 +103,7 @@ func (s *server) PostAuthYandex(w http.ResponseWriter, r *http.Request) {
 
 	var req yandexAuthRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Printf("[AUTH] Failed to decode request body: %v", err)
+		slog.InfoContext(r.Context(), "invalid yandex auth request", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
@@ -104,9 +112,9 @@ func (s *server) PostAuthYandex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	accessToken, err := s.exchangeYandexCode(req.Code, req.RedirectURI)
+	accessToken, err := s.exchangeYandexCode(r.Context(), req.Code, req.RedirectURI)
 	if err != nil {
-		log.Printf("[AUTH] Failed to exchange Yandex code: %v", err)
+		slog.ErrorContext(r.Context(), "failed to exchange yandex code", "error", err)
 		if strings.Contains(err.Error(), "missing Yandex OAuth credentials") {
 			w.WriteHeader(http.StatusInternalServerError)
 		} else {
@@ -115,23 +123,22 @@ func (s *server) PostAuthYandex(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	yandexUser, err := s.getYandexUserInfo(accessToken)
+	yandexUser, err := s.getYandexUserInfo(r.Context(), accessToken)
 	if err != nil {
-		log.Printf("[AUTH] Failed to get Yandex user info: %v", err)
+		slog.ErrorContext(r.Context(), "failed to get yandex user info", "error", err)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 
 	var u *usergen.User
-	log.Printf("[AUTH] Checking for existing user with email: %s", yandexUser.DefaultEmail)
 	existingUser, err := s.repo.GetByEmail(r.Context(), yandexUser.DefaultEmail)
 	if err != nil {
-		log.Printf("[AUTH] Error checking for existing user with email %s: %v", yandexUser.DefaultEmail, err)
+		slog.ErrorContext(r.Context(), "failed to look up user by email", "error", err)
 	} else {
-		log.Printf("[AUTH] GetByEmail successful, existingUser is nil: %v", existingUser == nil)
+		slog.DebugContext(r.Context(), "looked up user by email", "found", existingUser != nil)
 	}
 	if err == nil && existingUser != nil {
-		log.Printf("[AUTH] Existing user found with email %s, ID: %s", yandexUser.DefaultEmail, existingUser.Id.String())
+		slog.DebugContext(r.Context(), "existing user found by email", "user_id", existingUser.Id.String())
 		u = existingUser
 
 		if yandexUser.DisplayName != "" && yandexUser.DisplayName != u.DisplayName {
@@ -148,7 +155,7 @@ func (s *server) PostAuthYandex(w http.ResponseWriter, r *http.Request) {
 			u.AvatarUrl = &avatarURL
 		}
 	} else {
-		log.Printf("[AUTH] Creating new user with email %s", yandexUser.DefaultEmail)
+		slog.DebugContext(r.Context(), "creating user for yandex account")
 		newEmail := types.Email(yandexUser.DefaultEmail)
 		newUser := usergen.User{
 			Id:          uuid.New(),
@@ -178,20 +185,25 @@ func (s *server) PostAuthYandex(w http.ResponseWriter, r *http.Request) {
 
 	err = s.repo.UpsertWithEmail(r.Context(), u, yandexUser.DefaultEmail)
 	if err != nil {
-		log.Printf("[AUTH] Failed to save user: %v", err)
+		slog.ErrorContext(r.Context(), "failed to save user", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte("Failed to save user"))
 		return
 	}
 
-	tok, exp := sign(u.Id.String())
-	resp := usergen.AuthResponse{AccessToken: tok, ExpiresIn: exp, User: *u}
-	log.Printf("[AUTH] Successfully authenticated user: %s (%s) with email: %s", u.DisplayName, u.Id.String(), yandexUser.DefaultEmail)
+	resp, err := s.issueTokens(r.Context(), u, &login{method: usergen.Yandex, device: clientDevice(r), refreshable: true})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to issue tokens", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	telemetry.SetUserID(r.Context(), u.Id.String())
+	slog.InfoContext(r.Context(), "user authenticated", "provider", "yandex")
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
 func (s *server) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
-	log.Printf("[AUTH] Telegram auth request from %s", r.RemoteAddr)
+	slog.DebugContext(r.Context(), "telegram auth request", "remote", r.RemoteAddr)
 
 	type telegramAuthRequest struct {
 		InitData string `json:"initData"`
@@ -199,7 +211,7 @@ func (s *server) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
 
 	var req telegramAuthRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Printf("[AUTH] Failed to decode telegram auth request: %v", err)
+		slog.InfoContext(r.Context(), "invalid telegram auth request", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
@@ -210,14 +222,14 @@ func (s *server) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
 
 	botToken := strings.TrimSpace(os.Getenv("TELEGRAM_BOT_TOKEN"))
 	if botToken == "" {
-		log.Printf("[AUTH] Telegram auth failed: TELEGRAM_BOT_TOKEN is missing")
+		slog.ErrorContext(r.Context(), "telegram auth failed: TELEGRAM_BOT_TOKEN is missing")
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
 	params, err := validateTelegramInitData(req.InitData, botToken, 24*time.Hour)
 	if err != nil {
-		log.Printf("[AUTH] Telegram initData validation failed: %v", err)
+		slog.InfoContext(r.Context(), "telegram initData validation failed", "error", err)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -230,7 +242,7 @@ func (s *server) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
 
 	var tu telegramUser
 	if err := json.Unmarshal([]byte(userRaw), &tu); err != nil || tu.ID == 0 {
-		log.Printf("[AUTH] Telegram user parse failed: %v", err)
+		slog.InfoContext(r.Context(), "failed to parse telegram user", "error", err)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -251,7 +263,7 @@ func (s *server) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
 
 	existingUser, err := s.repo.GetByTelegramID(r.Context(), tu.ID)
 	if err != nil && err != ErrNotFound {
-		log.Printf("[AUTH] Telegram GetByTelegramID error: %v", err)
+		slog.ErrorContext(r.Context(), "failed to look up user by telegram id", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
@@ -269,19 +281,30 @@ func (s *server) PostAuthTelegram(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if err := s.repo.UpsertWithTelegramID(r.Context(), u, tu.ID); err != nil {
-		log.Printf("[AUTH] Telegram UpsertWithTelegramID failed: %v", err)
+	var languageCode *string
+	if lc := strings.TrimSpace(tu.LanguageCode); lc != "" {
+		languageCode = &lc
+	}
+
+	if err := s.repo.UpsertWithTelegramID(r.Context(), u, tu.ID, languageCode); err != nil {
+		slog.ErrorContext(r.Context(), "failed to save telegram user", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
 
-	tok, exp := sign(u.Id.String())
-	resp := usergen.AuthResponse{AccessToken: tok, ExpiresIn: exp, User: *u}
+	resp, err := s.issueTokens(r.Context(), u, &login{method: usergen.Telegram, device: clientDevice(r), refreshable: true})
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to issue tokens", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	telemetry.SetUserID(r.Context(), u.Id.String())
+	slog.InfoContext(r.Context(), "user authenticated", "provider", "telegram")
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
 func (s *server) PostAuthTelegramBot(w http.ResponseWriter, r *http.Request, params usergen.PostAuthTelegramBotParams) {
-	log.Printf("[AUTH] Telegram-bot auth request from %s", r.RemoteAddr)
+	slog.DebugContext(r.Context(), "telegram bot auth request", "remote", r.RemoteAddr)
 
 	expected := strings.TrimSpace(os.Getenv("TELEGRAM_BOT_TOKEN"))
 	if expected == "" || strings.TrimSpace(params.XWiliBotToken) != expected {
@@ -309,32 +332,44 @@ func (s *server) PostAuthTelegramBot(w http.ResponseWriter, r *http.Request, par
 		return
 	}
 
-	tok, exp := sign(u.Id.String())
-	resp := usergen.AuthResponse{AccessToken: tok, ExpiresIn: exp, User: *u}
+	// the bot's own calls get tokens without a session. A web login link
+	// starts one, though the browser it is opened in is not known here.
+	var l *login
+	if req.WebLogin != nil && *req.WebLogin {
+		l = &login{method: usergen.TelegramBot}
+	}
+	resp, err := s.issueTokens(r.Context(), u, l)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to issue tokens", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	telemetry.SetUserID(r.Context(), u.Id.String())
+	slog.InfoContext(r.Context(), "user authenticated", "provider", "telegram_bot")
 	_ = json.NewEncoder(w).Encode(resp)
 }
 
 func (s *server) GetUsersMe(w http.ResponseWriter, r *http.Request) {
-	id, ok := parseBearer(r)
+	id, ok := s.parseBearer(r)
 	if !ok {
-		log.Printf("[USER] Unauthorized access to /users/me from %s", r.RemoteAddr)
+		slog.InfoContext(r.Context(), "unauthorized profile request", "remote", r.RemoteAddr)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
 	u, err := s.repo.Get(r.Context(), id)
 	if err != nil {
-		log.Printf("[USER] Failed to get user %s: %v", id.String(), err)
+		slog.ErrorContext(r.Context(), "failed to get user", "error", err)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
-	log.Printf("[USER] Retrieved profile for user: %s", u.DisplayName)
+	slog.DebugContext(r.Context(), "retrieved profile")
 	_ = json.NewEncoder(w).Encode(u)
 }
 
 func (s *server) PutUsersMe(w http.ResponseWriter, r *http.Request) {
-	id, ok := parseBearer(r)
+	id, ok := s.parseBearer(r)
 	if !ok {
-		log.Printf("[USER] Unauthorized profile update attempt from %s", r.RemoteAddr)
+		slog.InfoContext(r.Context(), "unauthorized profile update", "remote", r.RemoteAddr)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -342,7 +377,7 @@ func (s *server) PutUsersMe(w http.ResponseWriter, r *http.Request) {
 	_ = json.NewDecoder(r.Body).Decode(&upd)
 	u, err := s.repo.Get(r.Context(), id)
 	if err != nil {
-		log.Printf("[USER] Failed to get user %s for update: %v", id.String(), err)
+		slog.ErrorContext(r.Context(), "failed to get user for update", "error", err)
 		w.WriteHeader(http.StatusUnauthorized)
 		return
 	}
@@ -354,12 +389,12 @@ func (s *server) PutUsersMe(w http.ResponseWriter, r *http.Request) {
 	}
 	err = s.repo.Upsert(r.Context(), u)
 	if err != nil {
-		log.Printf("[USER] Failed to update user profile: %v", err)
+		slog.ErrorContext(r.Context(), "failed to update profile", "error", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		w.Write([]byte("Failed to update profile"))
 		return
 	}
-	log.Printf("[USER] Updated profile for user: %s", u.DisplayName)
+	slog.InfoContext(r.Context(), "updated profile")
 	_ = json.NewEncoder(w).Encode(u)
 }
 
@@ -425,7 +460,7 @@ func validateTelegramInitData(initData string, botToken string, maxAge time.Dura
 }
 
 func (s *server) PostAuthValidate(w http.ResponseWriter, r *http.Request) {
-	log.Printf("[VALIDATE] Token validation request from %s", r.RemoteAddr)
+	slog.DebugContext(r.Context(), "token validation request", "remote", r.RemoteAddr)
 
 	type validateReq struct {
 		Token string `json:"token"`
@@ -437,51 +472,18 @@ func (s *server) PostAuthValidate(w http.ResponseWriter, r *http.Request) {
 
 	var req validateReq
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		log.Printf("[VALIDATE] Failed to decode request body: %v", err)
+		slog.InfoContext(r.Context(), "invalid token validation request", "error", err)
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode(map[string]string{"error": "Invalid request body"})
 		return
 	}
 
-	token, err := jwt.Parse(req.Token, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return jwtKey, nil
-	})
-
-	if err != nil || !token.Valid {
-		log.Printf("[VALIDATE] Invalid token: %v", err)
-		json.NewEncoder(w).Encode(validateResp{
-			Valid: false,
-			User:  usergen.User{},
-		})
-		return
-	}
-
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		log.Printf("[VALIDATE] Invalid token claims")
-		json.NewEncoder(w).Encode(validateResp{
-			Valid: false,
-			User:  usergen.User{},
-		})
-		return
-	}
-
-	userIDStr, ok := claims["sub"].(string)
-	if !ok {
-		log.Printf("[VALIDATE] Missing user ID in token")
-		json.NewEncoder(w).Encode(validateResp{
-			Valid: false,
-			User:  usergen.User{},
-		})
-		return
+	userID, sessionID, err := s.tokens.Parse(req.Token)
+	if err == nil && !s.sessionActive(r.Context(), sessionID) {
+		err = errSessionEnded
 	}
-
-	userID, err := uuid.Parse(userIDStr)
 	if err != nil {
-		log.Printf("[VALIDATE] Invalid user ID format: %v", err)
+		slog.InfoContext(r.Context(), "invalid token", "error", err)
 		json.NewEncoder(w).Encode(validateResp{
 			Valid: false,
 			User:  usergen.User{},
@@ -491,7 +493,7 @@ func (s *server) PostAuthValidate(w http.ResponseWriter, r *http.Request) {
 
 	user, err := s.repo.Get(r.Context(), userID)
 	if err != nil {
-		log.Printf("[VALIDATE] Failed to get user %s: %v", userID.String(), err)
+		slog.InfoContext(r.Context(), "token of unknown user", "user_id", userID.String(), "error", err)
 		json.NewEncoder(w).Encode(validateResp{
 			Valid: false,
 			User:  usergen.User{},
@@ -499,7 +501,8 @@ func (s *server) PostAuthValidate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	log.Printf("[VALIDATE] Successfully validated token for user: %s", user.DisplayName)
+	telemetry.SetUserID(r.Context(), userID.String())
+	slog.DebugContext(r.Context(), "token validated")
 	json.NewEncoder(w).Encode(validateResp{
 		Valid: true,
 		User:  *user,
@@ -515,8 +518,52 @@ func (s *server) GetUsersUserId(w http.ResponseWriter, r *http.Request, userId u
 	_ = json.NewEncoder(w).Encode(u)
 }
 
+func (s *server) GetUsersUserIdTelegram(w http.ResponseWriter, r *http.Request, userId uuid.UUID, params usergen.GetUsersUserIdTelegramParams) {
+	expected := strings.TrimSpace(os.Getenv("TELEGRAM_BOT_TOKEN"))
+	if expected == "" || strings.TrimSpace(params.XWiliBotToken) != expected {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	link, err := s.repo.GetTelegramLink(r.Context(), userId)
+	if err != nil {
+		if err == ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		slog.ErrorContext(r.Context(), "failed to get telegram link", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(link)
+}
+
+func (s *server) GetUsersUserIdEmail(w http.ResponseWriter, r *http.Request, userId uuid.UUID, params usergen.GetUsersUserIdEmailParams) {
+	expected := strings.TrimSpace(os.Getenv("SERVICE_TOKEN"))
+	if expected == "" || strings.TrimSpace(params.XWiliServiceToken) != expected {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	u, err := s.repo.Get(r.Context(), userId)
+	if err != nil {
+		if err == ErrNotFound {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		slog.ErrorContext(r.Context(), "failed to get user for email", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if u.Email == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_ = json.NewEncoder(w).Encode(usergen.UserEmail{Email: *u.Email})
+}
+
 // exchangeYandexCode exchanges authorization code for access token
-func (s *server) exchangeYandexCode(code string, redirectURIFromClient string) (string, error) {
+func (s *server) exchangeYandexCode(ctx context.Context, code string, redirectURIFromClient string) (string, error) {
 	clientID := os.Getenv("YANDEX_CLIENT_ID")
 	clientSecret := os.Getenv("YANDEX_CLIENT_SECRET")
 	redirectURI := redirectURIFromClient
@@ -537,7 +584,13 @@ func (s *server) exchangeYandexCode(code string, redirectURIFromClient string) (
 		data.Set("redirect_uri", redirectURI)
 	}
 
-	resp, err := http.PostForm("https://oauth.yandex.ru/token", data)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://oauth.yandex.ru/token", strings.NewReader(data.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.httpClient.Do(req)
 	if err != nil {
 		return "", err
 	}
@@ -545,7 +598,7 @@ func (s *server) exchangeYandexCode(code string, redirectURIFromClient string) (
 
 	if resp.StatusCode != http.StatusOK {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		log.Printf("[AUTH] Token exchange failed: %d - %s", resp.StatusCode, string(bodyBytes))
+		slog.ErrorContext(ctx, "yandex token exchange failed", "status", resp.StatusCode, "body", string(bodyBytes))
 		return "", fmt.Errorf("token exchange failed with status: %d", resp.StatusCode)
 	}
 
@@ -557,16 +610,15 @@ func (s *server) exchangeYandexCode(code string, redirectURIFromClient string) (
 	return tokenResp.AccessToken, nil
 }
 
-func (s *server) getYandexUserInfo(accessToken string) (*yandexUserInfo, error) {
-	req, err := http.NewRequest("GET", "https://login.yandex.ru/info", nil)
+func (s *server) getYandexUserInfo(ctx context.Context, accessToken string) (*yandexUserInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", "https://login.yandex.ru/info", nil)
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Authorization", "OAuth "+accessToken)
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := s.httpClient.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -583,3 +635,9 @@ func (s *server) getYandexUserInfo(accessToken string) (*yandexUserInfo, error)
 
 	return &userInfo, nil
 }
+
+func (s *server) GetWellKnownJwksJson(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	_ = json.NewEncoder(w).Encode(s.tokens.JWKS())
+}
@@ -4,12 +4,17 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"sort"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/google/uuid"
+	usergen "github.com/theseems/wili/backend/services/user/gen"
 )
 
 func signTelegramInitData(botToken string, params url.Values) string {
@@ -83,3 +88,29 @@ func TestValidateTelegramInitData_Expired(t *testing.T) {
 		t.Fatalf("expected error")
 	}
 }
+
+func TestGetUsersUserIdTelegram_RequiresBotToken(t *testing.T) {
+	t.Setenv("TELEGRAM_BOT_TOKEN", "123:ABC")
+	s := newServer(nil, nil, nil)
+
+	for _, token := range []string{"", "wrong"} {
+		rec := httptest.NewRecorder()
+		s.GetUsersUserIdTelegram(rec, httptest.NewRequest(http.MethodGet, "/", nil), uuid.New(), usergen.GetUsersUserIdTelegramParams{XWiliBotToken: token})
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for token %q, got %d", token, rec.Code)
+		}
+	}
+}
+
+func TestGetUsersUserIdEmail_RequiresServiceToken(t *testing.T) {
+	t.Setenv("SERVICE_TOKEN", "internal")
+	s := newServer(nil, nil, nil)
+
+	for _, token := range []string{"", "wrong"} {
+		rec := httptest.NewRecorder()
+		s.GetUsersUserIdEmail(rec, httptest.NewRequest(http.MethodGet, "/", nil), uuid.New(), usergen.GetUsersUserIdEmailParams{XWiliServiceToken: token})
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for token %q, got %d", token, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	usergen "github.com/theseems/wili/backend/services/user/gen"
+	"github.com/theseems/wili/backend/telemetry"
+)
+
+const (
+	defaultAccessTokenTTL = 15 * time.Minute
+	defaultSessionTTL     = 30 * 24 * time.Hour
+	// endedSessionRetention is how long ended sessions and exchanged refresh
+	// tokens are kept, during which replaying a token is detected as reuse
+	endedSessionRetention = 7 * 24 * time.Hour
+)
+
+var errSessionEnded = errors.New("session ended")
+
+// newRefreshToken returns a random opaque refresh token
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashRefreshToken is what refresh tokens are stored as, so that a copy of the
+// database does not hold usable tokens
+func hashRefreshToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// clientDevice returns the device r was sent from. The user service runs
+// behind the ingress, which appends the address it was reached from to
+// X-Forwarded-For; the earlier entries come from the client and can be forged.
+func clientDevice(r *http.Request) Device {
+	device := Device{UserAgent: r.UserAgent(), IP: r.RemoteAddr}
+	if forwarded := r.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		entries := strings.Split(forwarded[len(forwarded)-1], ",")
+		device.IP = strings.TrimSpace(entries[len(entries)-1])
+	} else if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		device.IP = host
+	}
+	return device
+}
+
+// login is how a user logged in, which the session it starts records
+type login struct {
+	method usergen.SessionMethod
+	device Device
+	// refreshable sessions get a refresh token; the others end with their
+	// access token, as web logins sent by the Telegram bot do
+	refreshable bool
+}
+
+// issueTokens returns an access token for u and starts a session for l.
+// Tokens without a session can not be revoked and are only issued, with a nil
+// l, for the Telegram bot's own calls, which ask for a new one whenever they
+// need one.
+func (s *server) issueTokens(ctx context.Context, u *User, l *login) (*usergen.AuthResponse, error) {
+	resp := &usergen.AuthResponse{ExpiresIn: int64(s.accessTokenTTL.Seconds()), User: *u}
+	sessionID := uuid.Nil
+	if l != nil {
+		var tokenHash []byte
+		expiresAt := time.Now().Add(s.accessTokenTTL)
+		if l.refreshable {
+			refreshToken, err := newRefreshToken()
+			if err != nil {
+				return nil, err
+			}
+			tokenHash, expiresAt = hashRefreshToken(refreshToken), time.Now().Add(s.sessionTTL)
+			resp.RefreshToken = &refreshToken
+		}
+		session, err := s.sessions.CreateSession(ctx, u.Id, string(l.method), l.device, tokenHash, expiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create session: %w", err)
+		}
+		sessionID = session.ID
+	}
+	accessToken, err := s.tokens.Sign(u.Id, sessionID, s.accessTokenTTL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign token: %w", err)
+	}
+	resp.AccessToken = accessToken
+	return resp, nil
+}
+
+// sessionActive reports whether tokens of sessionID may be used, and records
+// the session as seen; tokens without a session are valid until they expire
+func (s *server) sessionActive(ctx context.Context, sessionID uuid.UUID) bool {
+	if sessionID == uuid.Nil {
+		return true
+	}
+	active, err := s.sessions.TouchSession(ctx, sessionID)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to check session", "session_id", sessionID.String(), "error", err)
+		return false
+	}
+	return active
+}
+
+func (s *server) PostAuthRefresh(w http.ResponseWriter, r *http.Request) {
+	var req usergen.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	refreshToken, err := newRefreshToken()
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to create refresh token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	session, err := s.sessions.RotateRefreshToken(r.Context(), hashRefreshToken(req.RefreshToken), hashRefreshToken(refreshToken), clientDevice(r), time.Now().Add(s.sessionTTL))
+	if errors.Is(err, ErrRefreshTokenReused) {
+		slog.WarnContext(r.Context(), "refresh token reused, session revoked", "remote", r.RemoteAddr)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if errors.Is(err, ErrNotFound) {
+		slog.InfoContext(r.Context(), "unknown or ended refresh token")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to refresh session", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	telemetry.SetUserID(r.Context(), session.UserID.String())
+
+	u, err := s.repo.Get(r.Context(), session.UserID)
+	if err != nil {
+		slog.InfoContext(r.Context(), "session of unknown user", "error", err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	accessToken, err := s.tokens.Sign(u.Id, session.ID, s.accessTokenTTL)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to sign token", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.DebugContext(r.Context(), "session refreshed", "session_id", session.ID.String())
+	_ = json.NewEncoder(w).Encode(usergen.AuthResponse{
+		AccessToken:  accessToken,
+		ExpiresIn:    int64(s.accessTokenTTL.Seconds()),
+		RefreshToken: &refreshToken,
+		User:         *u,
+	})
+}
+
+func (s *server) PostAuthLogout(w http.ResponseWriter, r *http.Request) {
+	var req usergen.RefreshTokenRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err := s.sessions.RevokeSessionByToken(r.Context(), hashRefreshToken(req.RefreshToken)); err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke session", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "user logged out")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) PostAuthLogoutAll(w http.ResponseWriter, r *http.Request) {
+	id, ok := s.parseBearer(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	revoked, err := s.sessions.RevokeUserSessions(r.Context(), id)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke sessions", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "user logged out on all devices", "sessions", revoked)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) GetUsersMeSessions(w http.ResponseWriter, r *http.Request) {
+	id, current, ok := s.authenticate(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	sessions, err := s.sessions.ListSessions(r.Context(), id)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to list sessions", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	resp := usergen.SessionList{Sessions: make([]usergen.Session, 0, len(sessions))}
+	for _, session := range sessions {
+		info := usergen.Session{
+			Id:         session.ID,
+			Method:     usergen.SessionMethod(session.Method),
+			CreatedAt:  session.CreatedAt,
+			LastSeenAt: session.LastUsedAt,
+			ExpiresAt:  session.ExpiresAt,
+			Current:    session.ID == current,
+		}
+		if session.UserAgent != "" {
+			info.UserAgent = &session.UserAgent
+		}
+		if session.IP != "" {
+			info.Ip = &session.IP
+		}
+		resp.Sessions = append(resp.Sessions, info)
+	}
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *server) DeleteUsersMeSessionsSessionId(w http.ResponseWriter, r *http.Request, sessionId uuid.UUID) {
+	id, ok := s.parseBearer(r)
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	err := s.sessions.RevokeSession(r.Context(), id, sessionId)
+	if errors.Is(err, ErrNotFound) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(r.Context(), "failed to revoke session", "error", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	slog.InfoContext(r.Context(), "session revoked", "session_id", sessionId.String())
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteEndedSessionsJob deletes sessions and refresh tokens kept past
+// endedSessionRetention
+func deleteEndedSessionsJob(sessions SessionRepo) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		deleted, err := sessions.DeleteEndedSessions(ctx, time.Now().Add(-endedSessionRetention))
+		if err != nil {
+			return fmt.Errorf("failed to delete ended sessions: %w", err)
+		}
+		slog.InfoContext(ctx, "deleted ended sessions", "sessions", deleted)
+		return nil
+	}
+}
@@ -0,0 +1,340 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	usergen "github.com/theseems/wili/backend/services/user/gen"
+)
+
+// memSessions keeps sessions in memory with the semantics of the Postgres
+// implementation
+type memSessions struct {
+	mu       sync.Mutex
+	sessions map[uuid.UUID]*Session
+	revoked  map[uuid.UUID]bool
+	tokens   map[string]uuid.UUID // refresh token hash -> session
+	used     map[string]bool
+}
+
+func newMemSessions() *memSessions {
+	return &memSessions{
+		sessions: map[uuid.UUID]*Session{},
+		revoked:  map[uuid.UUID]bool{},
+		tokens:   map[string]uuid.UUID{},
+		used:     map[string]bool{},
+	}
+}
+
+func (m *memSessions) CreateSession(ctx context.Context, userID uuid.UUID, method string, device Device, tokenHash []byte, expiresAt time.Time) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session := &Session{ID: uuid.New(), UserID: userID, Method: method, Device: device, CreatedAt: time.Now(), LastUsedAt: time.Now(), ExpiresAt: expiresAt}
+	m.sessions[session.ID] = session
+	if tokenHash != nil {
+		m.tokens[string(tokenHash)] = session.ID
+	}
+	return session, nil
+}
+
+func (m *memSessions) active(id uuid.UUID) bool {
+	session, ok := m.sessions[id]
+	return ok && !m.revoked[id] && time.Now().Before(session.ExpiresAt)
+}
+
+func (m *memSessions) RotateRefreshToken(ctx context.Context, tokenHash, newHash []byte, device Device, expiresAt time.Time) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id, ok := m.tokens[string(tokenHash)]
+	if !ok || !m.active(id) {
+		return nil, ErrNotFound
+	}
+	if m.used[string(tokenHash)] {
+		m.revoked[id] = true
+		return nil, ErrRefreshTokenReused
+	}
+	m.used[string(tokenHash)] = true
+	m.tokens[string(newHash)] = id
+	m.sessions[id].Device, m.sessions[id].LastUsedAt, m.sessions[id].ExpiresAt = device, time.Now(), expiresAt
+	session := *m.sessions[id]
+	return &session, nil
+}
+
+func (m *memSessions) RevokeSessionByToken(ctx context.Context, tokenHash []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if id, ok := m.tokens[string(tokenHash)]; ok {
+		m.revoked[id] = true
+	}
+	return nil
+}
+
+func (m *memSessions) RevokeSession(ctx context.Context, userID, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.active(id) || m.sessions[id].UserID != userID {
+		return ErrNotFound
+	}
+	m.revoked[id] = true
+	return nil
+}
+
+func (m *memSessions) RevokeUserSessions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var revoked int64
+	for id, session := range m.sessions {
+		if session.UserID == userID && !m.revoked[id] {
+			m.revoked[id] = true
+			revoked++
+		}
+	}
+	return revoked, nil
+}
+
+func (m *memSessions) TouchSession(ctx context.Context, id uuid.UUID) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.active(id) {
+		return false, nil
+	}
+	m.sessions[id].LastUsedAt = time.Now()
+	return true, nil
+}
+
+func (m *memSessions) ListSessions(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var sessions []Session
+	for id, session := range m.sessions {
+		if session.UserID == userID && m.active(id) {
+			sessions = append(sessions, *session)
+		}
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt) })
+	return sessions, nil
+}
+
+func (m *memSessions) DeleteEndedSessions(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+// oneUserRepo knows a single user
+type oneUserRepo struct {
+	UserRepo
+	user *User
+}
+
+func (r oneUserRepo) Get(ctx context.Context, id uuid.UUID) (*User, error) {
+	if id != r.user.Id {
+		return nil, ErrNotFound
+	}
+	return r.user, nil
+}
+
+func newSessionTestServer(t *testing.T) (*server, *User) {
+	t.Helper()
+	tokens, err := loadTokenKeys("", "", "secret", "wili-user-service", "wili")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &User{Id: uuid.New(), DisplayName: "Anna"}
+	return newServer(oneUserRepo{user: u}, newMemSessions(), tokens), u
+}
+
+func postJSON(handler http.HandlerFunc, body interface{}, bearer string) *httptest.ResponseRecorder {
+	b, _ := json.Marshal(body)
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(b))
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	rec := httptest.NewRecorder()
+	handler(rec, req)
+	return rec
+}
+
+func refresh(t *testing.T, s *server, refreshToken string) (*usergen.AuthResponse, int) {
+	t.Helper()
+	rec := postJSON(s.PostAuthRefresh, usergen.RefreshTokenRequest{RefreshToken: refreshToken}, "")
+	if rec.Code != http.StatusOK {
+		return nil, rec.Code
+	}
+	var resp usergen.AuthResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	return &resp, rec.Code
+}
+
+func browserLogin() *login {
+	return &login{method: usergen.Yandex, device: Device{UserAgent: "Firefox", IP: "203.0.113.7"}, refreshable: true}
+}
+
+func tokenValid(s *server, accessToken string) bool {
+	rec := postJSON(s.PostAuthValidate, map[string]string{"token": accessToken}, "")
+	return strings.Contains(rec.Body.String(), `"valid":true`)
+}
+
+func TestSessions_RefreshRotatesTokens(t *testing.T) {
+	s, u := newSessionTestServer(t)
+	login, err := s.issueTokens(context.Background(), u, browserLogin())
+	if err != nil || login.RefreshToken == nil {
+		t.Fatalf("expected tokens with a refresh token, got %+v err=%v", login, err)
+	}
+	if login.ExpiresIn != int64(defaultAccessTokenTTL.Seconds()) {
+		t.Errorf("expected a short-lived access token, got expiresIn=%d", login.ExpiresIn)
+	}
+
+	refreshed, code := refresh(t, s, *login.RefreshToken)
+	if code != http.StatusOK || refreshed.RefreshToken == nil || *refreshed.RefreshToken == *login.RefreshToken {
+		t.Fatalf("expected a new refresh token, got %d %+v", code, refreshed)
+	}
+	if refreshed.User.Id != u.Id || !tokenValid(s, refreshed.AccessToken) {
+		t.Errorf("expected a valid access token of %s", u.Id)
+	}
+
+	// replaying the first refresh token ends the session for everyone holding it
+	if _, code := refresh(t, s, *login.RefreshToken); code != http.StatusUnauthorized {
+		t.Errorf("expected reuse to be rejected, got %d", code)
+	}
+	if _, code := refresh(t, s, *refreshed.RefreshToken); code != http.StatusUnauthorized {
+		t.Errorf("expected the session to be revoked after reuse, got %d", code)
+	}
+	if tokenValid(s, refreshed.AccessToken) {
+		t.Error("expected access tokens of the revoked session to be rejected")
+	}
+}
+
+func TestSessions_Logout(t *testing.T) {
+	s, u := newSessionTestServer(t)
+	phone, _ := s.issueTokens(context.Background(), u, browserLogin())
+	laptop, _ := s.issueTokens(context.Background(), u, browserLogin())
+	bot, _ := s.issueTokens(context.Background(), u, nil)
+	if bot.RefreshToken != nil {
+		t.Error("expected no refresh token for the bot")
+	}
+
+	if rec := postJSON(s.PostAuthLogout, usergen.RefreshTokenRequest{RefreshToken: *phone.RefreshToken}, ""); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	if tokenValid(s, phone.AccessToken) || !tokenValid(s, laptop.AccessToken) {
+		t.Error("expected only the phone to be logged out")
+	}
+
+	if rec := postJSON(s.PostAuthLogoutAll, nil, laptop.AccessToken); rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+	if tokenValid(s, laptop.AccessToken) {
+		t.Error("expected every session to be logged out")
+	}
+	if _, code := refresh(t, s, *laptop.RefreshToken); code != http.StatusUnauthorized {
+		t.Errorf("expected the refresh token of an ended session to be rejected, got %d", code)
+	}
+	if !tokenValid(s, bot.AccessToken) {
+		t.Error("expected tokens without a session to stay valid until they expire")
+	}
+	if rec := postJSON(s.PostAuthLogoutAll, nil, laptop.AccessToken); rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected a logged out access token to be rejected, got %d", rec.Code)
+	}
+}
+
+func listSessions(t *testing.T, s *server, bearer string) []usergen.Session {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, "/users/me/sessions", nil)
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	rec := httptest.NewRecorder()
+	s.GetUsersMeSessions(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	var resp usergen.SessionList
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp.Sessions
+}
+
+func deleteSession(s *server, bearer string, id uuid.UUID) int {
+	req := httptest.NewRequest(http.MethodDelete, "/users/me/sessions/"+id.String(), nil)
+	req.Header.Set("Authorization", "Bearer "+bearer)
+	rec := httptest.NewRecorder()
+	s.DeleteUsersMeSessionsSessionId(rec, req, id)
+	return rec.Code
+}
+
+func TestSessions_Devices(t *testing.T) {
+	s, u := newSessionTestServer(t)
+	laptop, _ := s.issueTokens(context.Background(), u, browserLogin())
+	botLink, _ := s.issueTokens(context.Background(), u, &login{method: usergen.TelegramBot})
+	if botLink.RefreshToken != nil {
+		t.Error("expected no refresh token for a bot web login")
+	}
+	other, _ := s.issueTokens(context.Background(), &User{Id: uuid.New()}, browserLogin())
+
+	sessions := listSessions(t, s, laptop.AccessToken)
+	if len(sessions) != 2 {
+		t.Fatalf("expected the two sessions of the user, got %+v", sessions)
+	}
+	byMethod := map[usergen.SessionMethod]usergen.Session{}
+	for _, session := range sessions {
+		byMethod[session.Method] = session
+	}
+	web, bot := byMethod[usergen.Yandex], byMethod[usergen.TelegramBot]
+	if !web.Current || web.UserAgent == nil || *web.UserAgent != "Firefox" || web.Ip == nil || *web.Ip != "203.0.113.7" {
+		t.Errorf("expected the current session with its device, got %+v", web)
+	}
+	if bot.Current || bot.UserAgent != nil || !bot.ExpiresAt.Before(web.ExpiresAt) {
+		t.Errorf("expected a short session without a device for the bot login, got %+v", bot)
+	}
+
+	otherSession := listSessions(t, s, other.AccessToken)[0]
+	if code := deleteSession(s, laptop.AccessToken, otherSession.Id); code != http.StatusNotFound {
+		t.Errorf("expected sessions of other users to be hidden, got %d", code)
+	}
+	if code := deleteSession(s, laptop.AccessToken, bot.Id); code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", code)
+	}
+	if tokenValid(s, botLink.AccessToken) || !tokenValid(s, laptop.AccessToken) || len(listSessions(t, s, other.AccessToken)) != 1 {
+		t.Error("expected only the revoked session to be logged out")
+	}
+	if code := deleteSession(s, laptop.AccessToken, bot.Id); code != http.StatusNotFound {
+		t.Errorf("expected an ended session to be gone, got %d", code)
+	}
+	if sessions := listSessions(t, s, laptop.AccessToken); len(sessions) != 1 {
+		t.Errorf("expected one session left, got %+v", sessions)
+	}
+}
+
+func TestClientDevice(t *testing.T) {
+	tests := []struct {
+		name      string
+		forwarded []string
+		want      string
+	}{
+		{name: "no_header", want: "10.0.0.5"},
+		{name: "ingress_only", forwarded: []string{"203.0.113.7"}, want: "203.0.113.7"},
+		{name: "forged_entry", forwarded: []string{"1.2.3.4, 203.0.113.7"}, want: "203.0.113.7"},
+		{name: "several_headers", forwarded: []string{"1.2.3.4", "198.51.100.2,203.0.113.7"}, want: "203.0.113.7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/auth/refresh", nil)
+			r.RemoteAddr = "10.0.0.5:41234"
+			for _, value := range tt.forwarded {
+				r.Header.Add("X-Forwarded-For", value)
+			}
+			if got := clientDevice(r).IP; got != tt.want {
+				t.Errorf("Expected IP %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	defaultActivityLimit = 20
+	maxActivityLimit     = 100
+)
+
+// mongoActivity is one domain event of a wishlist. Audit data is kept for
+// abuse investigations and never returned by the API.
+type mongoActivity struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	WishlistID string             `bson:"wishlistId"`
+	Version    int64              `bson:"version"`
+	Type       string             `bson:"type"`
+	ItemID     string             `bson:"itemId"`
+	ItemName   string             `bson:"itemName"`
+	Fields     []string           `bson:"fields,omitempty"`
+	ActorID    *string            `bson:"actorId,omitempty"`
+	Booker     *activityBooker    `bson:"booker,omitempty"`
+	Audit      *activityAudit     `bson:"audit,omitempty"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+}
+
+type activityBooker struct {
+	Name    *string `bson:"name,omitempty"`
+	Message *string `bson:"message,omitempty"`
+}
+
+type activityAudit struct {
+	IP           string `bson:"ip,omitempty"`
+	ForwardedFor string `bson:"forwardedFor,omitempty"`
+	UserAgent    string `bson:"userAgent,omitempty"`
+}
+
+// bookingActivityTypes are the events hidden from owners of surprise wishlists
+var bookingActivityTypes = []string{
+	string(wishlistgen.ActivityEventTypeItemBooked),
+	string(wishlistgen.ActivityEventTypeItemUnbookedByToken),
+	string(wishlistgen.ActivityEventTypeItemUnbookedByOwner),
+}
+
+func isBookingActivity(eventType string) bool {
+	for _, t := range bookingActivityTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type auditContextKey struct{}
+
+// auditMiddleware remembers where a request came from so that the events it
+// produces can be traced back later.
+func auditMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		audit := activityAudit{
+			IP:           r.RemoteAddr,
+			ForwardedFor: r.Header.Get("X-Forwarded-For"),
+			UserAgent:    r.UserAgent(),
+		}
+		if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+			audit.IP = host
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), auditContextKey{}, &audit)))
+	})
+}
+
+func auditFromContext(ctx context.Context) *activityAudit {
+	audit, _ := ctx.Value(auditContextKey{}).(*activityAudit)
+	return audit
+}
+
+// activityFromChanges turns the item changes of a revision into activity
+// events. Bookings only count when made or released through the booking
+// endpoints, not when a booked item is moved or copied.
+func activityFromChanges(mw *mongoWishlist, prev *revisionSnapshot, changes []revisionChange, actorID *openapi_types.UUID, action string, now time.Time) []mongoActivity {
+	var actor *string
+	if actorID != nil {
+		id := actorID.String()
+		actor = &id
+	}
+
+	var events []mongoActivity
+	for _, change := range changes {
+		if change.Target != string(wishlistgen.RevisionChangeTargetItem) {
+			continue
+		}
+
+		event := mongoActivity{
+			WishlistID: mw.UUID,
+			Version:    mw.Version,
+			ItemID:     change.ItemID,
+			ItemName:   change.ItemName,
+			ActorID:    actor,
+			CreatedAt:  now,
+		}
+
+		switch wishlistgen.RevisionChangeKind(change.Kind) {
+		case wishlistgen.Added:
+			event.Type = string(wishlistgen.ActivityEventTypeItemAdded)
+		case wishlistgen.Modified:
+			event.Type = string(wishlistgen.ActivityEventTypeItemEdited)
+			event.Fields = change.Fields
+		case wishlistgen.Removed:
+			event.Type = string(wishlistgen.ActivityEventTypeItemRemoved)
+		case wishlistgen.Booked:
+			if action != "book_item" {
+				continue
+			}
+			event.Type = string(wishlistgen.ActivityEventTypeItemBooked)
+			if item := findItem(mw, change.ItemID); item != nil && item.Booking != nil {
+				event.Booker = &activityBooker{Name: item.Booking.BookerName, Message: item.Booking.Message}
+			}
+		case wishlistgen.Unbooked:
+			if action != "unbook_item" {
+				continue
+			}
+			event.Type = string(wishlistgen.ActivityEventTypeItemUnbookedByToken)
+			if actorID != nil {
+				event.Type = string(wishlistgen.ActivityEventTypeItemUnbookedByOwner)
+			}
+			if prev != nil {
+				for _, item := range prev.Items {
+					if item.ID == change.ItemID {
+						event.Booker = &activityBooker{Name: item.BookerName}
+						break
+					}
+				}
+			}
+		default:
+			continue
+		}
+
+		events = append(events, event)
+	}
+	return events
+}
+
+// recordActivity stores the events of a successful write. Like revisions,
+// failures are only logged.
+func (r *MongoRepo) recordActivity(ctx context.Context, mw *mongoWishlist, prev *revisionSnapshot, changes []revisionChange, actorID *openapi_types.UUID, action string) {
+	events := activityFromChanges(mw, prev, changes, actorID, action, time.Now())
+	if len(events) == 0 {
+		return
+	}
+
+	audit := auditFromContext(ctx)
+	docs := make([]interface{}, len(events))
+	for i := range events {
+		events[i].ID = primitive.NewObjectID()
+		events[i].Audit = audit
+		docs[i] = events[i]
+	}
+
+	if _, err := r.activity.InsertMany(ctx, docs); err != nil {
+		r.logger.LogError(ctx, actorID, "record_activity", err, fmt.Sprintf("failed to record %d events of wishlist %s", len(events), mw.UUID))
+	}
+
+	r.enqueueWebhookDeliveries(ctx, mw, events)
+}
+
+// activityFilter selects the events of a wishlist the owner may see, older than before when set
+func activityFilter(wishlistID openapi_types.UUID, visibility wishlistgen.WishlistPrivacyBookerVisibility, before primitive.ObjectID) bson.M {
+	filter := bson.M{"wishlistId": wishlistID.String()}
+	if !before.IsZero() {
+		filter["_id"] = bson.M{"$lt": before}
+	}
+	if visibility == wishlistgen.Surprise {
+		filter["type"] = bson.M{"$nin": bookingActivityTypes}
+	}
+	return filter
+}
+
+// convertToAPIActivity converts an event, dropping the booker unless the owner may see it
+func convertToAPIActivity(event mongoActivity, visibility wishlistgen.WishlistPrivacyBookerVisibility) wishlistgen.ActivityEvent {
+	apiEvent := wishlistgen.ActivityEvent{
+		Id:        event.ID.Hex(),
+		Type:      wishlistgen.ActivityEventType(event.Type),
+		ItemName:  event.ItemName,
+		CreatedAt: event.CreatedAt,
+	}
+	if id, err := uuid.Parse(event.ItemID); err == nil {
+		apiEvent.ItemId = id
+	}
+	if len(event.Fields) > 0 {
+		fields := event.Fields
+		apiEvent.Fields = &fields
+	}
+	if event.Booker != nil && visibility == wishlistgen.Visible {
+		apiEvent.Booker = &wishlistgen.ActivityBooker{Name: event.Booker.Name, Message: event.Booker.Message}
+	}
+	return apiEvent
+}
+
+// GetActivity returns up to limit events older than before (all when zero), newest first
+func (r *MongoRepo) GetActivity(ctx context.Context, wishlistID, userID openapi_types.UUID, before primitive.ObjectID, limit int) (*wishlistgen.ActivityFeed, error) {
+	var mw mongoWishlist
+	err := r.wishlists.FindOne(ctx, bson.M{
+		"uuid":   wishlistID.String(),
+		"userId": userID.String(),
+	}, options.FindOne().SetProjection(bson.M{"privacy": 1})).Decode(&mw)
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist: %w", err)
+	}
+	visibility := mw.Privacy.bookerVisibility()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit + 1)).
+		SetProjection(bson.M{"audit": 0})
+
+	cursor, err := r.activity.Find(ctx, activityFilter(wishlistID, visibility, before), opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find activity: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var events []mongoActivity
+	if err = cursor.All(ctx, &events); err != nil {
+		return nil, fmt.Errorf("failed to decode activity: %w", err)
+	}
+
+	feed := &wishlistgen.ActivityFeed{Events: []wishlistgen.ActivityEvent{}}
+	if len(events) > limit {
+		events = events[:limit]
+		next := events[limit-1].ID.Hex()
+		feed.NextCursor = &next
+	}
+	for _, event := range events {
+		feed.Events = append(feed.Events, convertToAPIActivity(event, visibility))
+	}
+
+	return feed, nil
+}
+
+// List item and booking events of a wishlist (owner only)
+func (s *WishlistServer) GetWishlistsWishlistIdActivity(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdActivityParams) {
+	s.logger.LogRequest(r, nil, "get_activity")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	limit := defaultActivityLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxActivityLimit {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_activity", fmt.Sprintf("invalid limit %d", *params.Limit))
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxActivityLimit))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	var before primitive.ObjectID
+	if params.Cursor != nil {
+		before, err = primitive.ObjectIDFromHex(*params.Cursor)
+		if err != nil {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_activity", "invalid cursor")
+			s.writeError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	feed, err := s.repo.GetActivity(r.Context(), wishlistId, userID, before, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "get_activity", err, fmt.Sprintf("failed to retrieve activity of wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve activity")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_activity", fmt.Sprintf("retrieved %d events of wishlist %s", len(feed.Events), wishlistId.String()))
+	s.writeJSON(w, http.StatusOK, feed)
+}
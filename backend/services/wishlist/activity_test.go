@@ -0,0 +1,160 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestActivityFromChanges(t *testing.T) {
+	owner := uuid.New()
+	itemID := uuid.New().String()
+	now := time.Now()
+
+	bookedWishlist := &mongoWishlist{
+		UUID:    uuid.New().String(),
+		Version: 3,
+		Items: []mongoWishlistItem{{
+			ID:      itemID,
+			Booking: &mongoItemBooking{BookerName: stringPtr("Anna"), Message: stringPtr("Got it")},
+		}},
+	}
+	prevBooked := &revisionSnapshot{Items: []revisionItem{{ID: itemID, Booked: true, BookerName: stringPtr("Anna")}}}
+
+	change := func(kind wishlistgen.RevisionChangeKind) []revisionChange {
+		return []revisionChange{
+			{Target: string(wishlistgen.RevisionChangeTargetWishlist), Kind: string(wishlistgen.Modified)},
+			{Target: string(wishlistgen.RevisionChangeTargetItem), ItemID: itemID, ItemName: "Book", Kind: string(kind)},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		prev         *revisionSnapshot
+		changes      []revisionChange
+		actor        *uuid.UUID
+		action       string
+		expectedType string
+		expectBooker string
+	}{
+		{"added", nil, change(wishlistgen.Added), &owner, "add_item", string(wishlistgen.ActivityEventTypeItemAdded), ""},
+		{"edited", nil, change(wishlistgen.Modified), &owner, "update_item", string(wishlistgen.ActivityEventTypeItemEdited), ""},
+		{"removed", nil, change(wishlistgen.Removed), &owner, "delete_item", string(wishlistgen.ActivityEventTypeItemRemoved), ""},
+		{"booked", nil, change(wishlistgen.Booked), nil, "book_item", string(wishlistgen.ActivityEventTypeItemBooked), "Anna"},
+		{"booked_item_moved", nil, change(wishlistgen.Booked), &owner, "move_items", "", ""},
+		{"unbooked_by_token", prevBooked, change(wishlistgen.Unbooked), nil, "unbook_item", string(wishlistgen.ActivityEventTypeItemUnbookedByToken), "Anna"},
+		{"unbooked_by_owner", prevBooked, change(wishlistgen.Unbooked), &owner, "unbook_item", string(wishlistgen.ActivityEventTypeItemUnbookedByOwner), "Anna"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := activityFromChanges(bookedWishlist, tt.prev, tt.changes, tt.actor, tt.action, now)
+
+			if tt.expectedType == "" {
+				if len(events) != 0 {
+					t.Fatalf("Expected no events, got %+v", events)
+				}
+				return
+			}
+			if len(events) != 1 {
+				t.Fatalf("Expected 1 event, got %d", len(events))
+			}
+			event := events[0]
+			if event.Type != tt.expectedType {
+				t.Errorf("Expected type %s, got %s", tt.expectedType, event.Type)
+			}
+			if event.ItemID != itemID || event.ItemName != "Book" || event.Version != 3 {
+				t.Errorf("Expected item details and version 3, got %+v", event)
+			}
+			if tt.expectBooker == "" {
+				if event.Booker != nil {
+					t.Errorf("Expected no booker, got %+v", event.Booker)
+				}
+			} else if event.Booker == nil || event.Booker.Name == nil || *event.Booker.Name != tt.expectBooker {
+				t.Errorf("Expected booker %s, got %+v", tt.expectBooker, event.Booker)
+			}
+		})
+	}
+}
+
+func TestConvertToAPIActivityRedactsBooker(t *testing.T) {
+	event := mongoActivity{
+		ID:     primitive.NewObjectID(),
+		Type:   string(wishlistgen.ActivityEventTypeItemBooked),
+		ItemID: uuid.New().String(),
+		Booker: &activityBooker{Name: stringPtr("Anna"), Message: stringPtr("Got it")},
+		Audit:  &activityAudit{IP: "10.0.0.1"},
+	}
+
+	visible := convertToAPIActivity(event, wishlistgen.Visible)
+	if visible.Booker == nil || visible.Booker.Name == nil || *visible.Booker.Name != "Anna" {
+		t.Errorf("Expected booker to be visible, got %+v", visible.Booker)
+	}
+
+	anonymous := convertToAPIActivity(event, wishlistgen.Anonymous)
+	if anonymous.Booker != nil {
+		t.Errorf("Expected booker to be redacted, got %+v", anonymous.Booker)
+	}
+	if anonymous.Id != event.ID.Hex() {
+		t.Errorf("Expected id %s, got %s", event.ID.Hex(), anonymous.Id)
+	}
+}
+
+func TestActivityFilter(t *testing.T) {
+	wishlistID := uuid.New()
+	before := primitive.NewObjectID()
+
+	filter := activityFilter(wishlistID, wishlistgen.Visible, primitive.NilObjectID)
+	if _, ok := filter["_id"]; ok {
+		t.Errorf("Expected no cursor condition on the first page, got %v", filter)
+	}
+	if _, ok := filter["type"]; ok {
+		t.Errorf("Expected booking events to be included, got %v", filter)
+	}
+
+	filter = activityFilter(wishlistID, wishlistgen.Surprise, before)
+	if cond, ok := filter["_id"].(bson.M); !ok || cond["$lt"] != before {
+		t.Errorf("Expected events before the cursor, got %v", filter["_id"])
+	}
+	if cond, ok := filter["type"].(bson.M); !ok || len(cond["$nin"].([]string)) != 3 {
+		t.Errorf("Expected booking events to be excluded in surprise mode, got %v", filter["type"])
+	}
+}
+
+func TestAuditMiddleware(t *testing.T) {
+	var audit *activityAudit
+	handler := auditMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		audit = auditFromContext(r.Context())
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "192.0.2.7:51234"
+	req.Header.Set("User-Agent", "test-agent")
+	req.Header.Set("X-Forwarded-For", "198.51.100.1")
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	if audit == nil {
+		t.Fatal("Expected audit data in request context")
+	}
+	if audit.IP != "192.0.2.7" || audit.UserAgent != "test-agent" || audit.ForwardedFor != "198.51.100.1" {
+		t.Errorf("Unexpected audit data: %+v", audit)
+	}
+}
+
+func TestActivityRequiresAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+
+	rec := httptest.NewRecorder()
+	s.GetWishlistsWishlistIdActivity(rec, httptest.NewRequest(http.MethodGet, "/", nil), uuid.New(), wishlistgen.GetWishlistsWishlistIdActivityParams{})
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
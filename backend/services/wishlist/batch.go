@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// errBatchRejected means some operations of a batch could not be applied; the
+// results explain which ones.
+var errBatchRejected = errors.New("batch rejected")
+
+// applyItemOperations applies ops in order to a copy of items. Every operation
+// is evaluated even after a failure so that all problems are reported at once;
+// ok is false if any of them failed.
+func applyItemOperations(items []mongoWishlistItem, ops []wishlistgen.BatchItemOperation, now time.Time) ([]mongoWishlistItem, []wishlistgen.BatchItemResult, bool) {
+	result := make([]mongoWishlistItem, len(items), len(items)+len(ops))
+	copy(result, items)
+	results := make([]wishlistgen.BatchItemResult, len(ops))
+	ok := true
+
+	liveIndex := func(id openapi_types.UUID) int {
+		for i := range result {
+			if result[i].ID == id.String() && result[i].DeletedAt == nil {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for i, op := range ops {
+		res := wishlistgen.BatchItemResult{
+			Index:  i,
+			Op:     wishlistgen.BatchItemResultOp(op.Op),
+			Status: wishlistgen.BatchItemResultStatusOk,
+		}
+
+		switch op.Op {
+		case wishlistgen.BatchItemOperationOpCreate:
+			result = append(result, mongoWishlistItem{
+				ID:        uuid.New().String(),
+				Type:      *op.Type,
+				Data:      convertWishlistItemDataToMap(*op.Data),
+				CreatedAt: now,
+				UpdatedAt: now,
+			})
+			item := convertToAPIItem(result[len(result)-1])
+			res.Item = &item
+
+		case wishlistgen.BatchItemOperationOpUpdate, wishlistgen.BatchItemOperationOpDelete:
+			idx := liveIndex(*op.ItemId)
+			if idx < 0 {
+				res.Status = wishlistgen.BatchItemResultStatusFailed
+				message := fmt.Sprintf("item %s not found", op.ItemId.String())
+				res.Error = &message
+				ok = false
+				break
+			}
+
+			updated := result[idx]
+			updated.UpdatedAt = now
+			if op.Op == wishlistgen.BatchItemOperationOpDelete {
+				updated.DeletedAt = &now
+			} else {
+				if op.Type != nil {
+					updated.Type = *op.Type
+				}
+				if op.Data != nil {
+					updated.Data = convertWishlistItemDataToMap(*op.Data)
+				}
+				item := convertToAPIItem(updated)
+				res.Item = &item
+			}
+			result[idx] = updated
+		}
+
+		results[i] = res
+	}
+
+	return result, results, ok
+}
+
+// ApplyItemBatch applies item operations to a wishlist as one write. When an
+// operation cannot be applied nothing is written and errBatchRejected is
+// returned along with the per-operation results.
+func (r *MongoRepo) ApplyItemBatch(ctx context.Context, wishlistID, userID openapi_types.UUID, ops []wishlistgen.BatchItemOperation, ifMatch []int64) (*wishlistgen.BatchItemsResponse, error) {
+	current, err := r.findOwned(ctx, wishlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ifMatch != nil && !containsVersion(ifMatch, current.Version) {
+		return nil, ErrVersionMismatch
+	}
+
+	now := time.Now()
+	items, results, ok := applyItemOperations(current.Items, ops, now)
+	if !ok {
+		return &wishlistgen.BatchItemsResponse{Applied: false, Results: results}, errBatchRejected
+	}
+
+	filter := bson.M{
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"items":     items,
+			"updatedAt": now,
+		},
+	}
+
+	// The items array is rewritten from current, so it must not have moved on meanwhile
+	mw, err := r.commitUpdate(ctx, filter, update, []int64{current.Version}, &userID, "batch_items")
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply item batch: %w", err)
+	}
+
+	return &wishlistgen.BatchItemsResponse{Applied: true, Version: &mw.Version, Results: results}, nil
+}
+
+// Create, update and delete items in one request (owner only)
+func (s *WishlistServer) PostWishlistsWishlistIdItemsBatch(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsBatchParams) {
+	s.logger.LogRequest(r, nil, "batch_items")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	var req wishlistgen.BatchItemsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.LogBadRequest(r.Context(), &userID, "batch_items", fmt.Sprintf("malformed JSON: %v", err))
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return
+	}
+
+	if validationErrors := ValidateBatchItemsRequest(req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, "batch_items", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	resp, err := s.repo.ApplyItemBatch(r.Context(), wishlistId, userID, req.Operations, ifMatchVersions(params.IfMatch))
+	if err != nil {
+		if errors.Is(err, errBatchRejected) {
+			s.logger.LogConflict(r.Context(), &userID, "batch_items", fmt.Sprintf("batch of %d operations rejected for wishlist %s", len(req.Operations), wishlistId.String()))
+			s.writeJSON(w, http.StatusConflict, resp)
+			return
+		}
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "batch_items", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "batch_items", err, fmt.Sprintf("failed to apply batch to wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to apply batch")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "batch_items", fmt.Sprintf("applied %d operations to wishlist %s", len(req.Operations), wishlistId.String()))
+	setETag(w, *resp.Version)
+	s.writeJSON(w, http.StatusOK, resp)
+}
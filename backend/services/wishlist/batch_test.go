@@ -0,0 +1,140 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestValidateBatchItemsRequest(t *testing.T) {
+	itemID := uuid.New()
+	itemType := "general"
+
+	tests := []struct {
+		name           string
+		ops            []wishlistgen.BatchItemOperation
+		expectedFields []string
+	}{
+		{
+			name: "valid",
+			ops: []wishlistgen.BatchItemOperation{
+				{Op: wishlistgen.BatchItemOperationOpCreate, Type: &itemType, Data: &wishlistgen.WishlistItemData{Name: "Book"}},
+				{Op: wishlistgen.BatchItemOperationOpUpdate, ItemId: &itemID, Data: &wishlistgen.WishlistItemData{Name: "Pen"}},
+				{Op: wishlistgen.BatchItemOperationOpDelete, ItemId: &itemID},
+			},
+		},
+		{
+			name:           "empty",
+			ops:            nil,
+			expectedFields: []string{"operations"},
+		},
+		{
+			name: "fields_are_prefixed",
+			ops: []wishlistgen.BatchItemOperation{
+				{Op: wishlistgen.BatchItemOperationOpDelete, ItemId: &itemID},
+				{Op: wishlistgen.BatchItemOperationOpCreate, Type: &itemType, Data: &wishlistgen.WishlistItemData{Name: ""}},
+				{Op: wishlistgen.BatchItemOperationOpUpdate},
+				{Op: "rename"},
+			},
+			expectedFields: []string{"operations[1].data.name", "operations[2].itemId", "operations[3].op"},
+		},
+		{
+			name:           "create_without_data",
+			ops:            []wishlistgen.BatchItemOperation{{Op: wishlistgen.BatchItemOperationOpCreate, Type: &itemType}},
+			expectedFields: []string{"operations[0].type"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateBatchItemsRequest(wishlistgen.BatchItemsRequest{Operations: tt.ops})
+			if len(errs) != len(tt.expectedFields) {
+				t.Fatalf("Expected %d errors, got %d: %v", len(tt.expectedFields), len(errs), errs)
+			}
+			for i, err := range errs {
+				if err.Field != tt.expectedFields[i] {
+					t.Errorf("Expected field %s, got %s", tt.expectedFields[i], err.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyItemOperations(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	itemType := "general"
+	a, b, missing := uuid.New(), uuid.New(), uuid.New()
+
+	current := []mongoWishlistItem{
+		{ID: a.String(), Type: "general", Data: map[string]interface{}{"name": "Book"}, CreatedAt: earlier, UpdatedAt: earlier},
+		{ID: b.String(), Type: "general", Data: map[string]interface{}{"name": "Pen"}, CreatedAt: earlier, UpdatedAt: earlier},
+	}
+
+	t.Run("applies_in_order", func(t *testing.T) {
+		items, results, ok := applyItemOperations(current, []wishlistgen.BatchItemOperation{
+			{Op: wishlistgen.BatchItemOperationOpUpdate, ItemId: &a, Data: &wishlistgen.WishlistItemData{Name: "Novel"}},
+			{Op: wishlistgen.BatchItemOperationOpDelete, ItemId: &b},
+			{Op: wishlistgen.BatchItemOperationOpCreate, Type: &itemType, Data: &wishlistgen.WishlistItemData{Name: "Cup"}},
+		}, now)
+
+		if !ok {
+			t.Fatalf("Expected batch to apply, got %+v", results)
+		}
+		if len(items) != 3 {
+			t.Fatalf("Expected 3 items, got %d", len(items))
+		}
+		if items[0].Data["name"] != "Novel" || !items[0].CreatedAt.Equal(earlier) {
+			t.Errorf("Expected item a updated with createdAt kept, got %+v", items[0])
+		}
+		if items[1].DeletedAt == nil {
+			t.Error("Expected item b moved to trash")
+		}
+		if results[2].Item == nil || results[2].Item.Data.Name != "Cup" {
+			t.Errorf("Expected created item in result, got %+v", results[2])
+		}
+		if current[0].Data["name"] != "Book" || current[1].DeletedAt != nil {
+			t.Error("Expected input items to be left untouched")
+		}
+	})
+
+	t.Run("reports_every_failure", func(t *testing.T) {
+		_, results, ok := applyItemOperations(current, []wishlistgen.BatchItemOperation{
+			{Op: wishlistgen.BatchItemOperationOpDelete, ItemId: &b},
+			{Op: wishlistgen.BatchItemOperationOpUpdate, ItemId: &b, Data: &wishlistgen.WishlistItemData{Name: "Pencil"}},
+			{Op: wishlistgen.BatchItemOperationOpDelete, ItemId: &missing},
+		}, now)
+
+		if ok {
+			t.Fatal("Expected batch to be rejected")
+		}
+		statuses := []wishlistgen.BatchItemResultStatus{results[0].Status, results[1].Status, results[2].Status}
+		expected := []wishlistgen.BatchItemResultStatus{wishlistgen.BatchItemResultStatusOk, wishlistgen.BatchItemResultStatusFailed, wishlistgen.BatchItemResultStatusFailed}
+		for i := range expected {
+			if statuses[i] != expected[i] {
+				t.Errorf("Expected result %d to be %s, got %s", i, expected[i], statuses[i])
+			}
+		}
+		if results[2].Error == nil || !strings.Contains(*results[2].Error, missing.String()) {
+			t.Errorf("Expected error naming the missing item, got %v", results[2].Error)
+		}
+	})
+}
+
+func TestBatchRouteRequiresAuth(t *testing.T) {
+	handler := wishlistgen.Handler(NewWishlistServer(nil, nil, time.Hour))
+	wishlistID := uuid.New()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/wishlists/"+wishlistID.String()+"/items:batch", strings.NewReader(`{}`)))
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// mongoBookerContact keeps a booker's email apart from the wishlist, so it can
+// never leak to the owner through wishlists, history, exports or events. It is
+// deleted when the booking ends and expires the day after the event, or after
+// the retention when there is no event date.
+type mongoBookerContact struct {
+	BookingID  string    `bson:"bookingId"`
+	ItemID     string    `bson:"itemId"`
+	WishlistID string    `bson:"wishlistId"`
+	Email      string    `bson:"email"`
+	Language   string    `bson:"language"`
+	CreatedAt  time.Time `bson:"createdAt"`
+	ExpiresAt  time.Time `bson:"expiresAt"`
+}
+
+// KeepBookerContacts makes BookItem store booker emails for receipts; without
+// it they are dropped
+func (r *MongoRepo) KeepBookerContacts(retention time.Duration) {
+	r.contactRetention = retention
+}
+
+func (r *MongoRepo) saveBookerContact(ctx context.Context, wishlistID, itemID, bookingID string, req wishlistgen.BookItemRequest, eventDate *time.Time, now time.Time) (bool, error) {
+	if req.BookerEmail == nil || r.contactRetention <= 0 {
+		return false, nil
+	}
+	language := wishlistgen.Ru
+	if req.Language != nil {
+		language = *req.Language
+	}
+	expiresAt := now.Add(r.contactRetention)
+	if eventDate != nil && eventDate.After(now) && eventDate.AddDate(0, 0, 1).Before(expiresAt) {
+		expiresAt = eventDate.AddDate(0, 0, 1)
+	}
+	_, err := r.contacts.InsertOne(ctx, mongoBookerContact{
+		BookingID:  bookingID,
+		ItemID:     itemID,
+		WishlistID: wishlistID,
+		Email:      string(*req.BookerEmail),
+		Language:   string(language),
+		CreatedAt:  now,
+		ExpiresAt:  expiresAt,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to save booker contact: %w", err)
+	}
+	return true, nil
+}
+
+func (r *MongoRepo) findBookerContact(ctx context.Context, bookingID string) (*mongoBookerContact, error) {
+	var contact mongoBookerContact
+	err := r.contacts.FindOne(ctx, bson.M{"bookingId": bookingID}).Decode(&contact)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booker contact: %w", err)
+	}
+	return &contact, nil
+}
+
+// findBooking returns a booked item and its wishlist, or nil once the booking ended
+func (r *MongoRepo) findBooking(ctx context.Context, itemID, bookingID string) (*mongoWishlist, *mongoWishlistItem, error) {
+	var mw mongoWishlist
+	err := r.wishlists.FindOne(ctx, bson.M{
+		"items": bson.M{"$elemMatch": bson.M{"id": itemID, "booking.bookingId": bookingID}},
+	}).Decode(&mw)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find booking: %w", err)
+	}
+	return &mw, findItem(&mw, itemID), nil
+}
+
+// forgetBookerContacts deletes the contacts of ended bookings of an item
+func (r *MongoRepo) forgetBookerContacts(ctx context.Context, itemID string) {
+	if _, err := r.contacts.DeleteMany(ctx, bson.M{"itemId": itemID}); err != nil {
+		r.logger.LogError(ctx, nil, "forget_booker_contacts", err, fmt.Sprintf("failed to delete booker contacts of item %s", itemID))
+	}
+}
+
+func (n *emailNotifier) cancelURL(wishlistID, itemID, cancellationToken string) string {
+	return fmt.Sprintf("%s/wishlists/%s/items/%s/booking/cancel?cancellationToken=%s", n.apiURL, wishlistID, itemID, url.QueryEscape(cancellationToken))
+}
+
+// SendReceipt is a domainBus handler emailing bookers who left an address the
+// details of their booking and a cancel link
+func (n *emailNotifier) SendReceipt(ctx context.Context, event domainEvent) error {
+	if event.Type != "book_item" || event.Wishlist == nil || len(event.ItemIDs) == 0 {
+		return nil
+	}
+	var bookingID string
+	for _, item := range event.Wishlist.Items {
+		if item.Id.String() == event.ItemIDs[0] && item.Booking != nil {
+			bookingID = item.Booking.BookingId.String()
+		}
+	}
+	if bookingID == "" {
+		return nil
+	}
+
+	contact, err := n.repo.findBookerContact(ctx, bookingID)
+	if err != nil || contact == nil {
+		return err
+	}
+	mw, item, err := n.repo.findBooking(ctx, event.ItemIDs[0], bookingID)
+	if err != nil || item == nil {
+		return err
+	}
+
+	key := event.ID + ":receipt"
+	sent, err := n.repo.emailSent(ctx, key)
+	if err != nil || sent {
+		return err
+	}
+
+	data := emailData{
+		Kind:          emailKindBookingReceipt,
+		WishlistTitle: mw.Title,
+		WishlistURL:   n.wishlistURL(mw.UUID),
+		CancelURL:     n.cancelURL(mw.UUID, item.ID, item.Booking.CancellationToken),
+	}
+	if name, ok := item.Data["name"].(string); ok {
+		data.ItemName = name
+	}
+	if item.Booking.BookerName != nil {
+		data.BookerName = *item.Booking.BookerName
+	}
+	if item.Booking.Message != nil {
+		data.Message = *item.Booking.Message
+	}
+
+	msg, err := renderEmail(wishlistgen.TemplateLanguage(contact.Language), contact.Email, []emailData{data}, "")
+	if err != nil {
+		return fmt.Errorf("failed to render receipt: %w", err)
+	}
+	if err := n.mailer.Send(ctx, msg); err != nil {
+		return err
+	}
+	return n.repo.markEmailsSent(ctx, "", []string{key})
+}
+
+var bookingCancelPage = template.Must(template.New("cancel").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Wili</title></head>
+<body style="font-family: sans-serif;">
+<p>{{.Text}}</p>
+{{if .Button}}<form method="post"><button type="submit">{{.Button}}</button></form>
+{{end}}</body></html>
+`))
+
+var (
+	bookingCancelQuestion = localized{
+		wishlistgen.En: "Cancel your booking of «%s»?",
+		wishlistgen.Ru: "Отменить бронь «%s»?",
+	}
+	bookingCancelledText = localized{
+		wishlistgen.En: "Your booking of «%s» has been cancelled.",
+		wishlistgen.Ru: "Бронь «%s» отменена.",
+	}
+	bookingNotFoundText = localized{
+		wishlistgen.En: "This booking no longer exists.",
+		wishlistgen.Ru: "Этой брони больше нет.",
+	}
+)
+
+type bookingCancelPageData struct {
+	Text   string
+	Button string
+}
+
+func (s *WishlistServer) writeBookingCancelPage(w http.ResponseWriter, status int, data bookingCancelPageData) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	bookingCancelPage.Execute(w, data)
+}
+
+// bookerLanguage is the language the booker chose when booking
+func (s *WishlistServer) bookerLanguage(ctx context.Context, bookingID string) wishlistgen.TemplateLanguage {
+	contact, err := s.repo.findBookerContact(ctx, bookingID)
+	if err != nil || contact == nil || !isSupportedLanguage(wishlistgen.TemplateLanguage(contact.Language)) {
+		return wishlistgen.Ru
+	}
+	return wishlistgen.TemplateLanguage(contact.Language)
+}
+
+// Confirmation page of the cancel link in booking receipts
+func (s *WishlistServer) GetWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdItemsItemIdBookingCancelParams) {
+	s.logger.LogRequest(r, nil, "cancel_booking_page")
+
+	status, err := s.repo.GetBookingStatus(r.Context(), itemId, params.CancellationToken.String())
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), nil, "booking", fmt.Sprintf("for item %s", itemId.String()))
+			s.writeBookingCancelPage(w, http.StatusNotFound, bookingCancelPageData{Text: bookingNotFoundText[wishlistgen.Ru]})
+			return
+		}
+		s.logger.LogError(r.Context(), nil, "cancel_booking_page", err, fmt.Sprintf("failed to look up booking of item %s", itemId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve booking")
+		return
+	}
+
+	language := s.bookerLanguage(r.Context(), status.BookingId.String())
+	s.logger.LogSuccess(r.Context(), nil, "cancel_booking_page", fmt.Sprintf("showed cancel page for item %s", itemId.String()))
+	s.writeBookingCancelPage(w, http.StatusOK, bookingCancelPageData{
+		Text:   fmt.Sprintf(bookingCancelQuestion[language], status.ItemName),
+		Button: emailCancelLabel[language],
+	})
+}
+
+// Cancel a booking from the confirmation page of a receipt
+func (s *WishlistServer) PostWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsItemIdBookingCancelParams) {
+	s.logger.LogRequest(r, nil, "cancel_booking")
+
+	token := params.CancellationToken.String()
+	status, err := s.repo.GetBookingStatus(r.Context(), itemId, token)
+	if err == nil {
+		// Look the language up before unbooking deletes the contact
+		language := s.bookerLanguage(r.Context(), status.BookingId.String())
+		if _, err = s.repo.UnbookItemByToken(r.Context(), itemId, token, nil); err == nil {
+			s.logger.LogSuccess(r.Context(), nil, "cancel_booking", fmt.Sprintf("cancelled booking of item %s via receipt", itemId.String()))
+			s.writeBookingCancelPage(w, http.StatusOK, bookingCancelPageData{Text: fmt.Sprintf(bookingCancelledText[language], status.ItemName)})
+			return
+		}
+	}
+
+	if strings.Contains(err.Error(), "not found") {
+		s.logger.LogNotFound(r.Context(), nil, "booking", fmt.Sprintf("for item %s", itemId.String()))
+		s.writeBookingCancelPage(w, http.StatusNotFound, bookingCancelPageData{Text: bookingNotFoundText[wishlistgen.Ru]})
+		return
+	}
+	s.logger.LogError(r.Context(), nil, "cancel_booking", err, fmt.Sprintf("failed to cancel booking of item %s", itemId.String()))
+	s.writeError(w, http.StatusInternalServerError, "Failed to cancel booking")
+}
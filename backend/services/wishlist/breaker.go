@@ -0,0 +1,67 @@
+package main
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// errCircuitOpen is returned instead of calling a dependency that kept failing
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+// circuitBreaker stops calls to a dependency after threshold consecutive
+// failures. Once cooldown has passed it lets a single call through: the
+// circuit closes when that call succeeds and stays open for another cooldown
+// when it fails.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// Allow reports whether a call may be made; every allowed call must be
+// followed by Done with its outcome
+func (b *circuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return nil
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return errCircuitOpen
+	}
+	b.probing = true
+	return nil
+}
+
+// Done records the outcome of an allowed call
+func (b *circuitBreaker) Done(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+	if success {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+}
+
+// Abandon ends an allowed call that says nothing about the dependency, such
+// as one its caller cancelled
+func (b *circuitBreaker) Abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
@@ -22,15 +22,254 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ActivityEventType.
+const (
+	ActivityEventTypeItemAdded           ActivityEventType = "item_added"
+	ActivityEventTypeItemBooked          ActivityEventType = "item_booked"
+	ActivityEventTypeItemEdited          ActivityEventType = "item_edited"
+	ActivityEventTypeItemRemoved         ActivityEventType = "item_removed"
+	ActivityEventTypeItemUnbookedByOwner ActivityEventType = "item_unbooked_by_owner"
+	ActivityEventTypeItemUnbookedByToken ActivityEventType = "item_unbooked_by_token"
+)
+
+// Defines values for BatchItemOperationOp.
+const (
+	BatchItemOperationOpCreate BatchItemOperationOp = "create"
+	BatchItemOperationOpDelete BatchItemOperationOp = "delete"
+	BatchItemOperationOpUpdate BatchItemOperationOp = "update"
+)
+
+// Defines values for BatchItemResultOp.
+const (
+	BatchItemResultOpCreate BatchItemResultOp = "create"
+	BatchItemResultOpDelete BatchItemResultOp = "delete"
+	BatchItemResultOpUpdate BatchItemResultOp = "update"
+)
+
+// Defines values for BatchItemResultStatus.
+const (
+	BatchItemResultStatusFailed BatchItemResultStatus = "failed"
+	BatchItemResultStatusOk     BatchItemResultStatus = "ok"
+)
+
+// Defines values for BookingStatusState.
+const (
+	BookingStatusStateActive          BookingStatusState = "active"
+	BookingStatusStateItemRemoved     BookingStatusState = "item_removed"
+	BookingStatusStateWishlistRemoved BookingStatusState = "wishlist_removed"
+)
+
+// Defines values for EmailCategory.
+const (
+	Bookings  EmailCategory = "bookings"
+	Reminders EmailCategory = "reminders"
+)
+
+// Defines values for EmailDelivery.
+const (
+	Digest  EmailDelivery = "digest"
+	Instant EmailDelivery = "instant"
+)
+
+// Defines values for RevisionChangeKind.
+const (
+	Added    RevisionChangeKind = "added"
+	Booked   RevisionChangeKind = "booked"
+	Modified RevisionChangeKind = "modified"
+	Removed  RevisionChangeKind = "removed"
+	Unbooked RevisionChangeKind = "unbooked"
+)
+
+// Defines values for RevisionChangeTarget.
+const (
+	RevisionChangeTargetItem     RevisionChangeTarget = "item"
+	RevisionChangeTargetWishlist RevisionChangeTarget = "wishlist"
+)
+
+// Defines values for TemplateLanguage.
+const (
+	En TemplateLanguage = "en"
+	Ru TemplateLanguage = "ru"
+)
+
+// Defines values for TransferItemsRequestBookingPolicy.
+const (
+	Keep    TransferItemsRequestBookingPolicy = "keep"
+	Reject  TransferItemsRequestBookingPolicy = "reject"
+	Release TransferItemsRequestBookingPolicy = "release"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	ItemAdded           WebhookEventType = "item_added"
+	ItemBooked          WebhookEventType = "item_booked"
+	ItemEdited          WebhookEventType = "item_edited"
+	ItemRemoved         WebhookEventType = "item_removed"
+	ItemUnbookedByOwner WebhookEventType = "item_unbooked_by_owner"
+	ItemUnbookedByToken WebhookEventType = "item_unbooked_by_token"
+)
+
+// Defines values for WishlistPrivacyBookerVisibility.
+const (
+	Anonymous WishlistPrivacyBookerVisibility = "anonymous"
+	Surprise  WishlistPrivacyBookerVisibility = "surprise"
+	Visible   WishlistPrivacyBookerVisibility = "visible"
+)
+
+// Defines values for PostWishlistsImportParamsFormat.
+const (
+	PostWishlistsImportParamsFormatCsv  PostWishlistsImportParamsFormat = "csv"
+	PostWishlistsImportParamsFormatJson PostWishlistsImportParamsFormat = "json"
+	PostWishlistsImportParamsFormatMd   PostWishlistsImportParamsFormat = "md"
+	PostWishlistsImportParamsFormatUrls PostWishlistsImportParamsFormat = "urls"
+)
+
+// Defines values for GetWishlistsWishlistIdExportParamsFormat.
+const (
+	GetWishlistsWishlistIdExportParamsFormatCsv  GetWishlistsWishlistIdExportParamsFormat = "csv"
+	GetWishlistsWishlistIdExportParamsFormatJson GetWishlistsWishlistIdExportParamsFormat = "json"
+	GetWishlistsWishlistIdExportParamsFormatMd   GetWishlistsWishlistIdExportParamsFormat = "md"
+)
+
+// ActivityBooker Who booked or released the item; null when the privacy settings anonymize bookers
+type ActivityBooker struct {
+	Message *string `json:"message"`
+	Name    *string `json:"name"`
+}
+
+// ActivityEvent defines model for ActivityEvent.
+type ActivityEvent struct {
+	// Booker Who booked or released the item; null when the privacy settings anonymize bookers
+	Booker    *ActivityBooker `json:"booker"`
+	CreatedAt time.Time       `json:"createdAt"`
+
+	// Fields Changed fields of "item_edited" events (e.g. "data.url")
+	Fields   *[]string          `json:"fields,omitempty"`
+	Id       string             `json:"id"`
+	ItemId   openapi_types.UUID `json:"itemId"`
+	ItemName string             `json:"itemName"`
+	Type     ActivityEventType  `json:"type"`
+}
+
+// ActivityEventType defines model for ActivityEvent.Type.
+type ActivityEventType string
+
+// ActivityFeed defines model for ActivityFeed.
+type ActivityFeed struct {
+	Events []ActivityEvent `json:"events"`
+
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// BatchItemOperation defines model for BatchItemOperation.
+type BatchItemOperation struct {
+	// Data Item-specific data payload. All items must have a name.
+	// Description and other properties are optional to support different item types
+	// (e.g., marketplace items with SKU, price, etc.).
+	Data *WishlistItemData `json:"data,omitempty"`
+
+	// ItemId Target item (update and delete)
+	ItemId *openapi_types.UUID  `json:"itemId,omitempty"`
+	Op     BatchItemOperationOp `json:"op"`
+
+	// Type Item type (required for create)
+	Type *string `json:"type,omitempty"`
+}
+
+// BatchItemOperationOp defines model for BatchItemOperation.Op.
+type BatchItemOperationOp string
+
+// BatchItemResult defines model for BatchItemResult.
+type BatchItemResult struct {
+	Error *string `json:"error,omitempty"`
+
+	// Index Position of the operation in the request
+	Index  int                   `json:"index"`
+	Item   *WishlistItem         `json:"item,omitempty"`
+	Op     BatchItemResultOp     `json:"op"`
+	Status BatchItemResultStatus `json:"status"`
+}
+
+// BatchItemResultOp defines model for BatchItemResult.Op.
+type BatchItemResultOp string
+
+// BatchItemResultStatus defines model for BatchItemResult.Status.
+type BatchItemResultStatus string
+
+// BatchItemsRequest defines model for BatchItemsRequest.
+type BatchItemsRequest struct {
+	Operations []BatchItemOperation `json:"operations"`
+}
+
+// BatchItemsResponse defines model for BatchItemsResponse.
+type BatchItemsResponse struct {
+	// Applied Whether the operations were applied
+	Applied bool              `json:"applied"`
+	Results []BatchItemResult `json:"results"`
+
+	// Version Wishlist version after the batch (only when applied)
+	Version *int64 `json:"version,omitempty"`
+}
+
 // BookItemRequest defines model for BookItemRequest.
 type BookItemRequest struct {
+	// BookerEmail Optional address the booking receipt with a cancel link is sent to.
+	// Never shown to the wishlist owner and deleted once the booking ends.
+	BookerEmail *openapi_types.Email `json:"bookerEmail,omitempty"`
+
 	// BookerName Optional name of the person booking the item. If not provided, booking will be anonymous.
-	BookerName *string `json:"bookerName,omitempty"`
+	BookerName *string           `json:"bookerName,omitempty"`
+	Language   *TemplateLanguage `json:"language,omitempty"`
 
 	// Message Optional message from the booker to the wishlist owner.
 	Message *string `json:"message,omitempty"`
 }
 
+// BookItemResponse defines model for BookItemResponse.
+type BookItemResponse struct {
+	// BookedAt When the item was booked
+	BookedAt time.Time `json:"bookedAt"`
+
+	// BookerName Name of the person who booked the item (null for anonymous bookings)
+	BookerName *string `json:"bookerName"`
+
+	// BookingId Unique identifier for this booking
+	BookingId openapi_types.UUID `json:"bookingId"`
+
+	// CancellationToken Secret token that allows the booker to cancel their booking. Store this securely!
+	CancellationToken openapi_types.UUID `json:"cancellationToken"`
+
+	// Message Optional message from the booker
+	Message *string `json:"message"`
+}
+
+// BookingStatus defines model for BookingStatus.
+type BookingStatus struct {
+	BookedAt  time.Time          `json:"bookedAt"`
+	BookingId openapi_types.UUID `json:"bookingId"`
+	ItemName  string             `json:"itemName"`
+
+	// Purchased Whether the booker marked the gift as bought; never shown to the owner
+	Purchased bool `json:"purchased"`
+
+	// RemovedAt When the item or wishlist was removed
+	RemovedAt *time.Time `json:"removedAt"`
+
+	// State Whether the booked item is still on the wishlist
+	State BookingStatusState `json:"state"`
+}
+
+// BookingStatusState Whether the booked item is still on the wishlist
+type BookingStatusState string
+
 // ConflictErrorResponse defines model for ConflictErrorResponse.
 type ConflictErrorResponse struct {
 	// Error Error type
@@ -40,6 +279,30 @@ type ConflictErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// CopyWishlistRequest defines model for CopyWishlistRequest.
+type CopyWishlistRequest struct {
+	// Description Description of the copy (defaults to the original description)
+	Description *string `json:"description"`
+
+	// OnlyUnbooked Copy only items that are not booked
+	OnlyUnbooked *bool `json:"onlyUnbooked,omitempty"`
+
+	// Title Title of the copy (defaults to the original title)
+	Title *string `json:"title,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Events Event types to send; all when omitted
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Url Absolute http(s) URL receiving the events
+	Url string `json:"url"`
+
+	// WishlistId Only send events of this wishlist; all wishlists of the user when omitted
+	WishlistId *openapi_types.UUID `json:"wishlistId"`
+}
+
 // CreateWishlistItemRequest defines model for CreateWishlistItemRequest.
 type CreateWishlistItemRequest struct {
 	// Data Item-specific data payload. All items must have a name.
@@ -54,12 +317,57 @@ type CreateWishlistItemRequest struct {
 // CreateWishlistRequest defines model for CreateWishlistRequest.
 type CreateWishlistRequest struct {
 	// Description Optional wishlist description
-	Description *string `json:"description"`
+	Description *string           `json:"description"`
+	Event       *WishlistEvent    `json:"event,omitempty"`
+	Language    *TemplateLanguage `json:"language,omitempty"`
+
+	// TemplateId Template whose items the new wishlist starts with (see GET /templates)
+	TemplateId *string `json:"templateId,omitempty"`
 
 	// Title Wishlist title
 	Title string `json:"title"`
 }
 
+// EmailCategory Category of email and inbox notifications. "bookings": items of your
+// wishlists were booked or unbooked; "reminders": upcoming events and
+// bookings about to expire.
+type EmailCategory string
+
+// EmailDelivery Send every email right away or collect them into one email a day
+type EmailDelivery string
+
+// EmailPreferences defines model for EmailPreferences.
+type EmailPreferences struct {
+	// Delivery Send every email right away or collect them into one email a day
+	Delivery EmailDelivery    `json:"delivery"`
+	Language TemplateLanguage `json:"language"`
+
+	// Unsubscribed Categories no email is sent for
+	Unsubscribed []EmailCategory `json:"unsubscribed"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Errors []ImportRowError `json:"errors"`
+
+	// Imported Number of items imported
+	Imported int      `json:"imported"`
+	Wishlist Wishlist `json:"wishlist"`
+}
+
+// ImportRowError defines model for ImportRowError.
+type ImportRowError struct {
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
+	Row     int     `json:"row"`
+}
+
+// InboxPreferences defines model for InboxPreferences.
+type InboxPreferences struct {
+	// Muted Categories that are not added to the inbox
+	Muted []EmailCategory `json:"muted"`
+}
+
 // ItemBooking defines model for ItemBooking.
 type ItemBooking struct {
 	// BookedAt When the item was booked
@@ -75,6 +383,160 @@ type ItemBooking struct {
 	Message *string `json:"message"`
 }
 
+// MarkNotificationsReadResponse defines model for MarkNotificationsReadResponse.
+type MarkNotificationsReadResponse struct {
+	// Updated Number of notifications that were unread
+	Updated int `json:"updated"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// BookerName Left out unless the wishlist shows bookers
+	BookerName *string `json:"bookerName"`
+
+	// Category Category of email and inbox notifications. "bookings": items of your
+	// wishlists were booked or unbooked; "reminders": upcoming events and
+	// bookings about to expire.
+	Category  EmailCategory `json:"category"`
+	CreatedAt time.Time     `json:"createdAt"`
+
+	// DaysLeft Days until the event or expiry, for reminders
+	DaysLeft *int   `json:"daysLeft"`
+	Id       string `json:"id"`
+
+	// ItemName Left out for surprise wishlists
+	ItemName *string `json:"itemName"`
+	Message  *string `json:"message"`
+	Read     bool    `json:"read"`
+
+	// Type item_booked, item_unbooked, event_soon or booking_expiring
+	Type          string             `json:"type"`
+	WishlistId    openapi_types.UUID `json:"wishlistId"`
+	WishlistTitle string             `json:"wishlistTitle"`
+}
+
+// NotificationFeed defines model for NotificationFeed.
+type NotificationFeed struct {
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor    *string        `json:"nextCursor"`
+	Notifications []Notification `json:"notifications"`
+	UnreadCount   int            `json:"unreadCount"`
+}
+
+// PortableItem defines model for PortableItem.
+type PortableItem struct {
+	// Booked Export only; ignored on import
+	Booked *bool `json:"booked,omitempty"`
+
+	// Currency ISO 4217 code
+	Currency    *string  `json:"currency,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Price       *float64 `json:"price,omitempty"`
+	Section     *string  `json:"section,omitempty"`
+
+	// Type Item type, "general" when omitted
+	Type *string `json:"type,omitempty"`
+	Url  *string `json:"url,omitempty"`
+}
+
+// PortableWishlist defines model for PortableWishlist.
+type PortableWishlist struct {
+	Description *string        `json:"description,omitempty"`
+	ExportedAt  *time.Time     `json:"exportedAt,omitempty"`
+	Items       []PortableItem `json:"items"`
+	Title       string         `json:"title"`
+}
+
+// RevertRequest defines model for RevertRequest.
+type RevertRequest struct {
+	// Revision Revision to revert to
+	Revision int64 `json:"revision"`
+}
+
+// RevisionChange defines model for RevisionChange.
+type RevisionChange struct {
+	// Fields Changed fields for "modified" changes (e.g. "title", "data.url")
+	Fields   *[]string            `json:"fields,omitempty"`
+	ItemId   *openapi_types.UUID  `json:"itemId,omitempty"`
+	ItemName *string              `json:"itemName,omitempty"`
+	Kind     RevisionChangeKind   `json:"kind"`
+	Target   RevisionChangeTarget `json:"target"`
+}
+
+// RevisionChangeKind defines model for RevisionChange.Kind.
+type RevisionChangeKind string
+
+// RevisionChangeTarget defines model for RevisionChange.Target.
+type RevisionChangeTarget string
+
+// TemplateLanguage defines model for TemplateLanguage.
+type TemplateLanguage string
+
+// TemplatesResponse defines model for TemplatesResponse.
+type TemplatesResponse struct {
+	Templates []WishlistTemplate `json:"templates"`
+}
+
+// TransferItemsRequest defines model for TransferItemsRequest.
+type TransferItemsRequest struct {
+	// BookingPolicy Moves only. reject fails if any item is booked, keep moves bookings
+	// with their items, release cancels them.
+	BookingPolicy    *TransferItemsRequestBookingPolicy `json:"bookingPolicy,omitempty"`
+	ItemIds          []openapi_types.UUID               `json:"itemIds"`
+	TargetWishlistId openapi_types.UUID                 `json:"targetWishlistId"`
+}
+
+// TransferItemsRequestBookingPolicy Moves only. reject fails if any item is booked, keep moves bookings
+// with their items, release cancels them.
+type TransferItemsRequestBookingPolicy string
+
+// TransferItemsResponse defines model for TransferItemsResponse.
+type TransferItemsResponse struct {
+	Source Wishlist `json:"source"`
+	Target Wishlist `json:"target"`
+}
+
+// TrashResponse defines model for TrashResponse.
+type TrashResponse struct {
+	// Items Items deleted from wishlists that are not in the trash themselves
+	Items     []TrashedItem     `json:"items"`
+	Wishlists []TrashedWishlist `json:"wishlists"`
+}
+
+// TrashedItem defines model for TrashedItem.
+type TrashedItem struct {
+	DeletedAt time.Time    `json:"deletedAt"`
+	Item      WishlistItem `json:"item"`
+
+	// PurgeAt When the item will be permanently deleted
+	PurgeAt       time.Time          `json:"purgeAt"`
+	WishlistId    openapi_types.UUID `json:"wishlistId"`
+	WishlistTitle string             `json:"wishlistTitle"`
+}
+
+// TrashedWishlist defines model for TrashedWishlist.
+type TrashedWishlist struct {
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// PurgeAt When the wishlist will be permanently deleted
+	PurgeAt  time.Time `json:"purgeAt"`
+	Wishlist Wishlist  `json:"wishlist"`
+}
+
+// UnreadNotificationCount defines model for UnreadNotificationCount.
+type UnreadNotificationCount struct {
+	Count int `json:"count"`
+}
+
+// UpdateEmailPreferencesRequest defines model for UpdateEmailPreferencesRequest.
+type UpdateEmailPreferencesRequest struct {
+	// Delivery Send every email right away or collect them into one email a day
+	Delivery     *EmailDelivery    `json:"delivery,omitempty"`
+	Language     *TemplateLanguage `json:"language,omitempty"`
+	Unsubscribed *[]EmailCategory  `json:"unsubscribed,omitempty"`
+}
+
 // UpdateWishlistItemRequest defines model for UpdateWishlistItemRequest.
 type UpdateWishlistItemRequest struct {
 	// Data Item-specific data payload. All items must have a name.
@@ -89,7 +551,9 @@ type UpdateWishlistItemRequest struct {
 // UpdateWishlistRequest defines model for UpdateWishlistRequest.
 type UpdateWishlistRequest struct {
 	// Description Updated wishlist description
-	Description *string `json:"description"`
+	Description *string          `json:"description"`
+	Event       *WishlistEvent   `json:"event,omitempty"`
+	Privacy     *WishlistPrivacy `json:"privacy,omitempty"`
 
 	// Title Updated wishlist title
 	Title *string `json:"title,omitempty"`
@@ -113,15 +577,98 @@ type ValidationErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events Event types sent; empty means all
+	Events []WebhookEventType `json:"events"`
+	Id     openapi_types.UUID `json:"id"`
+
+	// Secret Signing secret, only returned when the webhook is created
+	Secret     *string             `json:"secret,omitempty"`
+	Url        string              `json:"url"`
+	WishlistId *openapi_types.UUID `json:"wishlistId"`
+}
+
+// WebhookDeliveries defines model for WebhookDeliveries.
+type WebhookDeliveries struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int                `json:"attempts"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	DeliveredAt   *time.Time         `json:"deliveredAt"`
+	EventId       string             `json:"eventId"`
+	EventType     WebhookEventType   `json:"eventType"`
+	Id            openapi_types.UUID `json:"id"`
+	LastError     *string            `json:"lastError"`
+	NextAttemptAt *time.Time         `json:"nextAttemptAt"`
+
+	// Payload Body POSTed to the webhook URL
+	Payload      WebhookEvent        `json:"payload"`
+	RedeliveryOf *openapi_types.UUID `json:"redeliveryOf"`
+
+	// ResponseStatus HTTP status of the last attempt
+	ResponseStatus *int                  `json:"responseStatus"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookId      openapi_types.UUID    `json:"webhookId"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEvent Body POSTed to the webhook URL
+type WebhookEvent struct {
+	// Booker Who booked or released the item; null when the privacy settings anonymize bookers
+	Booker *ActivityBooker `json:"booker"`
+	Fields *[]string       `json:"fields,omitempty"`
+
+	// Id Event id, the same across redeliveries
+	Id         string             `json:"id"`
+	ItemId     openapi_types.UUID `json:"itemId"`
+	ItemName   string             `json:"itemName"`
+	OccurredAt time.Time          `json:"occurredAt"`
+	Type       WebhookEventType   `json:"type"`
+	WishlistId openapi_types.UUID `json:"wishlistId"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
 // Wishlist defines model for Wishlist.
 type Wishlist struct {
 	CreatedAt   time.Time          `json:"createdAt"`
 	Description *string            `json:"description"`
+	Event       WishlistEvent      `json:"event"`
 	Id          openapi_types.UUID `json:"id"`
 	Items       []WishlistItem     `json:"items"`
+	Privacy     WishlistPrivacy    `json:"privacy"`
 	Title       string             `json:"title"`
 	UpdatedAt   time.Time          `json:"updatedAt"`
 	UserId      openapi_types.UUID `json:"userId"`
+
+	// Version Monotonically increasing revision, bumped on every change; exposed as ETag
+	Version int64 `json:"version"`
+}
+
+// WishlistEvent defines model for WishlistEvent.
+type WishlistEvent struct {
+	// Date Day of the occasion the wishlist is for. Bookers and owners get
+	// reminders ahead of it; null when there is no date.
+	Date *openapi_types.Date `json:"date"`
+}
+
+// WishlistHistory defines model for WishlistHistory.
+type WishlistHistory struct {
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor *string            `json:"nextCursor"`
+	Revisions  []WishlistRevision `json:"revisions"`
 }
 
 // WishlistItem defines model for WishlistItem.
@@ -155,97 +702,388 @@ type WishlistItemData struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// DeleteWishlistsWishlistIdItemsItemIdUnbookParams defines parameters for DeleteWishlistsWishlistIdItemsItemIdUnbook.
-type DeleteWishlistsWishlistIdItemsItemIdUnbookParams struct {
-	// BookingId ID of the booking to unbook
-	BookingId openapi_types.UUID `form:"bookingId" json:"bookingId"`
+// WishlistPrivacy defines model for WishlistPrivacy.
+type WishlistPrivacy struct {
+	// BookerVisibility How bookers appear to the owner in the activity feed: "visible" shows
+	// names and messages, "anonymous" shows bookings without saying who made
+	// them, "surprise" hides booking events altogether.
+	BookerVisibility WishlistPrivacyBookerVisibility `json:"bookerVisibility"`
 }
 
-// PostWishlistsJSONRequestBody defines body for PostWishlists for application/json ContentType.
-type PostWishlistsJSONRequestBody = CreateWishlistRequest
-
-// PutWishlistsWishlistIdJSONRequestBody defines body for PutWishlistsWishlistId for application/json ContentType.
-type PutWishlistsWishlistIdJSONRequestBody = UpdateWishlistRequest
-
-// PostWishlistsWishlistIdItemsJSONRequestBody defines body for PostWishlistsWishlistIdItems for application/json ContentType.
-type PostWishlistsWishlistIdItemsJSONRequestBody = CreateWishlistItemRequest
+// WishlistPrivacyBookerVisibility How bookers appear to the owner in the activity feed: "visible" shows
+// names and messages, "anonymous" shows bookings without saying who made
+// them, "surprise" hides booking events altogether.
+type WishlistPrivacyBookerVisibility string
 
-// PutWishlistsWishlistIdItemsItemIdJSONRequestBody defines body for PutWishlistsWishlistIdItemsItemId for application/json ContentType.
-type PutWishlistsWishlistIdItemsItemIdJSONRequestBody = UpdateWishlistItemRequest
+// WishlistRevision defines model for WishlistRevision.
+type WishlistRevision struct {
+	// Action Operation that produced the revision (e.g. "update_item")
+	Action string `json:"action"`
 
-// PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody defines body for PostWishlistsWishlistIdItemsItemIdBook for application/json ContentType.
-type PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody = BookItemRequest
+	// ActorId User who made the change (null for anonymous guests, e.g. bookings)
+	ActorId   *openapi_types.UUID `json:"actorId"`
+	Changes   []RevisionChange    `json:"changes"`
+	CreatedAt time.Time           `json:"createdAt"`
 
-// Getter for additional properties for WishlistItemData. Returns the specified
-// element and whether it was found
-func (a WishlistItemData) Get(fieldName string) (value interface{}, found bool) {
-	if a.AdditionalProperties != nil {
-		value, found = a.AdditionalProperties[fieldName]
-	}
-	return
+	// Revision Wishlist version produced by this change
+	Revision int64 `json:"revision"`
 }
 
-// Setter for additional properties for WishlistItemData
-func (a *WishlistItemData) Set(fieldName string, value interface{}) {
-	if a.AdditionalProperties == nil {
-		a.AdditionalProperties = make(map[string]interface{})
-	}
-	a.AdditionalProperties[fieldName] = value
+// WishlistTemplate defines model for WishlistTemplate.
+type WishlistTemplate struct {
+	Description *string                     `json:"description,omitempty"`
+	Id          string                      `json:"id"`
+	Items       []CreateWishlistItemRequest `json:"items"`
+	Title       string                      `json:"title"`
 }
 
-// Override default JSON handling for WishlistItemData to handle AdditionalProperties
-func (a *WishlistItemData) UnmarshalJSON(b []byte) error {
-	object := make(map[string]json.RawMessage)
-	err := json.Unmarshal(b, &object)
-	if err != nil {
-		return err
-	}
+// CancellationTokenQuery defines model for CancellationTokenQuery.
+type CancellationTokenQuery = openapi_types.UUID
 
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
-		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
-		}
-		delete(object, "description")
-	}
+// IfMatch defines model for IfMatch.
+type IfMatch = string
 
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
-		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
-		}
-		delete(object, "name")
-	}
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
 
-	if raw, found := object["url"]; found {
-		err = json.Unmarshal(raw, &a.Url)
-		if err != nil {
-			return fmt.Errorf("error reading 'url': %w", err)
-		}
-		delete(object, "url")
-	}
+// ItemIdPath defines model for ItemIdPath.
+type ItemIdPath = openapi_types.UUID
 
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
-		}
-	}
-	return nil
-}
+// NotificationIdPath defines model for NotificationIdPath.
+type NotificationIdPath = string
 
-// Override default JSON handling for WishlistItemData to handle AdditionalProperties
-func (a WishlistItemData) MarshalJSON() ([]byte, error) {
-	var err error
-	object := make(map[string]json.RawMessage)
+// UnsubscribeToken defines model for UnsubscribeToken.
+type UnsubscribeToken = string
 
-	if a.Description != nil {
-		object["description"], err = json.Marshal(a.Description)
+// WishlistIdPath defines model for WishlistIdPath.
+type WishlistIdPath = openapi_types.UUID
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = ConflictErrorResponse
+
+// GetNotificationsParams defines parameters for GetNotifications.
+type GetNotificationsParams struct {
+	// Unread Only list unread notifications
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetNotificationsEmailUnsubscribeParams defines parameters for GetNotificationsEmailUnsubscribe.
+type GetNotificationsEmailUnsubscribeParams struct {
+	// Token Signed token from the unsubscribe link of an email
+	Token UnsubscribeToken `form:"token" json:"token"`
+}
+
+// PostNotificationsEmailUnsubscribeParams defines parameters for PostNotificationsEmailUnsubscribe.
+type PostNotificationsEmailUnsubscribeParams struct {
+	// Token Signed token from the unsubscribe link of an email
+	Token UnsubscribeToken `form:"token" json:"token"`
+}
+
+// GetTemplatesParams defines parameters for GetTemplates.
+type GetTemplatesParams struct {
+	Language *TemplateLanguage `form:"language,omitempty" json:"language,omitempty"`
+}
+
+// GetWebhooksWebhookIdDeliveriesParams defines parameters for GetWebhooksWebhookIdDeliveries.
+type GetWebhooksWebhookIdDeliveriesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostWishlistsImportTextBody defines parameters for PostWishlistsImport.
+type PostWishlistsImportTextBody = string
+
+// PostWishlistsImportParams defines parameters for PostWishlistsImport.
+type PostWishlistsImportParams struct {
+	Format PostWishlistsImportParamsFormat `form:"format" json:"format"`
+
+	// Title Title of the new wishlist; required when the document has none (csv, urls)
+	Title *string `form:"title,omitempty" json:"title,omitempty"`
+}
+
+// PostWishlistsImportParamsFormat defines parameters for PostWishlistsImport.
+type PostWishlistsImportParamsFormat string
+
+// DeleteWishlistsWishlistIdParams defines parameters for DeleteWishlistsWishlistId.
+type DeleteWishlistsWishlistIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWishlistsWishlistIdParams defines parameters for GetWishlistsWishlistId.
+type GetWishlistsWishlistIdParams struct {
+	// IfNoneMatch ETag of a cached wishlist; returns 304 if it is still current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PutWishlistsWishlistIdParams defines parameters for PutWishlistsWishlistId.
+type PutWishlistsWishlistIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWishlistsWishlistIdActivityParams defines parameters for GetWishlistsWishlistIdActivity.
+type GetWishlistsWishlistIdActivityParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetWishlistsWishlistIdEventsParams defines parameters for GetWishlistsWishlistIdEvents.
+type GetWishlistsWishlistIdEventsParams struct {
+	// LastEventID Id of the last event received before reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetWishlistsWishlistIdExportParams defines parameters for GetWishlistsWishlistIdExport.
+type GetWishlistsWishlistIdExportParams struct {
+	Format *GetWishlistsWishlistIdExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetWishlistsWishlistIdExportParamsFormat defines parameters for GetWishlistsWishlistIdExport.
+type GetWishlistsWishlistIdExportParamsFormat string
+
+// GetWishlistsWishlistIdHistoryParams defines parameters for GetWishlistsWishlistIdHistory.
+type GetWishlistsWishlistIdHistoryParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsParams defines parameters for PostWishlistsWishlistIdItems.
+type PostWishlistsWishlistIdItemsParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsCopyParams defines parameters for PostWishlistsWishlistIdItemsCopy.
+type PostWishlistsWishlistIdItemsCopyParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsMoveParams defines parameters for PostWishlistsWishlistIdItemsMove.
+type PostWishlistsWishlistIdItemsMoveParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdParams defines parameters for DeleteWishlistsWishlistIdItemsItemId.
+type DeleteWishlistsWishlistIdItemsItemIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutWishlistsWishlistIdItemsItemIdParams defines parameters for PutWishlistsWishlistIdItemsItemId.
+type PutWishlistsWishlistIdItemsItemIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookParams defines parameters for PostWishlistsWishlistIdItemsItemIdBook.
+type PostWishlistsWishlistIdItemsItemIdBookParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingParams defines parameters for GetWishlistsWishlistIdItemsItemIdBooking.
+type GetWishlistsWishlistIdItemsItemIdBookingParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken openapi_types.UUID `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingCancelParams defines parameters for GetWishlistsWishlistIdItemsItemIdBookingCancel.
+type GetWishlistsWishlistIdItemsItemIdBookingCancelParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookingCancelParams defines parameters for PostWishlistsWishlistIdItemsItemIdBookingCancel.
+type PostWishlistsWishlistIdItemsItemIdBookingCancelParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams defines parameters for DeleteWishlistsWishlistIdItemsItemIdBookingPurchased.
+type DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams defines parameters for PutWishlistsWishlistIdItemsItemIdBookingPurchased.
+type PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// PostWishlistsWishlistIdItemsItemIdRevertParams defines parameters for PostWishlistsWishlistIdItemsItemIdRevert.
+type PostWishlistsWishlistIdItemsItemIdRevertParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdUnbookParams defines parameters for DeleteWishlistsWishlistIdItemsItemIdUnbook.
+type DeleteWishlistsWishlistIdItemsItemIdUnbookParams struct {
+	// BookingId ID of the booking to unbook (for wishlist owner)
+	BookingId *openapi_types.UUID `form:"bookingId,omitempty" json:"bookingId,omitempty"`
+
+	// CancellationToken Cancellation token received when booking (for booker)
+	CancellationToken *openapi_types.UUID `form:"cancellationToken,omitempty" json:"cancellationToken,omitempty"`
+
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsBatchParams defines parameters for PostWishlistsWishlistIdItemsBatch.
+type PostWishlistsWishlistIdItemsBatchParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdRevertParams defines parameters for PostWishlistsWishlistIdRevert.
+type PostWishlistsWishlistIdRevertParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutNotificationsEmailPreferencesJSONRequestBody defines body for PutNotificationsEmailPreferences for application/json ContentType.
+type PutNotificationsEmailPreferencesJSONRequestBody = UpdateEmailPreferencesRequest
+
+// PutNotificationsPreferencesJSONRequestBody defines body for PutNotificationsPreferences for application/json ContentType.
+type PutNotificationsPreferencesJSONRequestBody = InboxPreferences
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = CreateWebhookRequest
+
+// PostWishlistsJSONRequestBody defines body for PostWishlists for application/json ContentType.
+type PostWishlistsJSONRequestBody = CreateWishlistRequest
+
+// PostWishlistsImportJSONRequestBody defines body for PostWishlistsImport for application/json ContentType.
+type PostWishlistsImportJSONRequestBody = PortableWishlist
+
+// PostWishlistsImportTextRequestBody defines body for PostWishlistsImport for text/plain ContentType.
+type PostWishlistsImportTextRequestBody = PostWishlistsImportTextBody
+
+// PutWishlistsWishlistIdJSONRequestBody defines body for PutWishlistsWishlistId for application/json ContentType.
+type PutWishlistsWishlistIdJSONRequestBody = UpdateWishlistRequest
+
+// PostWishlistsWishlistIdCopyJSONRequestBody defines body for PostWishlistsWishlistIdCopy for application/json ContentType.
+type PostWishlistsWishlistIdCopyJSONRequestBody = CopyWishlistRequest
+
+// PostWishlistsWishlistIdItemsJSONRequestBody defines body for PostWishlistsWishlistIdItems for application/json ContentType.
+type PostWishlistsWishlistIdItemsJSONRequestBody = CreateWishlistItemRequest
+
+// PostWishlistsWishlistIdItemsCopyJSONRequestBody defines body for PostWishlistsWishlistIdItemsCopy for application/json ContentType.
+type PostWishlistsWishlistIdItemsCopyJSONRequestBody = TransferItemsRequest
+
+// PostWishlistsWishlistIdItemsMoveJSONRequestBody defines body for PostWishlistsWishlistIdItemsMove for application/json ContentType.
+type PostWishlistsWishlistIdItemsMoveJSONRequestBody = TransferItemsRequest
+
+// PutWishlistsWishlistIdItemsItemIdJSONRequestBody defines body for PutWishlistsWishlistIdItemsItemId for application/json ContentType.
+type PutWishlistsWishlistIdItemsItemIdJSONRequestBody = UpdateWishlistItemRequest
+
+// PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody defines body for PostWishlistsWishlistIdItemsItemIdBook for application/json ContentType.
+type PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody = BookItemRequest
+
+// PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody defines body for PostWishlistsWishlistIdItemsItemIdRevert for application/json ContentType.
+type PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody = RevertRequest
+
+// PostWishlistsWishlistIdItemsBatchJSONRequestBody defines body for PostWishlistsWishlistIdItemsBatch for application/json ContentType.
+type PostWishlistsWishlistIdItemsBatchJSONRequestBody = BatchItemsRequest
+
+// PostWishlistsWishlistIdRevertJSONRequestBody defines body for PostWishlistsWishlistIdRevert for application/json ContentType.
+type PostWishlistsWishlistIdRevertJSONRequestBody = RevertRequest
+
+// Getter for additional properties for WishlistItemData. Returns the specified
+// element and whether it was found
+func (a WishlistItemData) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for WishlistItemData
+func (a *WishlistItemData) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for WishlistItemData to handle AdditionalProperties
+func (a *WishlistItemData) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["url"]; found {
+		err = json.Unmarshal(raw, &a.Url)
+		if err != nil {
+			return fmt.Errorf("error reading 'url': %w", err)
+		}
+		delete(object, "url")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for WishlistItemData to handle AdditionalProperties
+func (a WishlistItemData) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
 		if err != nil {
 			return nil, fmt.Errorf("error marshaling 'description': %w", err)
 		}
@@ -345,6 +1183,66 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// GetNotifications request
+	GetNotifications(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationsEmailPreferences request
+	GetNotificationsEmailPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutNotificationsEmailPreferencesWithBody request with any body
+	PutNotificationsEmailPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutNotificationsEmailPreferences(ctx context.Context, body PutNotificationsEmailPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationsEmailUnsubscribe request
+	GetNotificationsEmailUnsubscribe(ctx context.Context, params *GetNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNotificationsEmailUnsubscribe request
+	PostNotificationsEmailUnsubscribe(ctx context.Context, params *PostNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationsPreferences request
+	GetNotificationsPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutNotificationsPreferencesWithBody request with any body
+	PutNotificationsPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutNotificationsPreferences(ctx context.Context, body PutNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNotificationsReadAll request
+	PostNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNotificationsUnreadCount request
+	GetNotificationsUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNotificationsNotificationIdRead request
+	DeleteNotificationsNotificationIdRead(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostNotificationsNotificationIdRead request
+	PostNotificationsNotificationIdRead(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTemplates request
+	GetTemplates(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTrash request
+	GetTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooks request
+	GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWithBody request with any body
+	PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWebhooksWebhookId request
+	DeleteWebhooksWebhookId(ctx context.Context, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWebhooksWebhookIdDeliveries request
+	GetWebhooksWebhookIdDeliveries(ctx context.Context, webhookId openapi_types.UUID, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver request
+	PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx context.Context, webhookId openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetWishlists request
 	GetWishlists(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -353,41 +1251,111 @@ type ClientInterface interface {
 
 	PostWishlists(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PostWishlistsImportWithBody request with any body
+	PostWishlistsImportWithBody(ctx context.Context, params *PostWishlistsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsImport(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsImportWithTextBody(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeleteWishlistsWishlistId request
-	DeleteWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, params *DeleteWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetWishlistsWishlistId request
-	GetWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PutWishlistsWishlistIdWithBody request with any body
-	PutWishlistsWishlistIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutWishlistsWishlistIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWishlistsWishlistIdActivity request
+	GetWishlistsWishlistIdActivity(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// PostWishlistsWishlistIdCopyWithBody request with any body
+	PostWishlistsWishlistIdCopyWithBody(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsWishlistIdCopy(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWishlistsWishlistIdEvents request
+	GetWishlistsWishlistIdEvents(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWishlistsWishlistIdExport request
+	GetWishlistsWishlistIdExport(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWishlistsWishlistIdHistory request
+	GetWishlistsWishlistIdHistory(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostWishlistsWishlistIdItemsWithBody request with any body
-	PostWishlistsWishlistIdItemsWithBody(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostWishlistsWishlistIdItemsWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsWishlistIdItems(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdItemsCopyWithBody request with any body
+	PostWishlistsWishlistIdItemsCopyWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsWishlistIdItemsCopy(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, body PostWishlistsWishlistIdItemsCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdItemsMoveWithBody request with any body
+	PostWishlistsWishlistIdItemsMoveWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostWishlistsWishlistIdItems(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostWishlistsWishlistIdItemsMove(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, body PostWishlistsWishlistIdItemsMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteWishlistsWishlistIdItemsItemId request
-	DeleteWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PutWishlistsWishlistIdItemsItemIdWithBody request with any body
-	PutWishlistsWishlistIdItemsItemIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutWishlistsWishlistIdItemsItemIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PutWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PutWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// PostWishlistsWishlistIdItemsItemIdBookWithBody request with any body
-	PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsWishlistIdItemsItemIdBook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWishlistsWishlistIdItemsItemIdBooking request
+	GetWishlistsWishlistIdItemsItemIdBooking(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *GetWishlistsWishlistIdItemsItemIdBookingParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWishlistsWishlistIdItemsItemIdBookingCancel request
+	GetWishlistsWishlistIdItemsItemIdBookingCancel(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *GetWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdItemsItemIdBookingCancel request
+	PostWishlistsWishlistIdItemsItemIdBookingCancel(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PostWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteWishlistsWishlistIdItemsItemIdBookingPurchased request
+	DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutWishlistsWishlistIdItemsItemIdBookingPurchased request
+	PutWishlistsWishlistIdItemsItemIdBookingPurchased(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdItemsItemIdRestore request
+	PostWishlistsWishlistIdItemsItemIdRestore(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdItemsItemIdRevertWithBody request with any body
+	PostWishlistsWishlistIdItemsItemIdRevertWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	PostWishlistsWishlistIdItemsItemIdBook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	PostWishlistsWishlistIdItemsItemIdRevert(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, body PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteWishlistsWishlistIdItemsItemIdUnbook request
 	DeleteWishlistsWishlistIdItemsItemIdUnbook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdItemsBatchWithBody request with any body
+	PostWishlistsWishlistIdItemsBatchWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsWishlistIdItemsBatch(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, body PostWishlistsWishlistIdItemsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdRestore request
+	PostWishlistsWishlistIdRestore(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostWishlistsWishlistIdRevertWithBody request with any body
+	PostWishlistsWishlistIdRevertWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostWishlistsWishlistIdRevert(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, body PostWishlistsWishlistIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) GetWishlists(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWishlistsRequest(c.Server)
+func (c *Client) GetNotifications(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -398,8 +1366,8 @@ func (c *Client) GetWishlists(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWishlistsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWishlistsRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetNotificationsEmailPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsEmailPreferencesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -410,8 +1378,8 @@ func (c *Client) PostWishlistsWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWishlists(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWishlistsRequest(c.Server, body)
+func (c *Client) PutNotificationsEmailPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutNotificationsEmailPreferencesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -422,8 +1390,8 @@ func (c *Client) PostWishlists(ctx context.Context, body PostWishlistsJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWishlistsWishlistIdRequest(c.Server, wishlistId)
+func (c *Client) PutNotificationsEmailPreferences(ctx context.Context, body PutNotificationsEmailPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutNotificationsEmailPreferencesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -434,8 +1402,8 @@ func (c *Client) DeleteWishlistsWishlistId(ctx context.Context, wishlistId opena
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetWishlistsWishlistIdRequest(c.Server, wishlistId)
+func (c *Client) GetNotificationsEmailUnsubscribe(ctx context.Context, params *GetNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsEmailUnsubscribeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -446,8 +1414,8 @@ func (c *Client) GetWishlistsWishlistId(ctx context.Context, wishlistId openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutWishlistsWishlistIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutWishlistsWishlistIdRequestWithBody(c.Server, wishlistId, contentType, body)
+func (c *Client) PostNotificationsEmailUnsubscribe(ctx context.Context, params *PostNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNotificationsEmailUnsubscribeRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -458,8 +1426,8 @@ func (c *Client) PutWishlistsWishlistIdWithBody(ctx context.Context, wishlistId
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutWishlistsWishlistIdRequest(c.Server, wishlistId, body)
+func (c *Client) GetNotificationsPreferences(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsPreferencesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -470,8 +1438,8 @@ func (c *Client) PutWishlistsWishlistId(ctx context.Context, wishlistId openapi_
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWishlistsWishlistIdItemsWithBody(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWishlistsWishlistIdItemsRequestWithBody(c.Server, wishlistId, contentType, body)
+func (c *Client) PutNotificationsPreferencesWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutNotificationsPreferencesRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -482,8 +1450,8 @@ func (c *Client) PostWishlistsWishlistIdItemsWithBody(ctx context.Context, wishl
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWishlistsWishlistIdItems(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWishlistsWishlistIdItemsRequest(c.Server, wishlistId, body)
+func (c *Client) PutNotificationsPreferences(ctx context.Context, body PutNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutNotificationsPreferencesRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -494,8 +1462,8 @@ func (c *Client) PostWishlistsWishlistIdItems(ctx context.Context, wishlistId op
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWishlistsWishlistIdItemsItemIdRequest(c.Server, wishlistId, itemId)
+func (c *Client) PostNotificationsReadAll(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNotificationsReadAllRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -506,8 +1474,8 @@ func (c *Client) DeleteWishlistsWishlistIdItemsItemId(ctx context.Context, wishl
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutWishlistsWishlistIdItemsItemIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutWishlistsWishlistIdItemsItemIdRequestWithBody(c.Server, wishlistId, itemId, contentType, body)
+func (c *Client) GetNotificationsUnreadCount(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNotificationsUnreadCountRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -518,8 +1486,8 @@ func (c *Client) PutWishlistsWishlistIdItemsItemIdWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) PutWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPutWishlistsWishlistIdItemsItemIdRequest(c.Server, wishlistId, itemId, body)
+func (c *Client) DeleteNotificationsNotificationIdRead(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNotificationsNotificationIdReadRequest(c.Server, notificationId)
 	if err != nil {
 		return nil, err
 	}
@@ -530,8 +1498,8 @@ func (c *Client) PutWishlistsWishlistIdItemsItemId(ctx context.Context, wishlist
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody(c.Server, wishlistId, itemId, contentType, body)
+func (c *Client) PostNotificationsNotificationIdRead(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostNotificationsNotificationIdReadRequest(c.Server, notificationId)
 	if err != nil {
 		return nil, err
 	}
@@ -542,8 +1510,8 @@ func (c *Client) PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx context.Cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) PostWishlistsWishlistIdItemsItemIdBook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewPostWishlistsWishlistIdItemsItemIdBookRequest(c.Server, wishlistId, itemId, body)
+func (c *Client) GetTemplates(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTemplatesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -554,8 +1522,8 @@ func (c *Client) PostWishlistsWishlistIdItemsItemIdBook(ctx context.Context, wis
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteWishlistsWishlistIdItemsItemIdUnbook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest(c.Server, wishlistId, itemId, params)
+func (c *Client) GetTrash(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTrashRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -566,408 +1534,580 @@ func (c *Client) DeleteWishlistsWishlistIdItemsItemIdUnbook(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-// NewGetWishlistsRequest generates requests for GetWishlists
-func NewGetWishlistsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) GetWebhooks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostWebhooksWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostWishlistsRequest calls the generic PostWishlists builder with application/json body
-func NewPostWishlistsRequest(server string, body PostWishlistsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostWebhooks(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostWishlistsRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostWishlistsRequestWithBody generates requests for PostWishlists with any type of body
-func NewPostWishlistsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteWebhooksWebhookId(ctx context.Context, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWebhooksWebhookIdRequest(c.Server, webhookId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWebhooksWebhookIdDeliveries(ctx context.Context, webhookId openapi_types.UUID, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWebhooksWebhookIdDeliveriesRequest(c.Server, webhookId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteWishlistsWishlistIdRequest generates requests for DeleteWishlistsWishlistId
-func NewDeleteWishlistsWishlistIdRequest(server string, wishlistId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx context.Context, webhookId openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequest(c.Server, webhookId, deliveryId)
 	if err != nil {
 		return nil, err
 	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/wishlists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWishlists(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetWishlistsWishlistIdRequest generates requests for GetWishlistsWishlistId
-func NewGetWishlistsWishlistIdRequest(server string, wishlistId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PostWishlistsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostWishlists(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostWishlistsImportWithBody(ctx context.Context, params *PostWishlistsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsImportRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutWishlistsWishlistIdRequest calls the generic PutWishlistsWishlistId builder with application/json body
-func NewPutWishlistsWishlistIdRequest(server string, wishlistId openapi_types.UUID, body PutWishlistsWishlistIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostWishlistsImport(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsImportRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutWishlistsWishlistIdRequestWithBody(server, wishlistId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutWishlistsWishlistIdRequestWithBody generates requests for PutWishlistsWishlistId with any type of body
-func NewPutWishlistsWishlistIdRequestWithBody(server string, wishlistId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PostWishlistsImportWithTextBody(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportTextRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsImportRequestWithTextBody(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, params *DeleteWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWishlistsWishlistIdRequest(c.Server, wishlistId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdRequest(c.Server, wishlistId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostWishlistsWishlistIdItemsRequest calls the generic PostWishlistsWishlistIdItems builder with application/json body
-func NewPostWishlistsWishlistIdItemsRequest(server string, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdItemsJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PutWishlistsWishlistIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWishlistsWishlistIdRequestWithBody(c.Server, wishlistId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostWishlistsWishlistIdItemsRequestWithBody(server, wishlistId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPostWishlistsWishlistIdItemsRequestWithBody generates requests for PostWishlistsWishlistIdItems with any type of body
-func NewPostWishlistsWishlistIdItemsRequestWithBody(server string, wishlistId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PutWishlistsWishlistId(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWishlistsWishlistIdRequest(c.Server, wishlistId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetWishlistsWishlistIdActivity(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdActivityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdActivityRequest(c.Server, wishlistId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists/%s/items", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostWishlistsWishlistIdCopyWithBody(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdCopyRequestWithBody(c.Server, wishlistId, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteWishlistsWishlistIdItemsItemIdRequest generates requests for DeleteWishlistsWishlistIdItemsItemId
-func NewDeleteWishlistsWishlistIdItemsItemIdRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PostWishlistsWishlistIdCopy(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdCopyRequest(c.Server, wishlistId, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetWishlistsWishlistIdEvents(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdEventsRequest(c.Server, wishlistId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists/%s/items/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWishlistsWishlistIdExport(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdExportParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdExportRequest(c.Server, wishlistId, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPutWishlistsWishlistIdItemsItemIdRequest calls the generic PutWishlistsWishlistIdItemsItemId builder with application/json body
-func NewPutWishlistsWishlistIdItemsItemIdRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) GetWishlistsWishlistIdHistory(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdHistoryRequest(c.Server, wishlistId, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPutWishlistsWishlistIdItemsItemIdRequestWithBody(server, wishlistId, itemId, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewPutWishlistsWishlistIdItemsItemIdRequestWithBody generates requests for PutWishlistsWishlistIdItemsItemId with any type of body
-func NewPutWishlistsWishlistIdItemsItemIdRequestWithBody(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PostWishlistsWishlistIdItemsWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsRequestWithBody(c.Server, wishlistId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostWishlistsWishlistIdItems(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsRequest(c.Server, wishlistId, params, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/wishlists/%s/items/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) PostWishlistsWishlistIdItemsCopyWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsCopyRequestWithBody(c.Server, wishlistId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewPostWishlistsWishlistIdItemsItemIdBookRequest calls the generic PostWishlistsWishlistIdItemsItemIdBook builder with application/json body
-func NewPostWishlistsWishlistIdItemsItemIdBookRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) PostWishlistsWishlistIdItemsCopy(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, body PostWishlistsWishlistIdItemsCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsCopyRequest(c.Server, wishlistId, params, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody(server, wishlistId, itemId, "application/json", bodyReader)
-}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-// NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody generates requests for PostWishlistsWishlistIdItemsItemIdBook with any type of body
-func NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+func (c *Client) PostWishlistsWishlistIdItemsMoveWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsMoveRequestWithBody(c.Server, wishlistId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PostWishlistsWishlistIdItemsMove(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, body PostWishlistsWishlistIdItemsMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsMoveRequest(c.Server, wishlistId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) DeleteWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWishlistsWishlistIdItemsItemIdRequest(c.Server, wishlistId, itemId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) PutWishlistsWishlistIdItemsItemIdWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWishlistsWishlistIdItemsItemIdRequestWithBody(c.Server, wishlistId, itemId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+func (c *Client) PutWishlistsWishlistIdItemsItemId(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWishlistsWishlistIdItemsItemIdRequest(c.Server, wishlistId, itemId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody(c.Server, wishlistId, itemId, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/book", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) PostWishlistsWishlistIdItemsItemIdBook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsItemIdBookRequest(c.Server, wishlistId, itemId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetWishlistsWishlistIdItemsItemIdBooking(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *GetWishlistsWishlistIdItemsItemIdBookingParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdItemsItemIdBookingRequest(c.Server, wishlistId, itemId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) GetWishlistsWishlistIdItemsItemIdBookingCancel(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *GetWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWishlistsWishlistIdItemsItemIdBookingCancelRequest(c.Server, wishlistId, itemId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req.Header.Add("Content-Type", contentType)
+func (c *Client) PostWishlistsWishlistIdItemsItemIdBookingCancel(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PostWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsItemIdBookingCancelRequest(c.Server, wishlistId, itemId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWishlistsWishlistIdItemsItemIdBookingPurchasedRequest(c.Server, wishlistId, itemId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest generates requests for DeleteWishlistsWishlistIdItemsItemIdUnbook
-func NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams) (*http.Request, error) {
-	var err error
+func (c *Client) PutWishlistsWishlistIdItemsItemIdBookingPurchased(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutWishlistsWishlistIdItemsItemIdBookingPurchasedRequest(c.Server, wishlistId, itemId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam0 string
+func (c *Client) PostWishlistsWishlistIdItemsItemIdRestore(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsItemIdRestoreRequest(c.Server, wishlistId, itemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+func (c *Client) PostWishlistsWishlistIdItemsItemIdRevertWithBody(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsItemIdRevertRequestWithBody(c.Server, wishlistId, itemId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWishlistsWishlistIdItemsItemIdRevert(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, body PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsItemIdRevertRequest(c.Server, wishlistId, itemId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	var pathParam1 string
+func (c *Client) DeleteWishlistsWishlistIdItemsItemIdUnbook(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest(c.Server, wishlistId, itemId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+func (c *Client) PostWishlistsWishlistIdItemsBatchWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsBatchRequestWithBody(c.Server, wishlistId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWishlistsWishlistIdItemsBatch(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, body PostWishlistsWishlistIdItemsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdItemsBatchRequest(c.Server, wishlistId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWishlistsWishlistIdRestore(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdRestoreRequest(c.Server, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWishlistsWishlistIdRevertWithBody(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdRevertRequestWithBody(c.Server, wishlistId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostWishlistsWishlistIdRevert(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, body PostWishlistsWishlistIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostWishlistsWishlistIdRevertRequest(c.Server, wishlistId, params, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetNotificationsRequest generates requests for GetNotifications
+func NewGetNotificationsRequest(server string, params *GetNotificationsParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/unbook", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/notifications")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -980,22 +2120,58 @@ func NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest(server string, wishlis
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bookingId", runtime.ParamLocationQuery, params.BookingId); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
+		if params.Unread != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "unread", runtime.ParamLocationQuery, *params.Unread); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
 				}
 			}
+
 		}
 
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1003,489 +2179,4894 @@ func NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest(server string, wishlis
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetNotificationsEmailPreferencesRequest generates requests for GetNotificationsEmailPreferences
+func NewGetNotificationsEmailPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/notifications/email-preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
+
+	return req, nil
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetWishlistsWithResponse request
-	GetWishlistsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWishlistsResponse, error)
+// NewPutNotificationsEmailPreferencesRequest calls the generic PutNotificationsEmailPreferences builder with application/json body
+func NewPutNotificationsEmailPreferencesRequest(server string, body PutNotificationsEmailPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutNotificationsEmailPreferencesRequestWithBody(server, "application/json", bodyReader)
+}
 
-	// PostWishlistsWithBodyWithResponse request with any body
-	PostWishlistsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error)
+// NewPutNotificationsEmailPreferencesRequestWithBody generates requests for PutNotificationsEmailPreferences with any type of body
+func NewPutNotificationsEmailPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	PostWishlistsWithResponse(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// DeleteWishlistsWishlistIdWithResponse request
-	DeleteWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdResponse, error)
+	operationPath := fmt.Sprintf("/notifications/email-preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetWishlistsWishlistIdWithResponse request
-	GetWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutWishlistsWishlistIdWithBodyWithResponse request with any body
-	PutWishlistsWishlistIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	PutWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// PostWishlistsWishlistIdItemsWithBodyWithResponse request with any body
-	PostWishlistsWishlistIdItemsWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error)
+	return req, nil
+}
 
-	PostWishlistsWishlistIdItemsWithResponse(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error)
+// NewGetNotificationsEmailUnsubscribeRequest generates requests for GetNotificationsEmailUnsubscribe
+func NewGetNotificationsEmailUnsubscribeRequest(server string, params *GetNotificationsEmailUnsubscribeParams) (*http.Request, error) {
+	var err error
 
-	// DeleteWishlistsWishlistIdItemsItemIdWithResponse request
-	DeleteWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse request with any body
-	PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error)
+	operationPath := fmt.Sprintf("/notifications/email/unsubscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	PutWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse request with any body
-	PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	PostWishlistsWishlistIdItemsItemIdBookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error)
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
 
-	// DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse request
-	DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdUnbookResponse, error)
-}
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-type GetWishlistsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Wishlist
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetWishlistsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostNotificationsEmailUnsubscribeRequest generates requests for PostNotificationsEmailUnsubscribe
+func NewPostNotificationsEmailUnsubscribeRequest(server string, params *PostNotificationsEmailUnsubscribeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWishlistsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/email/unsubscribe")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostWishlistsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Wishlist
-	JSON400      *ValidationErrorResponse
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r PostWishlistsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostWishlistsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteWishlistsWishlistIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteWishlistsWishlistIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetNotificationsPreferencesRequest generates requests for GetNotificationsPreferences
+func NewGetNotificationsPreferencesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWishlistsWishlistIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type GetWishlistsWishlistIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Wishlist
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetWishlistsWishlistIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetWishlistsWishlistIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPutNotificationsPreferencesRequest calls the generic PutNotificationsPreferences builder with application/json body
+func NewPutNotificationsPreferencesRequest(server string, body PutNotificationsPreferencesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPutNotificationsPreferencesRequestWithBody(server, "application/json", bodyReader)
 }
 
-type PutWishlistsWishlistIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Wishlist
-	JSON400      *ValidationErrorResponse
-}
+// NewPutNotificationsPreferencesRequestWithBody generates requests for PutNotificationsPreferences with any type of body
+func NewPutNotificationsPreferencesRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r PutWishlistsWishlistIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutWishlistsWishlistIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	operationPath := fmt.Sprintf("/notifications/preferences")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return 0
-}
 
-type PostWishlistsWishlistIdItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *WishlistItem
-	JSON400      *ValidationErrorResponse
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostWishlistsWishlistIdItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPostNotificationsReadAllRequest generates requests for PostNotificationsReadAll
+func NewPostNotificationsReadAllRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/read-all")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNotificationsUnreadCountRequest generates requests for GetNotificationsUnreadCount
+func NewGetNotificationsUnreadCountRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/unread-count")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteNotificationsNotificationIdReadRequest generates requests for DeleteNotificationsNotificationIdRead
+func NewDeleteNotificationsNotificationIdReadRequest(server string, notificationId NotificationIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notificationId", runtime.ParamLocationPath, notificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostNotificationsNotificationIdReadRequest generates requests for PostNotificationsNotificationIdRead
+func NewPostNotificationsNotificationIdReadRequest(server string, notificationId NotificationIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "notificationId", runtime.ParamLocationPath, notificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/notifications/%s/read", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTemplatesRequest generates requests for GetTemplates
+func NewGetTemplatesRequest(server string, params *GetTemplatesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/templates")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Language != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "language", runtime.ParamLocationQuery, *params.Language); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetTrashRequest generates requests for GetTrash
+func NewGetTrashRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/trash")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksRequest generates requests for GetWebhooks
+func NewGetWebhooksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksRequest calls the generic PostWebhooks builder with application/json body
+func NewPostWebhooksRequest(server string, body PostWebhooksJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWebhooksRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWebhooksRequestWithBody generates requests for PostWebhooks with any type of body
+func NewPostWebhooksRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWebhooksWebhookIdRequest generates requests for DeleteWebhooksWebhookId
+func NewDeleteWebhooksWebhookIdRequest(server string, webhookId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWebhooksWebhookIdDeliveriesRequest generates requests for GetWebhooksWebhookIdDeliveries
+func NewGetWebhooksWebhookIdDeliveriesRequest(server string, webhookId openapi_types.UUID, params *GetWebhooksWebhookIdDeliveriesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequest generates requests for PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver
+func NewPostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverRequest(server string, webhookId openapi_types.UUID, deliveryId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "webhookId", runtime.ParamLocationPath, webhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "deliveryId", runtime.ParamLocationPath, deliveryId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/webhooks/%s/deliveries/%s/redeliver", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsRequest generates requests for GetWishlists
+func NewGetWishlistsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsRequest calls the generic PostWishlists builder with application/json body
+func NewPostWishlistsRequest(server string, body PostWishlistsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostWishlistsRequestWithBody generates requests for PostWishlists with any type of body
+func NewPostWishlistsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostWishlistsImportRequest calls the generic PostWishlistsImport builder with application/json body
+func NewPostWishlistsImportRequest(server string, params *PostWishlistsImportParams, body PostWishlistsImportJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsImportRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsImportRequestWithTextBody calls the generic PostWishlistsImport builder with text/plain body
+func NewPostWishlistsImportRequestWithTextBody(server string, params *PostWishlistsImportParams, body PostWishlistsImportTextRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyReader = strings.NewReader(string(body))
+	return NewPostWishlistsImportRequestWithBody(server, params, "text/plain", bodyReader)
+}
+
+// NewPostWishlistsImportRequestWithBody generates requests for PostWishlistsImport with any type of body
+func NewPostWishlistsImportRequestWithBody(server string, params *PostWishlistsImportParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Title != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "title", runtime.ParamLocationQuery, *params.Title); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteWishlistsWishlistIdRequest generates requests for DeleteWishlistsWishlistId
+func NewDeleteWishlistsWishlistIdRequest(server string, wishlistId openapi_types.UUID, params *DeleteWishlistsWishlistIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdRequest generates requests for GetWishlistsWishlistId
+func NewGetWishlistsWishlistIdRequest(server string, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutWishlistsWishlistIdRequest calls the generic PutWishlistsWishlistId builder with application/json body
+func NewPutWishlistsWishlistIdRequest(server string, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, body PutWishlistsWishlistIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutWishlistsWishlistIdRequestWithBody(server, wishlistId, params, "application/json", bodyReader)
+}
+
+// NewPutWishlistsWishlistIdRequestWithBody generates requests for PutWishlistsWishlistId with any type of body
+func NewPutWishlistsWishlistIdRequestWithBody(server string, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdActivityRequest generates requests for GetWishlistsWishlistIdActivity
+func NewGetWishlistsWishlistIdActivityRequest(server string, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdActivityParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/activity", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdCopyRequest calls the generic PostWishlistsWishlistIdCopy builder with application/json body
+func NewPostWishlistsWishlistIdCopyRequest(server string, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdCopyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdCopyRequestWithBody(server, wishlistId, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdCopyRequestWithBody generates requests for PostWishlistsWishlistIdCopy with any type of body
+func NewPostWishlistsWishlistIdCopyRequestWithBody(server string, wishlistId openapi_types.UUID, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/copy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdEventsRequest generates requests for GetWishlistsWishlistIdEvents
+func NewGetWishlistsWishlistIdEventsRequest(server string, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdExportRequest generates requests for GetWishlistsWishlistIdExport
+func NewGetWishlistsWishlistIdExportRequest(server string, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdExportParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdHistoryRequest generates requests for GetWishlistsWishlistIdHistory
+func NewGetWishlistsWishlistIdHistoryRequest(server string, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsRequest calls the generic PostWishlistsWishlistIdItems builder with application/json body
+func NewPostWishlistsWishlistIdItemsRequest(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, body PostWishlistsWishlistIdItemsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdItemsRequestWithBody(server, wishlistId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdItemsRequestWithBody generates requests for PostWishlistsWishlistIdItems with any type of body
+func NewPostWishlistsWishlistIdItemsRequestWithBody(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsCopyRequest calls the generic PostWishlistsWishlistIdItemsCopy builder with application/json body
+func NewPostWishlistsWishlistIdItemsCopyRequest(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, body PostWishlistsWishlistIdItemsCopyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdItemsCopyRequestWithBody(server, wishlistId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdItemsCopyRequestWithBody generates requests for PostWishlistsWishlistIdItemsCopy with any type of body
+func NewPostWishlistsWishlistIdItemsCopyRequestWithBody(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/copy", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsMoveRequest calls the generic PostWishlistsWishlistIdItemsMove builder with application/json body
+func NewPostWishlistsWishlistIdItemsMoveRequest(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, body PostWishlistsWishlistIdItemsMoveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdItemsMoveRequestWithBody(server, wishlistId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdItemsMoveRequestWithBody generates requests for PostWishlistsWishlistIdItemsMove with any type of body
+func NewPostWishlistsWishlistIdItemsMoveRequestWithBody(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteWishlistsWishlistIdItemsItemIdRequest generates requests for DeleteWishlistsWishlistIdItemsItemId
+func NewDeleteWishlistsWishlistIdItemsItemIdRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutWishlistsWishlistIdItemsItemIdRequest calls the generic PutWishlistsWishlistIdItemsItemId builder with application/json body
+func NewPutWishlistsWishlistIdItemsItemIdRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutWishlistsWishlistIdItemsItemIdRequestWithBody(server, wishlistId, itemId, params, "application/json", bodyReader)
+}
+
+// NewPutWishlistsWishlistIdItemsItemIdRequestWithBody generates requests for PutWishlistsWishlistIdItemsItemId with any type of body
+func NewPutWishlistsWishlistIdItemsItemIdRequestWithBody(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsItemIdBookRequest calls the generic PostWishlistsWishlistIdItemsItemIdBook builder with application/json body
+func NewPostWishlistsWishlistIdItemsItemIdBookRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody(server, wishlistId, itemId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody generates requests for PostWishlistsWishlistIdItemsItemIdBook with any type of body
+func NewPostWishlistsWishlistIdItemsItemIdBookRequestWithBody(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/book", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdItemsItemIdBookingRequest generates requests for GetWishlistsWishlistIdItemsItemIdBooking
+func NewGetWishlistsWishlistIdItemsItemIdBookingRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *GetWishlistsWishlistIdItemsItemIdBookingParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/booking", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cancellationToken", runtime.ParamLocationQuery, params.CancellationToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWishlistsWishlistIdItemsItemIdBookingCancelRequest generates requests for GetWishlistsWishlistIdItemsItemIdBookingCancel
+func NewGetWishlistsWishlistIdItemsItemIdBookingCancelRequest(server string, wishlistId WishlistIdPath, itemId ItemIdPath, params *GetWishlistsWishlistIdItemsItemIdBookingCancelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/booking/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cancellationToken", runtime.ParamLocationQuery, params.CancellationToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsItemIdBookingCancelRequest generates requests for PostWishlistsWishlistIdItemsItemIdBookingCancel
+func NewPostWishlistsWishlistIdItemsItemIdBookingCancelRequest(server string, wishlistId WishlistIdPath, itemId ItemIdPath, params *PostWishlistsWishlistIdItemsItemIdBookingCancelParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/booking/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cancellationToken", runtime.ParamLocationQuery, params.CancellationToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteWishlistsWishlistIdItemsItemIdBookingPurchasedRequest generates requests for DeleteWishlistsWishlistIdItemsItemIdBookingPurchased
+func NewDeleteWishlistsWishlistIdItemsItemIdBookingPurchasedRequest(server string, wishlistId WishlistIdPath, itemId ItemIdPath, params *DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/booking/purchased", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cancellationToken", runtime.ParamLocationQuery, params.CancellationToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutWishlistsWishlistIdItemsItemIdBookingPurchasedRequest generates requests for PutWishlistsWishlistIdItemsItemIdBookingPurchased
+func NewPutWishlistsWishlistIdItemsItemIdBookingPurchasedRequest(server string, wishlistId WishlistIdPath, itemId ItemIdPath, params *PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/booking/purchased", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cancellationToken", runtime.ParamLocationQuery, params.CancellationToken); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsItemIdRestoreRequest generates requests for PostWishlistsWishlistIdItemsItemIdRestore
+func NewPostWishlistsWishlistIdItemsItemIdRestoreRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/restore", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsItemIdRevertRequest calls the generic PostWishlistsWishlistIdItemsItemIdRevert builder with application/json body
+func NewPostWishlistsWishlistIdItemsItemIdRevertRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, body PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdItemsItemIdRevertRequestWithBody(server, wishlistId, itemId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdItemsItemIdRevertRequestWithBody generates requests for PostWishlistsWishlistIdItemsItemIdRevert with any type of body
+func NewPostWishlistsWishlistIdItemsItemIdRevertRequestWithBody(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/revert", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest generates requests for DeleteWishlistsWishlistIdItemsItemIdUnbook
+func NewDeleteWishlistsWishlistIdItemsItemIdUnbookRequest(server string, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "itemId", runtime.ParamLocationPath, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items/%s/unbook", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.BookingId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bookingId", runtime.ParamLocationQuery, *params.BookingId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CancellationToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cancellationToken", runtime.ParamLocationQuery, *params.CancellationToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdItemsBatchRequest calls the generic PostWishlistsWishlistIdItemsBatch builder with application/json body
+func NewPostWishlistsWishlistIdItemsBatchRequest(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, body PostWishlistsWishlistIdItemsBatchJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdItemsBatchRequestWithBody(server, wishlistId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdItemsBatchRequestWithBody generates requests for PostWishlistsWishlistIdItemsBatch with any type of body
+func NewPostWishlistsWishlistIdItemsBatchRequestWithBody(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/items:batch", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdRestoreRequest generates requests for PostWishlistsWishlistIdRestore
+func NewPostWishlistsWishlistIdRestoreRequest(server string, wishlistId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostWishlistsWishlistIdRevertRequest calls the generic PostWishlistsWishlistIdRevert builder with application/json body
+func NewPostWishlistsWishlistIdRevertRequest(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, body PostWishlistsWishlistIdRevertJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostWishlistsWishlistIdRevertRequestWithBody(server, wishlistId, params, "application/json", bodyReader)
+}
+
+// NewPostWishlistsWishlistIdRevertRequestWithBody generates requests for PostWishlistsWishlistIdRevert with any type of body
+func NewPostWishlistsWishlistIdRevertRequestWithBody(server string, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "wishlistId", runtime.ParamLocationPath, wishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/wishlists/%s/revert", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetNotificationsWithResponse request
+	GetNotificationsWithResponse(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*GetNotificationsResponse, error)
+
+	// GetNotificationsEmailPreferencesWithResponse request
+	GetNotificationsEmailPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNotificationsEmailPreferencesResponse, error)
+
+	// PutNotificationsEmailPreferencesWithBodyWithResponse request with any body
+	PutNotificationsEmailPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNotificationsEmailPreferencesResponse, error)
+
+	PutNotificationsEmailPreferencesWithResponse(ctx context.Context, body PutNotificationsEmailPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutNotificationsEmailPreferencesResponse, error)
+
+	// GetNotificationsEmailUnsubscribeWithResponse request
+	GetNotificationsEmailUnsubscribeWithResponse(ctx context.Context, params *GetNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*GetNotificationsEmailUnsubscribeResponse, error)
+
+	// PostNotificationsEmailUnsubscribeWithResponse request
+	PostNotificationsEmailUnsubscribeWithResponse(ctx context.Context, params *PostNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*PostNotificationsEmailUnsubscribeResponse, error)
+
+	// GetNotificationsPreferencesWithResponse request
+	GetNotificationsPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNotificationsPreferencesResponse, error)
+
+	// PutNotificationsPreferencesWithBodyWithResponse request with any body
+	PutNotificationsPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNotificationsPreferencesResponse, error)
+
+	PutNotificationsPreferencesWithResponse(ctx context.Context, body PutNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutNotificationsPreferencesResponse, error)
+
+	// PostNotificationsReadAllWithResponse request
+	PostNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostNotificationsReadAllResponse, error)
+
+	// GetNotificationsUnreadCountWithResponse request
+	GetNotificationsUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNotificationsUnreadCountResponse, error)
+
+	// DeleteNotificationsNotificationIdReadWithResponse request
+	DeleteNotificationsNotificationIdReadWithResponse(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*DeleteNotificationsNotificationIdReadResponse, error)
+
+	// PostNotificationsNotificationIdReadWithResponse request
+	PostNotificationsNotificationIdReadWithResponse(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*PostNotificationsNotificationIdReadResponse, error)
+
+	// GetTemplatesWithResponse request
+	GetTemplatesWithResponse(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*GetTemplatesResponse, error)
+
+	// GetTrashWithResponse request
+	GetTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrashResponse, error)
+
+	// GetWebhooksWithResponse request
+	GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error)
+
+	// PostWebhooksWithBodyWithResponse request with any body
+	PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error)
+
+	// DeleteWebhooksWebhookIdWithResponse request
+	DeleteWebhooksWebhookIdWithResponse(ctx context.Context, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIdResponse, error)
+
+	// GetWebhooksWebhookIdDeliveriesWithResponse request
+	GetWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, webhookId openapi_types.UUID, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksWebhookIdDeliveriesResponse, error)
+
+	// PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverWithResponse request
+	PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverWithResponse(ctx context.Context, webhookId openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse, error)
+
+	// GetWishlistsWithResponse request
+	GetWishlistsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWishlistsResponse, error)
+
+	// PostWishlistsWithBodyWithResponse request with any body
+	PostWishlistsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error)
+
+	PostWishlistsWithResponse(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error)
+
+	// PostWishlistsImportWithBodyWithResponse request with any body
+	PostWishlistsImportWithBodyWithResponse(ctx context.Context, params *PostWishlistsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsImportResponse, error)
+
+	PostWishlistsImportWithResponse(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsImportResponse, error)
+
+	PostWishlistsImportWithTextBodyWithResponse(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportTextRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsImportResponse, error)
+
+	// DeleteWishlistsWishlistIdWithResponse request
+	DeleteWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *DeleteWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdResponse, error)
+
+	// GetWishlistsWishlistIdWithResponse request
+	GetWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdResponse, error)
+
+	// PutWishlistsWishlistIdWithBodyWithResponse request with any body
+	PutWishlistsWishlistIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error)
+
+	PutWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error)
+
+	// GetWishlistsWishlistIdActivityWithResponse request
+	GetWishlistsWishlistIdActivityWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdActivityParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdActivityResponse, error)
+
+	// PostWishlistsWishlistIdCopyWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdCopyWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdCopyResponse, error)
+
+	PostWishlistsWishlistIdCopyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdCopyResponse, error)
+
+	// GetWishlistsWishlistIdEventsWithResponse request
+	GetWishlistsWishlistIdEventsWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdEventsParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdEventsResponse, error)
+
+	// GetWishlistsWishlistIdExportWithResponse request
+	GetWishlistsWishlistIdExportWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdExportParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdExportResponse, error)
+
+	// GetWishlistsWishlistIdHistoryWithResponse request
+	GetWishlistsWishlistIdHistoryWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdHistoryParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdHistoryResponse, error)
+
+	// PostWishlistsWishlistIdItemsWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdItemsWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error)
+
+	PostWishlistsWishlistIdItemsWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error)
+
+	// PostWishlistsWishlistIdItemsCopyWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdItemsCopyWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsCopyResponse, error)
+
+	PostWishlistsWishlistIdItemsCopyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, body PostWishlistsWishlistIdItemsCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsCopyResponse, error)
+
+	// PostWishlistsWishlistIdItemsMoveWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdItemsMoveWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsMoveResponse, error)
+
+	PostWishlistsWishlistIdItemsMoveWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, body PostWishlistsWishlistIdItemsMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsMoveResponse, error)
+
+	// DeleteWishlistsWishlistIdItemsItemIdWithResponse request
+	DeleteWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdResponse, error)
+
+	// PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse request with any body
+	PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error)
+
+	PutWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error)
+
+	// PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error)
+
+	PostWishlistsWishlistIdItemsItemIdBookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error)
+
+	// GetWishlistsWishlistIdItemsItemIdBookingWithResponse request
+	GetWishlistsWishlistIdItemsItemIdBookingWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *GetWishlistsWishlistIdItemsItemIdBookingParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdItemsItemIdBookingResponse, error)
+
+	// GetWishlistsWishlistIdItemsItemIdBookingCancelWithResponse request
+	GetWishlistsWishlistIdItemsItemIdBookingCancelWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *GetWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdItemsItemIdBookingCancelResponse, error)
+
+	// PostWishlistsWishlistIdItemsItemIdBookingCancelWithResponse request
+	PostWishlistsWishlistIdItemsItemIdBookingCancelWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PostWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookingCancelResponse, error)
+
+	// DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse request
+	DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse, error)
+
+	// PutWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse request
+	PutWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse, error)
+
+	// PostWishlistsWishlistIdItemsItemIdRestoreWithResponse request
+	PostWishlistsWishlistIdItemsItemIdRestoreWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdRestoreResponse, error)
+
+	// PostWishlistsWishlistIdItemsItemIdRevertWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdItemsItemIdRevertWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdRevertResponse, error)
+
+	PostWishlistsWishlistIdItemsItemIdRevertWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, body PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdRevertResponse, error)
+
+	// DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse request
+	DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdUnbookResponse, error)
+
+	// PostWishlistsWishlistIdItemsBatchWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdItemsBatchWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsBatchResponse, error)
+
+	PostWishlistsWishlistIdItemsBatchWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, body PostWishlistsWishlistIdItemsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsBatchResponse, error)
+
+	// PostWishlistsWishlistIdRestoreWithResponse request
+	PostWishlistsWishlistIdRestoreWithResponse(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdRestoreResponse, error)
+
+	// PostWishlistsWishlistIdRevertWithBodyWithResponse request with any body
+	PostWishlistsWishlistIdRevertWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdRevertResponse, error)
+
+	PostWishlistsWishlistIdRevertWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, body PostWishlistsWishlistIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdRevertResponse, error)
+}
+
+type GetNotificationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *NotificationFeed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationsEmailPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EmailPreferences
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsEmailPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsEmailPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutNotificationsEmailPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *EmailPreferences
+}
+
+// Status returns HTTPResponse.Status
+func (r PutNotificationsEmailPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutNotificationsEmailPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationsEmailUnsubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsEmailUnsubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsEmailUnsubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNotificationsEmailUnsubscribeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNotificationsEmailUnsubscribeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNotificationsEmailUnsubscribeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationsPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboxPreferences
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutNotificationsPreferencesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *InboxPreferences
+}
+
+// Status returns HTTPResponse.Status
+func (r PutNotificationsPreferencesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutNotificationsPreferencesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNotificationsReadAllResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MarkNotificationsReadResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNotificationsReadAllResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNotificationsReadAllResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNotificationsUnreadCountResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UnreadNotificationCount
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNotificationsUnreadCountResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNotificationsUnreadCountResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNotificationsNotificationIdReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNotificationsNotificationIdReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNotificationsNotificationIdReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostNotificationsNotificationIdReadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostNotificationsNotificationIdReadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostNotificationsNotificationIdReadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTemplatesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TemplatesResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTemplatesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTemplatesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTrashResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TrashResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTrashResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTrashResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Webhook
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWebhooksWebhookIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWebhooksWebhookIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWebhooksWebhookIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWebhooksWebhookIdDeliveriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WebhookDeliveries
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWebhooksWebhookIdDeliveriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWebhooksWebhookIdDeliveriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *WebhookDelivery
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Wishlist
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Wishlist
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsImportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ImportResult
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsImportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsImportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWishlistsWishlistIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWishlistsWishlistIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWishlistsWishlistIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Wishlist
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutWishlistsWishlistIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Wishlist
+	JSON400      *ValidationErrorResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PutWishlistsWishlistIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutWishlistsWishlistIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdActivityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ActivityFeed
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdActivityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdActivityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdCopyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Wishlist
+	JSON400      *ValidationErrorResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdCopyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdCopyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdExportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PortableWishlist
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdExportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdExportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WishlistHistory
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *WishlistItem
+	JSON400      *ValidationErrorResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsCopyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferItemsResponse
+	JSON400      *ValidationErrorResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsCopyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsCopyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsMoveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TransferItemsResponse
+	JSON400      *ValidationErrorResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsMoveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsMoveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWishlistsWishlistIdItemsItemIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWishlistsWishlistIdItemsItemIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWishlistsWishlistIdItemsItemIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutWishlistsWishlistIdItemsItemIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WishlistItem
+	JSON400      *ValidationErrorResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PutWishlistsWishlistIdItemsItemIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutWishlistsWishlistIdItemsItemIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsItemIdBookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookItemResponse
+	JSON400      *ValidationErrorResponse
+	JSON409      *ConflictErrorResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsItemIdBookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsItemIdBookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdItemsItemIdBookingResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BookingStatus
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdItemsItemIdBookingResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdItemsItemIdBookingResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWishlistsWishlistIdItemsItemIdBookingCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWishlistsWishlistIdItemsItemIdBookingCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWishlistsWishlistIdItemsItemIdBookingCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsItemIdBookingCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsItemIdBookingCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsItemIdBookingCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsItemIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WishlistItem
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsItemIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsItemIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsItemIdRevertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WishlistItem
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsItemIdRevertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsItemIdRevertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteWishlistsWishlistIdItemsItemIdUnbookResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteWishlistsWishlistIdItemsItemIdUnbookResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteWishlistsWishlistIdItemsItemIdUnbookResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdItemsBatchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BatchItemsResponse
+	JSON400      *ValidationErrorResponse
+	JSON409      *BatchItemsResponse
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdItemsBatchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdItemsBatchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdRestoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Wishlist
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdRestoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdRestoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostWishlistsWishlistIdRevertResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Wishlist
+	JSON412      *PreconditionFailed
+}
+
+// Status returns HTTPResponse.Status
+func (r PostWishlistsWishlistIdRevertResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostWishlistsWishlistIdRevertResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetNotificationsWithResponse request returning *GetNotificationsResponse
+func (c *ClientWithResponses) GetNotificationsWithResponse(ctx context.Context, params *GetNotificationsParams, reqEditors ...RequestEditorFn) (*GetNotificationsResponse, error) {
+	rsp, err := c.GetNotifications(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsResponse(rsp)
+}
+
+// GetNotificationsEmailPreferencesWithResponse request returning *GetNotificationsEmailPreferencesResponse
+func (c *ClientWithResponses) GetNotificationsEmailPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNotificationsEmailPreferencesResponse, error) {
+	rsp, err := c.GetNotificationsEmailPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsEmailPreferencesResponse(rsp)
+}
+
+// PutNotificationsEmailPreferencesWithBodyWithResponse request with arbitrary body returning *PutNotificationsEmailPreferencesResponse
+func (c *ClientWithResponses) PutNotificationsEmailPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNotificationsEmailPreferencesResponse, error) {
+	rsp, err := c.PutNotificationsEmailPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNotificationsEmailPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutNotificationsEmailPreferencesWithResponse(ctx context.Context, body PutNotificationsEmailPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutNotificationsEmailPreferencesResponse, error) {
+	rsp, err := c.PutNotificationsEmailPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNotificationsEmailPreferencesResponse(rsp)
+}
+
+// GetNotificationsEmailUnsubscribeWithResponse request returning *GetNotificationsEmailUnsubscribeResponse
+func (c *ClientWithResponses) GetNotificationsEmailUnsubscribeWithResponse(ctx context.Context, params *GetNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*GetNotificationsEmailUnsubscribeResponse, error) {
+	rsp, err := c.GetNotificationsEmailUnsubscribe(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsEmailUnsubscribeResponse(rsp)
+}
+
+// PostNotificationsEmailUnsubscribeWithResponse request returning *PostNotificationsEmailUnsubscribeResponse
+func (c *ClientWithResponses) PostNotificationsEmailUnsubscribeWithResponse(ctx context.Context, params *PostNotificationsEmailUnsubscribeParams, reqEditors ...RequestEditorFn) (*PostNotificationsEmailUnsubscribeResponse, error) {
+	rsp, err := c.PostNotificationsEmailUnsubscribe(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNotificationsEmailUnsubscribeResponse(rsp)
+}
+
+// GetNotificationsPreferencesWithResponse request returning *GetNotificationsPreferencesResponse
+func (c *ClientWithResponses) GetNotificationsPreferencesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNotificationsPreferencesResponse, error) {
+	rsp, err := c.GetNotificationsPreferences(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsPreferencesResponse(rsp)
+}
+
+// PutNotificationsPreferencesWithBodyWithResponse request with arbitrary body returning *PutNotificationsPreferencesResponse
+func (c *ClientWithResponses) PutNotificationsPreferencesWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutNotificationsPreferencesResponse, error) {
+	rsp, err := c.PutNotificationsPreferencesWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNotificationsPreferencesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutNotificationsPreferencesWithResponse(ctx context.Context, body PutNotificationsPreferencesJSONRequestBody, reqEditors ...RequestEditorFn) (*PutNotificationsPreferencesResponse, error) {
+	rsp, err := c.PutNotificationsPreferences(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutNotificationsPreferencesResponse(rsp)
+}
+
+// PostNotificationsReadAllWithResponse request returning *PostNotificationsReadAllResponse
+func (c *ClientWithResponses) PostNotificationsReadAllWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostNotificationsReadAllResponse, error) {
+	rsp, err := c.PostNotificationsReadAll(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNotificationsReadAllResponse(rsp)
+}
+
+// GetNotificationsUnreadCountWithResponse request returning *GetNotificationsUnreadCountResponse
+func (c *ClientWithResponses) GetNotificationsUnreadCountWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNotificationsUnreadCountResponse, error) {
+	rsp, err := c.GetNotificationsUnreadCount(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNotificationsUnreadCountResponse(rsp)
+}
+
+// DeleteNotificationsNotificationIdReadWithResponse request returning *DeleteNotificationsNotificationIdReadResponse
+func (c *ClientWithResponses) DeleteNotificationsNotificationIdReadWithResponse(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*DeleteNotificationsNotificationIdReadResponse, error) {
+	rsp, err := c.DeleteNotificationsNotificationIdRead(ctx, notificationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNotificationsNotificationIdReadResponse(rsp)
+}
+
+// PostNotificationsNotificationIdReadWithResponse request returning *PostNotificationsNotificationIdReadResponse
+func (c *ClientWithResponses) PostNotificationsNotificationIdReadWithResponse(ctx context.Context, notificationId NotificationIdPath, reqEditors ...RequestEditorFn) (*PostNotificationsNotificationIdReadResponse, error) {
+	rsp, err := c.PostNotificationsNotificationIdRead(ctx, notificationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostNotificationsNotificationIdReadResponse(rsp)
+}
+
+// GetTemplatesWithResponse request returning *GetTemplatesResponse
+func (c *ClientWithResponses) GetTemplatesWithResponse(ctx context.Context, params *GetTemplatesParams, reqEditors ...RequestEditorFn) (*GetTemplatesResponse, error) {
+	rsp, err := c.GetTemplates(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTemplatesResponse(rsp)
+}
+
+// GetTrashWithResponse request returning *GetTrashResponse
+func (c *ClientWithResponses) GetTrashWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetTrashResponse, error) {
+	rsp, err := c.GetTrash(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTrashResponse(rsp)
+}
+
+// GetWebhooksWithResponse request returning *GetWebhooksResponse
+func (c *ClientWithResponses) GetWebhooksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWebhooksResponse, error) {
+	rsp, err := c.GetWebhooks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksResponse(rsp)
+}
+
+// PostWebhooksWithBodyWithResponse request with arbitrary body returning *PostWebhooksResponse
+func (c *ClientWithResponses) PostWebhooksWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooksWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWebhooksWithResponse(ctx context.Context, body PostWebhooksJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWebhooksResponse, error) {
+	rsp, err := c.PostWebhooks(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksResponse(rsp)
+}
+
+// DeleteWebhooksWebhookIdWithResponse request returning *DeleteWebhooksWebhookIdResponse
+func (c *ClientWithResponses) DeleteWebhooksWebhookIdWithResponse(ctx context.Context, webhookId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWebhooksWebhookIdResponse, error) {
+	rsp, err := c.DeleteWebhooksWebhookId(ctx, webhookId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWebhooksWebhookIdResponse(rsp)
+}
+
+// GetWebhooksWebhookIdDeliveriesWithResponse request returning *GetWebhooksWebhookIdDeliveriesResponse
+func (c *ClientWithResponses) GetWebhooksWebhookIdDeliveriesWithResponse(ctx context.Context, webhookId openapi_types.UUID, params *GetWebhooksWebhookIdDeliveriesParams, reqEditors ...RequestEditorFn) (*GetWebhooksWebhookIdDeliveriesResponse, error) {
+	rsp, err := c.GetWebhooksWebhookIdDeliveries(ctx, webhookId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWebhooksWebhookIdDeliveriesResponse(rsp)
+}
+
+// PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverWithResponse request returning *PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse
+func (c *ClientWithResponses) PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverWithResponse(ctx context.Context, webhookId openapi_types.UUID, deliveryId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse, error) {
+	rsp, err := c.PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(ctx, webhookId, deliveryId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(rsp)
+}
+
+// GetWishlistsWithResponse request returning *GetWishlistsResponse
+func (c *ClientWithResponses) GetWishlistsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWishlistsResponse, error) {
+	rsp, err := c.GetWishlists(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsResponse(rsp)
+}
+
+// PostWishlistsWithBodyWithResponse request with arbitrary body returning *PostWishlistsResponse
+func (c *ClientWithResponses) PostWishlistsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error) {
+	rsp, err := c.PostWishlistsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWithResponse(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error) {
+	rsp, err := c.PostWishlists(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsResponse(rsp)
+}
+
+// PostWishlistsImportWithBodyWithResponse request with arbitrary body returning *PostWishlistsImportResponse
+func (c *ClientWithResponses) PostWishlistsImportWithBodyWithResponse(ctx context.Context, params *PostWishlistsImportParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsImportResponse, error) {
+	rsp, err := c.PostWishlistsImportWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsImportWithResponse(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsImportResponse, error) {
+	rsp, err := c.PostWishlistsImport(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsImportResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsImportWithTextBodyWithResponse(ctx context.Context, params *PostWishlistsImportParams, body PostWishlistsImportTextRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsImportResponse, error) {
+	rsp, err := c.PostWishlistsImportWithTextBody(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsImportResponse(rsp)
+}
+
+// DeleteWishlistsWishlistIdWithResponse request returning *DeleteWishlistsWishlistIdResponse
+func (c *ClientWithResponses) DeleteWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *DeleteWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdResponse, error) {
+	rsp, err := c.DeleteWishlistsWishlistId(ctx, wishlistId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWishlistsWishlistIdResponse(rsp)
+}
+
+// GetWishlistsWishlistIdWithResponse request returning *GetWishlistsWishlistIdResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdResponse, error) {
+	rsp, err := c.GetWishlistsWishlistId(ctx, wishlistId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdResponse(rsp)
+}
+
+// PutWishlistsWishlistIdWithBodyWithResponse request with arbitrary body returning *PutWishlistsWishlistIdResponse
+func (c *ClientWithResponses) PutWishlistsWishlistIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error) {
+	rsp, err := c.PutWishlistsWishlistIdWithBody(ctx, wishlistId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWishlistsWishlistIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PutWishlistsWishlistIdParams, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error) {
+	rsp, err := c.PutWishlistsWishlistId(ctx, wishlistId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWishlistsWishlistIdResponse(rsp)
+}
+
+// GetWishlistsWishlistIdActivityWithResponse request returning *GetWishlistsWishlistIdActivityResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdActivityWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdActivityParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdActivityResponse, error) {
+	rsp, err := c.GetWishlistsWishlistIdActivity(ctx, wishlistId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdActivityResponse(rsp)
+}
+
+// PostWishlistsWishlistIdCopyWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdCopyResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdCopyWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdCopyResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdCopyWithBody(ctx, wishlistId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdCopyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdCopyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdCopyResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdCopy(ctx, wishlistId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdCopyResponse(rsp)
+}
+
+// GetWishlistsWishlistIdEventsWithResponse request returning *GetWishlistsWishlistIdEventsResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdEventsWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdEventsParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdEventsResponse, error) {
+	rsp, err := c.GetWishlistsWishlistIdEvents(ctx, wishlistId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdEventsResponse(rsp)
+}
+
+// GetWishlistsWishlistIdExportWithResponse request returning *GetWishlistsWishlistIdExportResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdExportWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdExportParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdExportResponse, error) {
+	rsp, err := c.GetWishlistsWishlistIdExport(ctx, wishlistId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdExportResponse(rsp)
+}
+
+// GetWishlistsWishlistIdHistoryWithResponse request returning *GetWishlistsWishlistIdHistoryResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdHistoryWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *GetWishlistsWishlistIdHistoryParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdHistoryResponse, error) {
+	rsp, err := c.GetWishlistsWishlistIdHistory(ctx, wishlistId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdHistoryResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsWithBody(ctx, wishlistId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsParams, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItems(ctx, wishlistId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsCopyWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsCopyResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsCopyWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsCopyResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsCopyWithBody(ctx, wishlistId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsCopyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsCopyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsCopyParams, body PostWishlistsWishlistIdItemsCopyJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsCopyResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsCopy(ctx, wishlistId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsCopyResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsMoveWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsMoveResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsMoveWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsMoveResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsMoveWithBody(ctx, wishlistId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsMoveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsMoveWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsMoveParams, body PostWishlistsWishlistIdItemsMoveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsMoveResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsMove(ctx, wishlistId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsMoveResponse(rsp)
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdWithResponse request returning *DeleteWishlistsWishlistIdItemsItemIdResponse
+func (c *ClientWithResponses) DeleteWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdResponse, error) {
+	rsp, err := c.DeleteWishlistsWishlistIdItemsItemId(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWishlistsWishlistIdItemsItemIdResponse(rsp)
+}
+
+// PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse request with arbitrary body returning *PutWishlistsWishlistIdItemsItemIdResponse
+func (c *ClientWithResponses) PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error) {
+	rsp, err := c.PutWishlistsWishlistIdItemsItemIdWithBody(ctx, wishlistId, itemId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PutWishlistsWishlistIdItemsItemIdParams, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error) {
+	rsp, err := c.PutWishlistsWishlistIdItemsItemId(ctx, wishlistId, itemId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsItemIdBookResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx, wishlistId, itemId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdBookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdBookParams, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsItemIdBook(ctx, wishlistId, itemId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp)
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingWithResponse request returning *GetWishlistsWishlistIdItemsItemIdBookingResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdItemsItemIdBookingWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *GetWishlistsWishlistIdItemsItemIdBookingParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdItemsItemIdBookingResponse, error) {
+	rsp, err := c.GetWishlistsWishlistIdItemsItemIdBooking(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdItemsItemIdBookingResponse(rsp)
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingCancelWithResponse request returning *GetWishlistsWishlistIdItemsItemIdBookingCancelResponse
+func (c *ClientWithResponses) GetWishlistsWishlistIdItemsItemIdBookingCancelWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *GetWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdItemsItemIdBookingCancelResponse, error) {
+	rsp, err := c.GetWishlistsWishlistIdItemsItemIdBookingCancel(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWishlistsWishlistIdItemsItemIdBookingCancelResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookingCancelWithResponse request returning *PostWishlistsWishlistIdItemsItemIdBookingCancelResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdBookingCancelWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PostWishlistsWishlistIdItemsItemIdBookingCancelParams, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookingCancelResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsItemIdBookingCancel(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsItemIdBookingCancelResponse(rsp)
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse request returning *DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse
+func (c *ClientWithResponses) DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse, error) {
+	rsp, err := c.DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse(rsp)
+}
+
+// PutWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse request returning *PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse
+func (c *ClientWithResponses) PutWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse(ctx context.Context, wishlistId WishlistIdPath, itemId ItemIdPath, params *PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse, error) {
+	rsp, err := c.PutWishlistsWishlistIdItemsItemIdBookingPurchased(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsItemIdRestoreWithResponse request returning *PostWishlistsWishlistIdItemsItemIdRestoreResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdRestoreWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdRestoreResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsItemIdRestore(ctx, wishlistId, itemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsItemIdRestoreResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsItemIdRevertWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsItemIdRevertResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdRevertWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdRevertResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsItemIdRevertWithBody(ctx, wishlistId, itemId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsItemIdRevertResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdRevertWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *PostWishlistsWishlistIdItemsItemIdRevertParams, body PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdRevertResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsItemIdRevert(ctx, wishlistId, itemId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsItemIdRevertResponse(rsp)
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse request returning *DeleteWishlistsWishlistIdItemsItemIdUnbookResponse
+func (c *ClientWithResponses) DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdUnbookResponse, error) {
+	rsp, err := c.DeleteWishlistsWishlistIdItemsItemIdUnbook(ctx, wishlistId, itemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteWishlistsWishlistIdItemsItemIdUnbookResponse(rsp)
+}
+
+// PostWishlistsWishlistIdItemsBatchWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsBatchResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsBatchWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsBatchResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsBatchWithBody(ctx, wishlistId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsBatchResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdItemsBatchWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdItemsBatchParams, body PostWishlistsWishlistIdItemsBatchJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsBatchResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdItemsBatch(ctx, wishlistId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdItemsBatchResponse(rsp)
+}
+
+// PostWishlistsWishlistIdRestoreWithResponse request returning *PostWishlistsWishlistIdRestoreResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdRestoreWithResponse(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdRestoreResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdRestore(ctx, wishlistId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdRestoreResponse(rsp)
+}
+
+// PostWishlistsWishlistIdRevertWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdRevertResponse
+func (c *ClientWithResponses) PostWishlistsWishlistIdRevertWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdRevertResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdRevertWithBody(ctx, wishlistId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdRevertResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostWishlistsWishlistIdRevertWithResponse(ctx context.Context, wishlistId openapi_types.UUID, params *PostWishlistsWishlistIdRevertParams, body PostWishlistsWishlistIdRevertJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdRevertResponse, error) {
+	rsp, err := c.PostWishlistsWishlistIdRevert(ctx, wishlistId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostWishlistsWishlistIdRevertResponse(rsp)
+}
+
+// ParseGetNotificationsResponse parses an HTTP response from a GetNotificationsWithResponse call
+func ParseGetNotificationsResponse(rsp *http.Response) (*GetNotificationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest NotificationFeed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationsEmailPreferencesResponse parses an HTTP response from a GetNotificationsEmailPreferencesWithResponse call
+func ParseGetNotificationsEmailPreferencesResponse(rsp *http.Response) (*GetNotificationsEmailPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsEmailPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EmailPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutNotificationsEmailPreferencesResponse parses an HTTP response from a PutNotificationsEmailPreferencesWithResponse call
+func ParsePutNotificationsEmailPreferencesResponse(rsp *http.Response) (*PutNotificationsEmailPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutNotificationsEmailPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest EmailPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationsEmailUnsubscribeResponse parses an HTTP response from a GetNotificationsEmailUnsubscribeWithResponse call
+func ParseGetNotificationsEmailUnsubscribeResponse(rsp *http.Response) (*GetNotificationsEmailUnsubscribeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsEmailUnsubscribeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostNotificationsEmailUnsubscribeResponse parses an HTTP response from a PostNotificationsEmailUnsubscribeWithResponse call
+func ParsePostNotificationsEmailUnsubscribeResponse(rsp *http.Response) (*PostNotificationsEmailUnsubscribeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostNotificationsEmailUnsubscribeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationsPreferencesResponse parses an HTTP response from a GetNotificationsPreferencesWithResponse call
+func ParseGetNotificationsPreferencesResponse(rsp *http.Response) (*GetNotificationsPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InboxPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutNotificationsPreferencesResponse parses an HTTP response from a PutNotificationsPreferencesWithResponse call
+func ParsePutNotificationsPreferencesResponse(rsp *http.Response) (*PutNotificationsPreferencesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutNotificationsPreferencesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest InboxPreferences
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostNotificationsReadAllResponse parses an HTTP response from a PostNotificationsReadAllWithResponse call
+func ParsePostNotificationsReadAllResponse(rsp *http.Response) (*PostNotificationsReadAllResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostNotificationsReadAllResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MarkNotificationsReadResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetNotificationsUnreadCountResponse parses an HTTP response from a GetNotificationsUnreadCountWithResponse call
+func ParseGetNotificationsUnreadCountResponse(rsp *http.Response) (*GetNotificationsUnreadCountResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNotificationsUnreadCountResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UnreadNotificationCount
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteNotificationsNotificationIdReadResponse parses an HTTP response from a DeleteNotificationsNotificationIdReadWithResponse call
+func ParseDeleteNotificationsNotificationIdReadResponse(rsp *http.Response) (*DeleteNotificationsNotificationIdReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteNotificationsNotificationIdReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostNotificationsNotificationIdReadResponse parses an HTTP response from a PostNotificationsNotificationIdReadWithResponse call
+func ParsePostNotificationsNotificationIdReadResponse(rsp *http.Response) (*PostNotificationsNotificationIdReadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostNotificationsNotificationIdReadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetTemplatesResponse parses an HTTP response from a GetTemplatesWithResponse call
+func ParseGetTemplatesResponse(rsp *http.Response) (*GetTemplatesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTemplatesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TemplatesResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTrashResponse parses an HTTP response from a GetTrashWithResponse call
+func ParseGetTrashResponse(rsp *http.Response) (*GetTrashResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTrashResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TrashResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksResponse parses an HTTP response from a GetWebhooksWithResponse call
+func ParseGetWebhooksResponse(rsp *http.Response) (*GetWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksResponse parses an HTTP response from a PostWebhooksWithResponse call
+func ParsePostWebhooksResponse(rsp *http.Response) (*PostWebhooksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Webhook
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWebhooksWebhookIdResponse parses an HTTP response from a DeleteWebhooksWebhookIdWithResponse call
+func ParseDeleteWebhooksWebhookIdResponse(rsp *http.Response) (*DeleteWebhooksWebhookIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWebhooksWebhookIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetWebhooksWebhookIdDeliveriesResponse parses an HTTP response from a GetWebhooksWebhookIdDeliveriesWithResponse call
+func ParseGetWebhooksWebhookIdDeliveriesResponse(rsp *http.Response) (*GetWebhooksWebhookIdDeliveriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWebhooksWebhookIdDeliveriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WebhookDeliveries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse parses an HTTP response from a PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverWithResponse call
+func ParsePostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse(rsp *http.Response) (*PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliverResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest WebhookDelivery
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWishlistsResponse parses an HTTP response from a GetWishlistsWithResponse call
+func ParseGetWishlistsResponse(rsp *http.Response) (*GetWishlistsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWishlistsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWishlistsResponse parses an HTTP response from a PostWishlistsWithResponse call
+func ParsePostWishlistsResponse(rsp *http.Response) (*PostWishlistsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWishlistsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWishlistsImportResponse parses an HTTP response from a PostWishlistsImportWithResponse call
+func ParsePostWishlistsImportResponse(rsp *http.Response) (*PostWishlistsImportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWishlistsImportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ImportResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteWishlistsWishlistIdResponse parses an HTTP response from a DeleteWishlistsWishlistIdWithResponse call
+func ParseDeleteWishlistsWishlistIdResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteWishlistsWishlistIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWishlistsWishlistIdResponse parses an HTTP response from a GetWishlistsWishlistIdWithResponse call
+func ParseGetWishlistsWishlistIdResponse(rsp *http.Response) (*GetWishlistsWishlistIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWishlistsWishlistIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostWishlistsWishlistIdItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeleteWishlistsWishlistIdItemsItemIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteWishlistsWishlistIdItemsItemIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParsePutWishlistsWishlistIdResponse parses an HTTP response from a PutWishlistsWishlistIdWithResponse call
+func ParsePutWishlistsWishlistIdResponse(rsp *http.Response) (*PutWishlistsWishlistIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWishlistsWishlistIdItemsItemIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &PutWishlistsWishlistIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type PutWishlistsWishlistIdItemsItemIdResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *WishlistItem
-	JSON400      *ValidationErrorResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r PutWishlistsWishlistIdItemsItemIdResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PutWishlistsWishlistIdItemsItemIdResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type PostWishlistsWishlistIdItemsItemIdBookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ItemBooking
-	JSON400      *ValidationErrorResponse
-	JSON409      *ConflictErrorResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r PostWishlistsWishlistIdItemsItemIdBookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetWishlistsWishlistIdActivityResponse parses an HTTP response from a GetWishlistsWishlistIdActivityWithResponse call
+func ParseGetWishlistsWishlistIdActivityResponse(rsp *http.Response) (*GetWishlistsWishlistIdActivityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r PostWishlistsWishlistIdItemsItemIdBookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetWishlistsWishlistIdActivityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteWishlistsWishlistIdItemsItemIdUnbookResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ActivityFeed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteWishlistsWishlistIdItemsItemIdUnbookResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteWishlistsWishlistIdItemsItemIdUnbookResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// GetWishlistsWithResponse request returning *GetWishlistsResponse
-func (c *ClientWithResponses) GetWishlistsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWishlistsResponse, error) {
-	rsp, err := c.GetWishlists(ctx, reqEditors...)
+// ParsePostWishlistsWishlistIdCopyResponse parses an HTTP response from a PostWishlistsWishlistIdCopyWithResponse call
+func ParsePostWishlistsWishlistIdCopyResponse(rsp *http.Response) (*PostWishlistsWishlistIdCopyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetWishlistsResponse(rsp)
-}
 
-// PostWishlistsWithBodyWithResponse request with arbitrary body returning *PostWishlistsResponse
-func (c *ClientWithResponses) PostWishlistsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error) {
-	rsp, err := c.PostWishlistsWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &PostWishlistsWishlistIdCopyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePostWishlistsResponse(rsp)
-}
 
-func (c *ClientWithResponses) PostWishlistsWithResponse(ctx context.Context, body PostWishlistsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsResponse, error) {
-	rsp, err := c.PostWishlists(ctx, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	}
-	return ParsePostWishlistsResponse(rsp)
+
+	return response, nil
 }
 
-// DeleteWishlistsWishlistIdWithResponse request returning *DeleteWishlistsWishlistIdResponse
-func (c *ClientWithResponses) DeleteWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdResponse, error) {
-	rsp, err := c.DeleteWishlistsWishlistId(ctx, wishlistId, reqEditors...)
+// ParseGetWishlistsWishlistIdEventsResponse parses an HTTP response from a GetWishlistsWishlistIdEventsWithResponse call
+func ParseGetWishlistsWishlistIdEventsResponse(rsp *http.Response) (*GetWishlistsWishlistIdEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteWishlistsWishlistIdResponse(rsp)
-}
 
-// GetWishlistsWishlistIdWithResponse request returning *GetWishlistsWishlistIdResponse
-func (c *ClientWithResponses) GetWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetWishlistsWishlistIdResponse, error) {
-	rsp, err := c.GetWishlistsWishlistId(ctx, wishlistId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetWishlistsWishlistIdEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseGetWishlistsWishlistIdResponse(rsp)
+
+	return response, nil
 }
 
-// PutWishlistsWishlistIdWithBodyWithResponse request with arbitrary body returning *PutWishlistsWishlistIdResponse
-func (c *ClientWithResponses) PutWishlistsWishlistIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error) {
-	rsp, err := c.PutWishlistsWishlistIdWithBody(ctx, wishlistId, contentType, body, reqEditors...)
+// ParseGetWishlistsWishlistIdExportResponse parses an HTTP response from a GetWishlistsWishlistIdExportWithResponse call
+func ParseGetWishlistsWishlistIdExportResponse(rsp *http.Response) (*GetWishlistsWishlistIdExportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutWishlistsWishlistIdResponse(rsp)
-}
 
-func (c *ClientWithResponses) PutWishlistsWishlistIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, body PutWishlistsWishlistIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdResponse, error) {
-	rsp, err := c.PutWishlistsWishlistId(ctx, wishlistId, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetWishlistsWishlistIdExportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParsePutWishlistsWishlistIdResponse(rsp)
-}
 
-// PostWishlistsWishlistIdItemsWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsResponse
-func (c *ClientWithResponses) PostWishlistsWishlistIdItemsWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error) {
-	rsp, err := c.PostWishlistsWishlistIdItemsWithBody(ctx, wishlistId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PortableWishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/markdown) unsupported
+
 	}
-	return ParsePostWishlistsWishlistIdItemsResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PostWishlistsWishlistIdItemsWithResponse(ctx context.Context, wishlistId openapi_types.UUID, body PostWishlistsWishlistIdItemsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsResponse, error) {
-	rsp, err := c.PostWishlistsWishlistIdItems(ctx, wishlistId, body, reqEditors...)
+// ParseGetWishlistsWishlistIdHistoryResponse parses an HTTP response from a GetWishlistsWishlistIdHistoryWithResponse call
+func ParseGetWishlistsWishlistIdHistoryResponse(rsp *http.Response) (*GetWishlistsWishlistIdHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePostWishlistsWishlistIdItemsResponse(rsp)
-}
 
-// DeleteWishlistsWishlistIdItemsItemIdWithResponse request returning *DeleteWishlistsWishlistIdItemsItemIdResponse
-func (c *ClientWithResponses) DeleteWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdResponse, error) {
-	rsp, err := c.DeleteWishlistsWishlistIdItemsItemId(ctx, wishlistId, itemId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &GetWishlistsWishlistIdHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeleteWishlistsWishlistIdItemsItemIdResponse(rsp)
-}
 
-// PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse request with arbitrary body returning *PutWishlistsWishlistIdItemsItemIdResponse
-func (c *ClientWithResponses) PutWishlistsWishlistIdItemsItemIdWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error) {
-	rsp, err := c.PutWishlistsWishlistIdItemsItemIdWithBody(ctx, wishlistId, itemId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WishlistHistory
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) PutWishlistsWishlistIdItemsItemIdWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PutWishlistsWishlistIdItemsItemIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutWishlistsWishlistIdItemsItemIdResponse, error) {
-	rsp, err := c.PutWishlistsWishlistIdItemsItemId(ctx, wishlistId, itemId, body, reqEditors...)
+// ParsePostWishlistsWishlistIdItemsResponse parses an HTTP response from a PostWishlistsWishlistIdItemsWithResponse call
+func ParsePostWishlistsWishlistIdItemsResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp)
-}
 
-// PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse request with arbitrary body returning *PostWishlistsWishlistIdItemsItemIdBookResponse
-func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdBookWithBodyWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error) {
-	rsp, err := c.PostWishlistsWishlistIdItemsItemIdBookWithBody(ctx, wishlistId, itemId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp)
-}
+	response := &PostWishlistsWishlistIdItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest WishlistItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
-func (c *ClientWithResponses) PostWishlistsWishlistIdItemsItemIdBookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, body PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody, reqEditors ...RequestEditorFn) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error) {
-	rsp, err := c.PostWishlistsWishlistIdItemsItemIdBook(ctx, wishlistId, itemId, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp)
-}
 
-// DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse request returning *DeleteWishlistsWishlistIdItemsItemIdUnbookResponse
-func (c *ClientWithResponses) DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse(ctx context.Context, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params *DeleteWishlistsWishlistIdItemsItemIdUnbookParams, reqEditors ...RequestEditorFn) (*DeleteWishlistsWishlistIdItemsItemIdUnbookResponse, error) {
-	rsp, err := c.DeleteWishlistsWishlistIdItemsItemIdUnbook(ctx, wishlistId, itemId, params, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteWishlistsWishlistIdItemsItemIdUnbookResponse(rsp)
+	return response, nil
 }
 
-// ParseGetWishlistsResponse parses an HTTP response from a GetWishlistsWithResponse call
-func ParseGetWishlistsResponse(rsp *http.Response) (*GetWishlistsResponse, error) {
+// ParsePostWishlistsWishlistIdItemsCopyResponse parses an HTTP response from a PostWishlistsWishlistIdItemsCopyWithResponse call
+func ParsePostWishlistsWishlistIdItemsCopyResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsCopyResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWishlistsResponse{
+	response := &PostWishlistsWishlistIdItemsCopyResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Wishlist
+		var dest TransferItemsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostWishlistsResponse parses an HTTP response from a PostWishlistsWithResponse call
-func ParsePostWishlistsResponse(rsp *http.Response) (*PostWishlistsResponse, error) {
+// ParsePostWishlistsWishlistIdItemsMoveResponse parses an HTTP response from a PostWishlistsWishlistIdItemsMoveWithResponse call
+func ParsePostWishlistsWishlistIdItemsMoveResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsMoveResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostWishlistsResponse{
+	response := &PostWishlistsWishlistIdItemsMoveResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Wishlist
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TransferItemsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ValidationErrorResponse
@@ -1494,69 +7075,100 @@ func ParsePostWishlistsResponse(rsp *http.Response) (*PostWishlistsResponse, err
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteWishlistsWishlistIdResponse parses an HTTP response from a DeleteWishlistsWishlistIdWithResponse call
-func ParseDeleteWishlistsWishlistIdResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdResponse, error) {
+// ParseDeleteWishlistsWishlistIdItemsItemIdResponse parses an HTTP response from a DeleteWishlistsWishlistIdItemsItemIdWithResponse call
+func ParseDeleteWishlistsWishlistIdItemsItemIdResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdItemsItemIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteWishlistsWishlistIdResponse{
+	response := &DeleteWishlistsWishlistIdItemsItemIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
 	return response, nil
 }
 
-// ParseGetWishlistsWishlistIdResponse parses an HTTP response from a GetWishlistsWishlistIdWithResponse call
-func ParseGetWishlistsWishlistIdResponse(rsp *http.Response) (*GetWishlistsWishlistIdResponse, error) {
+// ParsePutWishlistsWishlistIdItemsItemIdResponse parses an HTTP response from a PutWishlistsWishlistIdItemsItemIdWithResponse call
+func ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp *http.Response) (*PutWishlistsWishlistIdItemsItemIdResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetWishlistsWishlistIdResponse{
+	response := &PutWishlistsWishlistIdItemsItemIdResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Wishlist
+		var dest WishlistItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ValidationErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePutWishlistsWishlistIdResponse parses an HTTP response from a PutWishlistsWishlistIdWithResponse call
-func ParsePutWishlistsWishlistIdResponse(rsp *http.Response) (*PutWishlistsWishlistIdResponse, error) {
+// ParsePostWishlistsWishlistIdItemsItemIdBookResponse parses an HTTP response from a PostWishlistsWishlistIdItemsItemIdBookWithResponse call
+func ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutWishlistsWishlistIdResponse{
+	response := &PostWishlistsWishlistIdItemsItemIdBookResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Wishlist
+		var dest BookItemResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1569,53 +7181,76 @@ func ParsePutWishlistsWishlistIdResponse(rsp *http.Response) (*PutWishlistsWishl
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ConflictErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParsePostWishlistsWishlistIdItemsResponse parses an HTTP response from a PostWishlistsWishlistIdItemsWithResponse call
-func ParsePostWishlistsWishlistIdItemsResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsResponse, error) {
+// ParseGetWishlistsWishlistIdItemsItemIdBookingResponse parses an HTTP response from a GetWishlistsWishlistIdItemsItemIdBookingWithResponse call
+func ParseGetWishlistsWishlistIdItemsItemIdBookingResponse(rsp *http.Response) (*GetWishlistsWishlistIdItemsItemIdBookingResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostWishlistsWishlistIdItemsResponse{
+	response := &GetWishlistsWishlistIdItemsItemIdBookingResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest WishlistItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest BookingStatus
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ValidationErrorResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON400 = &dest
+	}
+
+	return response, nil
+}
+
+// ParseGetWishlistsWishlistIdItemsItemIdBookingCancelResponse parses an HTTP response from a GetWishlistsWishlistIdItemsItemIdBookingCancelWithResponse call
+func ParseGetWishlistsWishlistIdItemsItemIdBookingCancelResponse(rsp *http.Response) (*GetWishlistsWishlistIdItemsItemIdBookingCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
+	response := &GetWishlistsWishlistIdItemsItemIdBookingCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
 
 	return response, nil
 }
 
-// ParseDeleteWishlistsWishlistIdItemsItemIdResponse parses an HTTP response from a DeleteWishlistsWishlistIdItemsItemIdWithResponse call
-func ParseDeleteWishlistsWishlistIdItemsItemIdResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdItemsItemIdResponse, error) {
+// ParsePostWishlistsWishlistIdItemsItemIdBookingCancelResponse parses an HTTP response from a PostWishlistsWishlistIdItemsItemIdBookingCancelWithResponse call
+func ParsePostWishlistsWishlistIdItemsItemIdBookingCancelResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsItemIdBookingCancelResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteWishlistsWishlistIdItemsItemIdResponse{
+	response := &PostWishlistsWishlistIdItemsItemIdBookingCancelResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1623,15 +7258,47 @@ func ParseDeleteWishlistsWishlistIdItemsItemIdResponse(rsp *http.Response) (*Del
 	return response, nil
 }
 
-// ParsePutWishlistsWishlistIdItemsItemIdResponse parses an HTTP response from a PutWishlistsWishlistIdItemsItemIdWithResponse call
-func ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp *http.Response) (*PutWishlistsWishlistIdItemsItemIdResponse, error) {
+// ParseDeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse parses an HTTP response from a DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse call
+func ParseDeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutWishlistsWishlistIdItemsItemIdResponse{
+	response := &DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse parses an HTTP response from a PutWishlistsWishlistIdItemsItemIdBookingPurchasedWithResponse call
+func ParsePutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse(rsp *http.Response) (*PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutWishlistsWishlistIdItemsItemIdBookingPurchasedResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostWishlistsWishlistIdItemsItemIdRestoreResponse parses an HTTP response from a PostWishlistsWishlistIdItemsItemIdRestoreWithResponse call
+func ParsePostWishlistsWishlistIdItemsItemIdRestoreResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsItemIdRestoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWishlistsWishlistIdItemsItemIdRestoreResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1644,34 +7311,86 @@ func ParsePutWishlistsWishlistIdItemsItemIdResponse(rsp *http.Response) (*PutWis
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest ValidationErrorResponse
+	}
+
+	return response, nil
+}
+
+// ParsePostWishlistsWishlistIdItemsItemIdRevertResponse parses an HTTP response from a PostWishlistsWishlistIdItemsItemIdRevertWithResponse call
+func ParsePostWishlistsWishlistIdItemsItemIdRevertResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsItemIdRevertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWishlistsWishlistIdItemsItemIdRevertResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WishlistItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePostWishlistsWishlistIdItemsItemIdBookResponse parses an HTTP response from a PostWishlistsWishlistIdItemsItemIdBookWithResponse call
-func ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsItemIdBookResponse, error) {
+// ParseDeleteWishlistsWishlistIdItemsItemIdUnbookResponse parses an HTTP response from a DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse call
+func ParseDeleteWishlistsWishlistIdItemsItemIdUnbookResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdItemsItemIdUnbookResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostWishlistsWishlistIdItemsItemIdBookResponse{
+	response := &DeleteWishlistsWishlistIdItemsItemIdUnbookResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWishlistsWishlistIdItemsBatchResponse parses an HTTP response from a PostWishlistsWishlistIdItemsBatchWithResponse call
+func ParsePostWishlistsWishlistIdItemsBatchResponse(rsp *http.Response) (*PostWishlistsWishlistIdItemsBatchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWishlistsWishlistIdItemsBatchResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest ItemBooking
+		var dest BatchItemsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1685,29 +7404,79 @@ func ParsePostWishlistsWishlistIdItemsItemIdBookResponse(rsp *http.Response) (*P
 		response.JSON400 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest ConflictErrorResponse
+		var dest BatchItemsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON409 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseDeleteWishlistsWishlistIdItemsItemIdUnbookResponse parses an HTTP response from a DeleteWishlistsWishlistIdItemsItemIdUnbookWithResponse call
-func ParseDeleteWishlistsWishlistIdItemsItemIdUnbookResponse(rsp *http.Response) (*DeleteWishlistsWishlistIdItemsItemIdUnbookResponse, error) {
+// ParsePostWishlistsWishlistIdRestoreResponse parses an HTTP response from a PostWishlistsWishlistIdRestoreWithResponse call
+func ParsePostWishlistsWishlistIdRestoreResponse(rsp *http.Response) (*PostWishlistsWishlistIdRestoreResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteWishlistsWishlistIdItemsItemIdUnbookResponse{
+	response := &PostWishlistsWishlistIdRestoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostWishlistsWishlistIdRevertResponse parses an HTTP response from a PostWishlistsWishlistIdRevertWithResponse call
+func ParsePostWishlistsWishlistIdRevertResponse(rsp *http.Response) (*PostWishlistsWishlistIdRevertResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostWishlistsWishlistIdRevertResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Wishlist
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	}
+
 	return response, nil
 }
@@ -0,0 +1,544 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	emailSentRetention = 30 * 24 * time.Hour
+
+	// unsubscribeAll is the token category of digests, which mix categories
+	unsubscribeAll = "all"
+)
+
+var emailCategories = []wishlistgen.EmailCategory{wishlistgen.Bookings, wishlistgen.Reminders}
+
+// mongoEmailPreferences only exists for users who changed the defaults or unsubscribed
+type mongoEmailPreferences struct {
+	UserID       string    `bson:"userId"`
+	Language     string    `bson:"language,omitempty"`
+	Delivery     string    `bson:"delivery,omitempty"`
+	Unsubscribed []string  `bson:"unsubscribed,omitempty"`
+	UpdatedAt    time.Time `bson:"updatedAt"`
+}
+
+func (p mongoEmailPreferences) toAPI() wishlistgen.EmailPreferences {
+	prefs := wishlistgen.EmailPreferences{
+		Language:     wishlistgen.Ru,
+		Delivery:     wishlistgen.Instant,
+		Unsubscribed: []wishlistgen.EmailCategory{},
+	}
+	if p.Language != "" {
+		prefs.Language = wishlistgen.TemplateLanguage(p.Language)
+	}
+	if p.Delivery != "" {
+		prefs.Delivery = wishlistgen.EmailDelivery(p.Delivery)
+	}
+	for _, category := range p.Unsubscribed {
+		prefs.Unsubscribed = append(prefs.Unsubscribed, wishlistgen.EmailCategory(category))
+	}
+	return prefs
+}
+
+func (p mongoEmailPreferences) wants(category wishlistgen.EmailCategory) bool {
+	for _, c := range p.Unsubscribed {
+		if c == string(category) {
+			return false
+		}
+	}
+	return true
+}
+
+// mongoQueuedEmail waits for the next digest of a user
+type mongoQueuedEmail struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	IdempotencyKey string             `bson:"idempotencyKey"`
+	UserID         string             `bson:"userId"`
+	Data           emailData          `bson:"data"`
+	CreatedAt      time.Time          `bson:"createdAt"`
+}
+
+// unsubscribeSigner creates and checks the tokens of unsubscribe links, which
+// work without logging in
+type unsubscribeSigner struct {
+	key []byte
+}
+
+func (s unsubscribeSigner) mac(payload string) []byte {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func (s unsubscribeSigner) Token(userID, category string) string {
+	payload := userID + ":" + category
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." + base64.RawURLEncoding.EncodeToString(s.mac(payload))
+}
+
+// Parse returns the user and category of a valid token
+func (s unsubscribeSigner) Parse(token string) (string, string, bool) {
+	if len(s.key) == 0 {
+		return "", "", false
+	}
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", "", false
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", "", false
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, s.mac(string(payload))) {
+		return "", "", false
+	}
+	userID, category, ok := strings.Cut(string(payload), ":")
+	return userID, category, ok
+}
+
+func (r *MongoRepo) findEmailPreferences(ctx context.Context, userID string) (mongoEmailPreferences, error) {
+	prefs := mongoEmailPreferences{UserID: userID}
+	err := r.emailPreferences.FindOne(ctx, bson.M{"userId": userID}).Decode(&prefs)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return prefs, fmt.Errorf("failed to find email preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (r *MongoRepo) GetEmailPreferences(ctx context.Context, userID openapi_types.UUID) (*wishlistgen.EmailPreferences, error) {
+	prefs, err := r.findEmailPreferences(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	api := prefs.toAPI()
+	return &api, nil
+}
+
+func (r *MongoRepo) UpdateEmailPreferences(ctx context.Context, userID openapi_types.UUID, req wishlistgen.UpdateEmailPreferencesRequest) (*wishlistgen.EmailPreferences, error) {
+	set := bson.M{"updatedAt": time.Now()}
+	if req.Language != nil {
+		set["language"] = string(*req.Language)
+	}
+	if req.Delivery != nil {
+		set["delivery"] = string(*req.Delivery)
+	}
+	if req.Unsubscribed != nil {
+		categories := []string{}
+		for _, c := range *req.Unsubscribed {
+			categories = append(categories, string(c))
+		}
+		set["unsubscribed"] = categories
+	}
+
+	var prefs mongoEmailPreferences
+	err := r.emailPreferences.FindOneAndUpdate(ctx,
+		bson.M{"userId": userID.String()},
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&prefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update email preferences: %w", err)
+	}
+
+	api := prefs.toAPI()
+	return &api, nil
+}
+
+// UnsubscribeEmail stops the given categories for a user
+func (r *MongoRepo) UnsubscribeEmail(ctx context.Context, userID string, categories []string) error {
+	_, err := r.emailPreferences.UpdateOne(ctx,
+		bson.M{"userId": userID},
+		bson.M{
+			"$addToSet": bson.M{"unsubscribed": bson.M{"$each": categories}},
+			"$set":      bson.M{"updatedAt": time.Now()},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepo) emailSent(ctx context.Context, key string) (bool, error) {
+	count, err := r.emailsSent.CountDocuments(ctx, bson.M{"idempotencyKey": key}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to check sent emails: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *MongoRepo) markEmailsSent(ctx context.Context, userID string, keys []string) error {
+	now := time.Now()
+	docs := make([]interface{}, len(keys))
+	for i, key := range keys {
+		docs[i] = bson.M{"idempotencyKey": key, "userId": userID, "sentAt": now}
+	}
+	_, err := r.emailsSent.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to record sent emails: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepo) queueDigestEmail(ctx context.Context, key, userID string, data emailData) error {
+	_, err := r.emailQueue.InsertOne(ctx, mongoQueuedEmail{
+		IdempotencyKey: key,
+		UserID:         userID,
+		Data:           data,
+		CreatedAt:      time.Now(),
+	})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to queue email: %w", err)
+	}
+	return nil
+}
+
+// emailNotifier turns domain events into emails, right away or in digests
+type emailNotifier struct {
+	repo        *MongoRepo
+	users       *UserClient
+	mailer      mailer
+	signer      unsubscribeSigner
+	frontendURL string
+	apiURL      string
+	logger      *Logger
+}
+
+func newEmailNotifier(repo *MongoRepo, users *UserClient, m mailer, signer unsubscribeSigner, frontendURL, apiURL string) *emailNotifier {
+	return &emailNotifier{
+		repo:        repo,
+		users:       users,
+		mailer:      m,
+		signer:      signer,
+		frontendURL: strings.TrimRight(frontendURL, "/"),
+		apiURL:      strings.TrimRight(apiURL, "/"),
+		logger:      NewLogger(),
+	}
+}
+
+func (n *emailNotifier) wishlistURL(wishlistID string) string {
+	return n.frontendURL + "/wishlists/" + wishlistID
+}
+
+func (n *emailNotifier) unsubscribeURL(userID, category string) string {
+	return n.apiURL + "/notifications/email/unsubscribe?token=" + url.QueryEscape(n.signer.Token(userID, category))
+}
+
+// emailDataOf describes an owner notification for an email
+func emailDataOf(notification *userNotification, wishlistURL string) emailData {
+	data := emailData{
+		Kind:          emailKindItemBooked,
+		WishlistTitle: notification.WishlistTitle,
+		WishlistURL:   wishlistURL,
+	}
+	if notification.Type != string(wishlistgen.ActivityEventTypeItemBooked) {
+		data.Kind = emailKindItemUnbooked
+	}
+	if notification.ItemName != nil {
+		data.ItemName = *notification.ItemName
+	}
+	if notification.BookerName != nil {
+		data.BookerName = *notification.BookerName
+	}
+	if notification.Message != nil {
+		data.Message = *notification.Message
+	}
+	return data
+}
+
+// Notify is a domainBus handler emailing owners about bookings
+func (n *emailNotifier) Notify(ctx context.Context, event domainEvent) error {
+	notification := ownerNotificationOf(event)
+	if notification == nil {
+		return nil
+	}
+	return n.Deliver(ctx, event.ID, event.OwnerID, emailDataOf(notification, n.wishlistURL(event.WishlistID)))
+}
+
+// Deliver emails data to a user, or queues it for the digest, unless the user
+// unsubscribed from its category. key makes repeated deliveries of the same
+// event no-ops.
+func (n *emailNotifier) Deliver(ctx context.Context, key, userID string, data emailData) error {
+	prefs, err := n.repo.findEmailPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	category := emailCategoryOf(data.Kind)
+	if !prefs.wants(category) {
+		return nil
+	}
+
+	sent, err := n.repo.emailSent(ctx, key)
+	if err != nil || sent {
+		return err
+	}
+
+	if prefs.toAPI().Delivery == wishlistgen.Digest {
+		return n.repo.queueDigestEmail(ctx, key, userID, data)
+	}
+
+	to, err := n.users.GetEmail(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to look up email of user %s: %w", userID, err)
+	}
+	if to == "" {
+		return nil
+	}
+
+	msg, err := renderEmail(prefs.toAPI().Language, to, []emailData{data}, n.unsubscribeURL(userID, string(category)))
+	if err != nil {
+		return fmt.Errorf("failed to render %s email: %w", data.Kind, err)
+	}
+	if err := n.mailer.Send(ctx, msg); err != nil {
+		return err
+	}
+	return n.repo.markEmailsSent(ctx, userID, []string{key})
+}
+
+// SendDigests emails every user with queued emails one digest and returns how
+// many were sent
+func (n *emailNotifier) SendDigests(ctx context.Context) (int, error) {
+	userIDs, err := n.repo.emailQueue.Distinct(ctx, "userId", bson.M{})
+	if err != nil {
+		return 0, fmt.Errorf("failed to list queued emails: %w", err)
+	}
+
+	sent := 0
+	for _, id := range userIDs {
+		userID, _ := id.(string)
+		ok, err := n.sendDigest(ctx, userID)
+		if err != nil {
+			n.logger.LogError(ctx, nil, "send_email_digest", err, fmt.Sprintf("failed to send digest to user %s", userID))
+			continue
+		}
+		if ok {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+func (n *emailNotifier) sendDigest(ctx context.Context, userID string) (bool, error) {
+	cursor, err := n.repo.emailQueue.Find(ctx, bson.M{"userId": userID}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return false, fmt.Errorf("failed to find queued emails: %w", err)
+	}
+	var queued []mongoQueuedEmail
+	if err := cursor.All(ctx, &queued); err != nil {
+		return false, fmt.Errorf("failed to decode queued emails: %w", err)
+	}
+	if len(queued) == 0 {
+		return false, nil
+	}
+
+	prefs, err := n.repo.findEmailPreferences(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	ids := make([]primitive.ObjectID, len(queued))
+	var keys []string
+	var events []emailData
+	for i, q := range queued {
+		ids[i] = q.ID
+		if prefs.wants(emailCategoryOf(q.Data.Kind)) {
+			keys = append(keys, q.IdempotencyKey)
+			events = append(events, q.Data)
+		}
+	}
+
+	if len(events) > 0 {
+		to, err := n.users.GetEmail(ctx, userID)
+		if err != nil {
+			return false, fmt.Errorf("failed to look up email: %w", err)
+		}
+		if to != "" {
+			unsubscribe := n.unsubscribeURL(userID, unsubscribeAll)
+			if len(events) == 1 {
+				unsubscribe = n.unsubscribeURL(userID, string(emailCategoryOf(events[0].Kind)))
+			}
+			msg, err := renderEmail(prefs.toAPI().Language, to, events, unsubscribe)
+			if err != nil {
+				return false, fmt.Errorf("failed to render digest: %w", err)
+			}
+			if err := n.mailer.Send(ctx, msg); err != nil {
+				return false, err
+			}
+			if err := n.repo.markEmailsSent(ctx, userID, keys); err != nil {
+				return false, err
+			}
+		} else {
+			events = nil
+		}
+	}
+
+	if _, err := n.repo.emailQueue.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return false, fmt.Errorf("failed to clear queued emails: %w", err)
+	}
+	return len(events) > 0, nil
+}
+
+func sendEmailDigestsJob(notifier *emailNotifier, logger *Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		sent, err := notifier.SendDigests(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to send email digests: %w", err)
+		}
+		if sent > 0 {
+			logger.LogDatabaseOperation(ctx, "send_email_digests", time.Since(start), true, fmt.Sprintf("sent %d digests", sent))
+		}
+		return nil
+	}
+}
+
+// Get email notification preferences of the authenticated user
+func (s *WishlistServer) GetNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "get_email_preferences")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	prefs, err := s.repo.GetEmailPreferences(r.Context(), userID)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "get_email_preferences", err, "failed to retrieve email preferences")
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve email preferences")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_email_preferences", "retrieved email preferences")
+	s.writeJSON(w, http.StatusOK, prefs)
+}
+
+// Update email notification preferences of the authenticated user
+func (s *WishlistServer) PutNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "update_email_preferences")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	var req wishlistgen.UpdateEmailPreferencesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.LogBadRequest(r.Context(), &userID, "update_email_preferences", "malformed JSON")
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return
+	}
+
+	if validationErrors := ValidateUpdateEmailPreferencesRequest(req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, "update_email_preferences", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	prefs, err := s.repo.UpdateEmailPreferences(r.Context(), userID, req)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "update_email_preferences", err, "failed to update email preferences")
+		s.writeError(w, http.StatusInternalServerError, "Failed to update email preferences")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "update_email_preferences", "updated email preferences")
+	s.writeJSON(w, http.StatusOK, prefs)
+}
+
+var unsubscribedPage = template.Must(template.New("unsubscribed").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Wili</title></head>
+<body style="font-family: sans-serif;"><p>{{.}}</p></body></html>
+`))
+
+var unsubscribedText = localized{
+	wishlistgen.En: "You have been unsubscribed. Preferences can be changed in your Wili profile.",
+	wishlistgen.Ru: "Вы отписались. Настройки писем можно изменить в профиле Wili.",
+}
+
+// unsubscribe applies an unsubscribe token and returns the user's language
+func (s *WishlistServer) unsubscribe(ctx context.Context, token string) (wishlistgen.TemplateLanguage, bool, error) {
+	userID, category, ok := s.unsubscribeSigner.Parse(token)
+	if !ok {
+		return "", false, nil
+	}
+
+	categories := []string{category}
+	if category == unsubscribeAll {
+		categories = nil
+		for _, c := range emailCategories {
+			categories = append(categories, string(c))
+		}
+	}
+	if err := s.repo.UnsubscribeEmail(ctx, userID, categories); err != nil {
+		return "", true, err
+	}
+
+	prefs, err := s.repo.findEmailPreferences(ctx, userID)
+	if err != nil {
+		return "", true, err
+	}
+	return prefs.toAPI().Language, true, nil
+}
+
+// Unsubscribe via the link in an email
+func (s *WishlistServer) GetNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request, params wishlistgen.GetNotificationsEmailUnsubscribeParams) {
+	s.logger.LogRequest(r, nil, "unsubscribe_email")
+
+	language, ok, err := s.unsubscribe(r.Context(), params.Token)
+	if !ok {
+		s.logger.LogBadRequest(r.Context(), nil, "unsubscribe_email", "invalid token")
+		s.writeError(w, http.StatusBadRequest, "Invalid unsubscribe token")
+		return
+	}
+	if err != nil {
+		s.logger.LogError(r.Context(), nil, "unsubscribe_email", err, "failed to unsubscribe")
+		s.writeError(w, http.StatusInternalServerError, "Failed to unsubscribe")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), nil, "unsubscribe_email", "unsubscribed via link")
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	unsubscribedPage.Execute(w, unsubscribedText[language])
+}
+
+// One-click unsubscribe (RFC 8058) by mail clients
+func (s *WishlistServer) PostNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request, params wishlistgen.PostNotificationsEmailUnsubscribeParams) {
+	s.logger.LogRequest(r, nil, "unsubscribe_email")
+
+	_, ok, err := s.unsubscribe(r.Context(), params.Token)
+	if !ok {
+		s.logger.LogBadRequest(r.Context(), nil, "unsubscribe_email", "invalid token")
+		s.writeError(w, http.StatusBadRequest, "Invalid unsubscribe token")
+		return
+	}
+	if err != nil {
+		s.logger.LogError(r.Context(), nil, "unsubscribe_email", err, "failed to unsubscribe")
+		s.writeError(w, http.StatusInternalServerError, "Failed to unsubscribe")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), nil, "unsubscribe_email", "unsubscribed via one-click")
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,278 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// Email kinds; each belongs to one wishlistgen.EmailCategory
+const (
+	emailKindItemBooked      = "item_booked"
+	emailKindItemUnbooked    = "item_unbooked"
+	emailKindEventSoon       = "event_soon"
+	emailKindBookingExpiring = "booking_expiring"
+	emailKindBookingReceipt  = "booking_receipt"
+	emailKindGiftReminder    = "gift_reminder"
+)
+
+func emailCategoryOf(kind string) wishlistgen.EmailCategory {
+	switch kind {
+	case emailKindEventSoon, emailKindBookingExpiring, emailKindGiftReminder:
+		return wishlistgen.Reminders
+	default:
+		return wishlistgen.Bookings
+	}
+}
+
+// emailData is what a notification email is rendered from. Empty ItemName
+// and BookerName mean the owner asked not to know; CancelURL is only set in
+// emails to bookers.
+type emailData struct {
+	Kind          string `bson:"kind"`
+	WishlistTitle string `bson:"wishlistTitle"`
+	WishlistURL   string `bson:"wishlistUrl"`
+	ItemName      string `bson:"itemName,omitempty"`
+	BookerName    string `bson:"bookerName,omitempty"`
+	Message       string `bson:"message,omitempty"`
+	DaysLeft      int    `bson:"daysLeft,omitempty"`
+	Views         int64  `bson:"views,omitempty"`
+	CancelURL     string `bson:"cancelUrl,omitempty"`
+}
+
+// emailSubjects are the subjects of single emails, emailLines the sentence
+// describing the event; both are templates over emailData. Lines are parsed
+// both as text and as HTML templates, the latter escaping user content.
+var (
+	emailSubjects = map[string]localized{
+		emailKindItemBooked: {
+			wishlistgen.En: "A gift from «{{.WishlistTitle}}» has been booked",
+			wishlistgen.Ru: "Забронировали подарок из «{{.WishlistTitle}}»",
+		},
+		emailKindItemUnbooked: {
+			wishlistgen.En: "A gift from «{{.WishlistTitle}}» is no longer booked",
+			wishlistgen.Ru: "Сняли бронь с подарка из «{{.WishlistTitle}}»",
+		},
+		emailKindEventSoon: {
+			wishlistgen.En: "«{{.WishlistTitle}}» is in {{days .DaysLeft}}",
+			wishlistgen.Ru: "«{{.WishlistTitle}}» через {{days .DaysLeft}}",
+		},
+		emailKindBookingExpiring: {
+			wishlistgen.En: "Your booking in «{{.WishlistTitle}}» expires soon",
+			wishlistgen.Ru: "Ваша бронь в «{{.WishlistTitle}}» скоро истечёт",
+		},
+		emailKindBookingReceipt: {
+			wishlistgen.En: "You booked «{{.ItemName}}»",
+			wishlistgen.Ru: "Вы забронировали «{{.ItemName}}»",
+		},
+		emailKindGiftReminder: {
+			wishlistgen.En: "«{{.WishlistTitle}}» is in {{days .DaysLeft}}: don't forget «{{.ItemName}}»",
+			wishlistgen.Ru: "До «{{.WishlistTitle}}» {{days .DaysLeft}}: не забудьте про «{{.ItemName}}»",
+		},
+	}
+
+	emailLines = map[string]localized{
+		emailKindItemBooked: {
+			wishlistgen.En: "{{if .ItemName}}«{{.ItemName}}» from «{{.WishlistTitle}}» has been booked{{if .BookerName}} by {{.BookerName}}{{end}}.{{if .Message}} Message: {{.Message}}{{end}}{{else}}Someone booked a gift from «{{.WishlistTitle}}». Which one is a surprise!{{end}}",
+			wishlistgen.Ru: "{{if .ItemName}}«{{.ItemName}}» из вишлиста «{{.WishlistTitle}}» забронировали{{if .BookerName}} — {{.BookerName}}{{end}}.{{if .Message}} Сообщение: {{.Message}}{{end}}{{else}}Кто-то забронировал подарок из вишлиста «{{.WishlistTitle}}». Какой — пусть будет сюрпризом!{{end}}",
+		},
+		emailKindItemUnbooked: {
+			wishlistgen.En: "{{if .ItemName}}«{{.ItemName}}» from «{{.WishlistTitle}}» is no longer booked.{{else}}One of the gifts from «{{.WishlistTitle}}» is no longer booked.{{end}}",
+			wishlistgen.Ru: "{{if .ItemName}}С «{{.ItemName}}» из вишлиста «{{.WishlistTitle}}» сняли бронь.{{else}}С одного из подарков вишлиста «{{.WishlistTitle}}» сняли бронь.{{end}}",
+		},
+		emailKindEventSoon: {
+			wishlistgen.En: "«{{.WishlistTitle}}» is in {{days .DaysLeft}}{{if .Views}}, and the wishlist has only been opened {{times .Views}}{{end}}. Share the link so guests know what to bring.",
+			wishlistgen.Ru: "До «{{.WishlistTitle}}» осталось {{days .DaysLeft}}{{if .Views}}, а вишлист открывали всего {{times .Views}}{{end}}. Поделитесь ссылкой, чтобы гости знали, что подарить.",
+		},
+		emailKindBookingExpiring: {
+			wishlistgen.En: "Your booking of «{{.ItemName}}» in «{{.WishlistTitle}}» expires in {{days .DaysLeft}}.",
+			wishlistgen.Ru: "Ваша бронь «{{.ItemName}}» в вишлисте «{{.WishlistTitle}}» истекает через {{days .DaysLeft}}.",
+		},
+		emailKindBookingReceipt: {
+			wishlistgen.En: "You booked «{{.ItemName}}» from «{{.WishlistTitle}}»{{if .BookerName}} as {{.BookerName}}{{end}}.{{if .Message}} Your message: {{.Message}}{{end}} Changed your mind? The booking can be cancelled with the link below.",
+			wishlistgen.Ru: "Вы забронировали «{{.ItemName}}» из вишлиста «{{.WishlistTitle}}»{{if .BookerName}} как {{.BookerName}}{{end}}.{{if .Message}} Ваше сообщение: {{.Message}}{{end}} Если передумаете, бронь можно отменить по ссылке ниже.",
+		},
+		emailKindGiftReminder: {
+			wishlistgen.En: "«{{.WishlistTitle}}» is in {{days .DaysLeft}}. You booked «{{.ItemName}}» — time to get it if you haven't yet. Can't make it? Cancel the booking so someone else can.",
+			wishlistgen.Ru: "До «{{.WishlistTitle}}» осталось {{days .DaysLeft}}. Вы забронировали «{{.ItemName}}» — самое время его купить, если ещё не успели. Не получается? Отмените бронь, чтобы подарок смог выбрать кто-то другой.",
+		},
+	}
+
+	emailDigestSubject = localized{
+		wishlistgen.En: "Wili: your daily summary",
+		wishlistgen.Ru: "Wili: новости за день",
+	}
+	emailOpenLabel = localized{
+		wishlistgen.En: "Open wishlist",
+		wishlistgen.Ru: "Открыть вишлист",
+	}
+	emailCancelLabel = localized{
+		wishlistgen.En: "Cancel booking",
+		wishlistgen.Ru: "Отменить бронь",
+	}
+	emailUnsubscribeLabel = localized{
+		wishlistgen.En: "Unsubscribe from these emails",
+		wishlistgen.Ru: "Отписаться от таких писем",
+	}
+)
+
+// emailEntry is one rendered event of an email
+type emailEntry struct {
+	Text      string
+	HTML      htmltemplate.HTML
+	URL       string
+	CancelURL string
+}
+
+type emailLayoutData struct {
+	Heading          string
+	Entries          []emailEntry
+	OpenLabel        string
+	CancelLabel      string
+	UnsubscribeLabel string
+	UnsubscribeURL   string
+}
+
+var emailTextLayout = texttemplate.Must(texttemplate.New("text").Parse(`{{.Heading}}
+{{range .Entries}}
+{{.Text}}
+{{$.OpenLabel}}: {{.URL}}
+{{if .CancelURL}}{{$.CancelLabel}}: {{.CancelURL}}
+{{end}}{{end}}{{if .UnsubscribeURL}}
+--
+{{.UnsubscribeLabel}}: {{.UnsubscribeURL}}
+{{end}}`))
+
+var emailHTMLLayout = htmltemplate.Must(htmltemplate.New("html").Parse(`<!DOCTYPE html>
+<html>
+<body style="font-family: sans-serif; color: #222;">
+<h2>{{.Heading}}</h2>
+{{range .Entries}}<p>{{.HTML}}<br><a href="{{.URL}}">{{$.OpenLabel}}</a>{{if .CancelURL}} · <a href="{{.CancelURL}}">{{$.CancelLabel}}</a>{{end}}</p>
+{{end}}{{if .UnsubscribeURL}}<hr>
+<p style="font-size: 12px; color: #888;"><a href="{{.UnsubscribeURL}}">{{.UnsubscribeLabel}}</a></p>
+{{end}}</body>
+</html>
+`))
+
+func emailFuncs(language wishlistgen.TemplateLanguage) map[string]any {
+	return map[string]any{
+		"days":  func(n int) string { return pluralDays(language, n) },
+		"times": func(n int64) string { return pluralTimes(language, n) },
+	}
+}
+
+func pluralDays(language wishlistgen.TemplateLanguage, n int) string {
+	if language == wishlistgen.Ru {
+		switch {
+		case n%10 == 1 && n%100 != 11:
+			return fmt.Sprintf("%d день", n)
+		case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+			return fmt.Sprintf("%d дня", n)
+		default:
+			return fmt.Sprintf("%d дней", n)
+		}
+	}
+	if n == 1 {
+		return "1 day"
+	}
+	return fmt.Sprintf("%d days", n)
+}
+
+func pluralTimes(language wishlistgen.TemplateLanguage, n int64) string {
+	if language == wishlistgen.Ru {
+		if n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14) {
+			return fmt.Sprintf("%d раза", n)
+		}
+		return fmt.Sprintf("%d раз", n)
+	}
+	if n == 1 {
+		return "once"
+	}
+	return fmt.Sprintf("%d times", n)
+}
+
+func renderText(language wishlistgen.TemplateLanguage, source string, data emailData) (string, error) {
+	t, err := texttemplate.New("").Funcs(emailFuncs(language)).Parse(source)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+func renderEmailEntry(language wishlistgen.TemplateLanguage, data emailData) (emailEntry, error) {
+	line, ok := emailLines[data.Kind]
+	if !ok {
+		return emailEntry{}, fmt.Errorf("unknown email kind %q", data.Kind)
+	}
+
+	text, err := renderText(language, line[language], data)
+	if err != nil {
+		return emailEntry{}, err
+	}
+
+	t, err := htmltemplate.New("").Funcs(emailFuncs(language)).Parse(line[language])
+	if err != nil {
+		return emailEntry{}, err
+	}
+	var html bytes.Buffer
+	if err := t.Execute(&html, data); err != nil {
+		return emailEntry{}, err
+	}
+
+	return emailEntry{Text: text, HTML: htmltemplate.HTML(html.String()), URL: data.WishlistURL, CancelURL: data.CancelURL}, nil
+}
+
+// renderEmail renders one email about all events; a single event gets its
+// own subject, several become a digest. Without unsubscribeURL the footer is
+// left out, which is only right for transactional emails like receipts.
+func renderEmail(language wishlistgen.TemplateLanguage, to string, events []emailData, unsubscribeURL string) (emailMessage, error) {
+	if !isSupportedLanguage(language) {
+		language = wishlistgen.Ru
+	}
+
+	subject := emailDigestSubject[language]
+	if len(events) == 1 {
+		var err error
+		if subject, err = renderText(language, emailSubjects[events[0].Kind][language], events[0]); err != nil {
+			return emailMessage{}, err
+		}
+	}
+
+	layout := emailLayoutData{
+		Heading:          subject,
+		OpenLabel:        emailOpenLabel[language],
+		CancelLabel:      emailCancelLabel[language],
+		UnsubscribeLabel: emailUnsubscribeLabel[language],
+		UnsubscribeURL:   unsubscribeURL,
+	}
+	for _, event := range events {
+		entry, err := renderEmailEntry(language, event)
+		if err != nil {
+			return emailMessage{}, err
+		}
+		layout.Entries = append(layout.Entries, entry)
+	}
+
+	var text, html bytes.Buffer
+	if err := emailTextLayout.Execute(&text, layout); err != nil {
+		return emailMessage{}, err
+	}
+	if err := emailHTMLLayout.Execute(&html, layout); err != nil {
+		return emailMessage{}, err
+	}
+
+	return emailMessage{
+		To:             to,
+		Subject:        subject,
+		Text:           text.String(),
+		HTML:           html.String(),
+		UnsubscribeURL: unsubscribeURL,
+	}, nil
+}
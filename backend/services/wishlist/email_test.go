@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestBuildEmail(t *testing.T) {
+	from := mail.Address{Name: "Wili", Address: "noreply@wili.me"}
+	data, err := buildEmail(from, emailMessage{
+		To:             "anna@example.com",
+		Subject:        "Забронировали подарок",
+		Text:           "Привет",
+		HTML:           "<p>Привет</p>",
+		UnsubscribeURL: "https://api.wili.me/notifications/email/unsubscribe?token=abc",
+	}, time.Now())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("Expected a parseable message, got %v", err)
+	}
+	subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if subject != "Забронировали подарок" {
+		t.Errorf("Expected decoded subject, got %q", subject)
+	}
+	if msg.Header.Get("List-Unsubscribe") != "<https://api.wili.me/notifications/email/unsubscribe?token=abc>" {
+		t.Errorf("Unexpected List-Unsubscribe: %q", msg.Header.Get("List-Unsubscribe"))
+	}
+	if !strings.HasSuffix(msg.Header.Get("Message-ID"), "@wili.me>") {
+		t.Errorf("Unexpected Message-ID: %q", msg.Header.Get("Message-ID"))
+	}
+
+	mediaType, params, _ := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if mediaType != "multipart/alternative" {
+		t.Fatalf("Expected multipart/alternative, got %q", mediaType)
+	}
+	reader := multipart.NewReader(msg.Body, params["boundary"])
+	for _, expected := range []string{"Привет", "<p>Привет</p>"} {
+		part, err := reader.NextPart()
+		if err != nil {
+			t.Fatalf("Expected part %q, got %v", expected, err)
+		}
+		body, _ := io.ReadAll(part)
+		if string(body) != expected {
+			t.Errorf("Expected part %q, got %q", expected, body)
+		}
+	}
+}
+
+func TestRenderEmail(t *testing.T) {
+	booked := emailData{
+		Kind:          emailKindItemBooked,
+		WishlistTitle: "Birthday",
+		WishlistURL:   "https://wili.me/wishlists/1",
+		ItemName:      "<b>Kettle</b>",
+		BookerName:    "Anna",
+	}
+
+	t.Run("single_event", func(t *testing.T) {
+		msg, err := renderEmail(wishlistgen.En, "owner@example.com", []emailData{booked}, "https://unsubscribe")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if msg.Subject != "A gift from «Birthday» has been booked" {
+			t.Errorf("Unexpected subject: %q", msg.Subject)
+		}
+		if !strings.Contains(msg.Text, "«<b>Kettle</b>» from «Birthday» has been booked by Anna.") {
+			t.Errorf("Expected booking line in text, got %q", msg.Text)
+		}
+		if strings.Contains(msg.HTML, "<b>Kettle</b>") || !strings.Contains(msg.HTML, "&lt;b&gt;Kettle&lt;/b&gt;") {
+			t.Errorf("Expected escaped item name in HTML, got %q", msg.HTML)
+		}
+		if !strings.Contains(msg.HTML, `href="https://unsubscribe"`) {
+			t.Errorf("Expected unsubscribe link in HTML, got %q", msg.HTML)
+		}
+	})
+
+	t.Run("surprise", func(t *testing.T) {
+		surprise := booked
+		surprise.ItemName, surprise.BookerName = "", ""
+		msg, err := renderEmail(wishlistgen.Ru, "owner@example.com", []emailData{surprise}, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(msg.Text, "Какой — пусть будет сюрпризом!") || strings.Contains(msg.Text, "Anna") {
+			t.Errorf("Expected surprise line, got %q", msg.Text)
+		}
+	})
+
+	t.Run("digest", func(t *testing.T) {
+		soon := emailData{Kind: emailKindEventSoon, WishlistTitle: "Birthday", DaysLeft: 3}
+		msg, err := renderEmail(wishlistgen.Ru, "owner@example.com", []emailData{booked, soon}, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if msg.Subject != emailDigestSubject[wishlistgen.Ru] {
+			t.Errorf("Expected digest subject, got %q", msg.Subject)
+		}
+		if !strings.Contains(msg.Text, "осталось 3 дня") {
+			t.Errorf("Expected reminder line, got %q", msg.Text)
+		}
+	})
+
+	t.Run("unknown_language_falls_back", func(t *testing.T) {
+		msg, err := renderEmail("de", "owner@example.com", []emailData{booked}, "")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if msg.Subject != "Забронировали подарок из «Birthday»" {
+			t.Errorf("Expected Russian subject, got %q", msg.Subject)
+		}
+	})
+}
+
+func TestRenderBookingReceipt(t *testing.T) {
+	receipt := emailData{
+		Kind:          emailKindBookingReceipt,
+		WishlistTitle: "Birthday",
+		WishlistURL:   "https://wili.me/wishlists/1",
+		ItemName:      "Kettle",
+		CancelURL:     "https://api.wili.me/wishlists/1/items/2/booking/cancel?cancellationToken=3",
+	}
+
+	msg, err := renderEmail(wishlistgen.En, "anna@example.com", []emailData{receipt}, "")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if msg.Subject != "You booked «Kettle»" {
+		t.Errorf("Unexpected subject: %q", msg.Subject)
+	}
+	if !strings.Contains(msg.Text, "Cancel booking: "+receipt.CancelURL) {
+		t.Errorf("Expected cancel link in text, got %q", msg.Text)
+	}
+	if !strings.Contains(msg.HTML, "booking/cancel?cancellationToken=3") {
+		t.Errorf("Expected cancel link in HTML, got %q", msg.HTML)
+	}
+	if strings.Contains(msg.Text, emailUnsubscribeLabel[wishlistgen.En]) || strings.Contains(msg.HTML, emailUnsubscribeLabel[wishlistgen.En]) {
+		t.Errorf("Expected no unsubscribe footer in a receipt")
+	}
+}
+
+func TestPluralDays(t *testing.T) {
+	tests := []struct {
+		language wishlistgen.TemplateLanguage
+		n        int
+		expected string
+	}{
+		{wishlistgen.Ru, 1, "1 день"},
+		{wishlistgen.Ru, 3, "3 дня"},
+		{wishlistgen.Ru, 5, "5 дней"},
+		{wishlistgen.Ru, 11, "11 дней"},
+		{wishlistgen.Ru, 21, "21 день"},
+		{wishlistgen.Ru, 112, "112 дней"},
+		{wishlistgen.En, 1, "1 day"},
+		{wishlistgen.En, 7, "7 days"},
+	}
+
+	for _, tt := range tests {
+		if got := pluralDays(tt.language, tt.n); got != tt.expected {
+			t.Errorf("Expected %q for %d (%s), got %q", tt.expected, tt.n, tt.language, got)
+		}
+	}
+}
+
+func TestUnsubscribeSigner(t *testing.T) {
+	signer := unsubscribeSigner{key: []byte("secret")}
+	token := signer.Token("user-1", "bookings")
+
+	userID, category, ok := signer.Parse(token)
+	if !ok || userID != "user-1" || category != "bookings" {
+		t.Fatalf("Expected round trip, got %q %q %v", userID, category, ok)
+	}
+
+	forged := unsubscribeSigner{key: []byte("other")}.Token("user-2", "bookings")
+	for _, bad := range []string{"", "garbage", forged, token + "x"} {
+		if _, _, ok := signer.Parse(bad); ok {
+			t.Errorf("Expected %q to be rejected", bad)
+		}
+	}
+
+	if _, _, ok := (unsubscribeSigner{}).Parse(unsubscribeSigner{}.Token("user-1", "all")); ok {
+		t.Error("Expected an empty key to reject every token")
+	}
+}
+
+func TestSMTPMailerSendsToStandin(t *testing.T) {
+	standin, err := startSMTPStandin("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start stand-in: %v", err)
+	}
+	defer standin.Close()
+
+	host, portStr, _ := strings.Cut(standin.Addr(), ":")
+	port, _ := strconv.Atoi(portStr)
+	m, err := newSMTPMailer(host, port, "", "", "Wili <noreply@wili.me>")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	msg := emailMessage{To: "anna@example.com", Subject: "Hello", Text: "Hi", HTML: "<p>Hi</p>"}
+	if err := m.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	received := standin.Messages()
+	if len(received) != 1 {
+		t.Fatalf("Expected 1 message, got %d", len(received))
+	}
+	if received[0].From != "noreply@wili.me" || len(received[0].To) != 1 || received[0].To[0] != "anna@example.com" {
+		t.Errorf("Unexpected envelope: %+v", received[0])
+	}
+	if !strings.Contains(received[0].Data, "Subject: Hello") {
+		t.Errorf("Expected subject in data, got %q", received[0].Data)
+	}
+}
+
+func TestEmailDataOf(t *testing.T) {
+	event := testBookingEvent("book_item", wishlistgen.Surprise)
+	data := emailDataOf(ownerNotificationOf(event), "https://wili.me/wishlists/"+event.WishlistID)
+	if data.Kind != emailKindItemBooked || data.ItemName != "" || data.BookerName != "" {
+		t.Errorf("Expected surprise booking without details, got %+v", data)
+	}
+
+	data = emailDataOf(ownerNotificationOf(testBookingEvent("unbook_item", wishlistgen.Visible)), "")
+	if data.Kind != emailKindItemUnbooked || data.ItemName != "Kettle" {
+		t.Errorf("Expected unbooking of Kettle, got %+v", data)
+	}
+}
+
+func TestValidateUpdateEmailPreferencesRequest(t *testing.T) {
+	language := wishlistgen.TemplateLanguage("de")
+	delivery := wishlistgen.EmailDelivery("weekly")
+	categories := []wishlistgen.EmailCategory{wishlistgen.Bookings, "news"}
+	digest := wishlistgen.Digest
+
+	tests := []struct {
+		name     string
+		req      wishlistgen.UpdateEmailPreferencesRequest
+		expected int
+	}{
+		{"empty", wishlistgen.UpdateEmailPreferencesRequest{}, 0},
+		{"digest", wishlistgen.UpdateEmailPreferencesRequest{Delivery: &digest}, 0},
+		{"invalid", wishlistgen.UpdateEmailPreferencesRequest{Language: &language, Delivery: &delivery, Unsubscribed: &categories}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := ValidateUpdateEmailPreferencesRequest(tt.req); len(errs) != tt.expected {
+				t.Errorf("Expected %d errors, got %d: %v", tt.expected, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestEmailPreferencesRequireAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+
+	rec := httptest.NewRecorder()
+	s.GetNotificationsEmailPreferences(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.PutNotificationsEmailPreferences(rec, httptest.NewRequest(http.MethodPut, "/", strings.NewReader(`{}`)))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
+
+func TestUnsubscribeRejectsInvalidToken(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	s.unsubscribeSigner = unsubscribeSigner{key: []byte("secret")}
+	forged := unsubscribeSigner{key: []byte("other")}.Token(uuid.New().String(), "all")
+
+	rec := httptest.NewRecorder()
+	s.GetNotificationsEmailUnsubscribe(rec, httptest.NewRequest(http.MethodGet, "/", nil), wishlistgen.GetNotificationsEmailUnsubscribeParams{Token: forged})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	s.PostNotificationsEmailUnsubscribe(rec, httptest.NewRequest(http.MethodPost, "/", nil), wishlistgen.PostNotificationsEmailUnsubscribeParams{Token: "garbage"})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// formatETag renders a wishlist version as a strong entity tag
+func formatETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// parseETag extracts the wishlist version from an entity tag, ignoring a weak prefix
+func parseETag(tag string) (int64, bool) {
+	tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+	if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version < 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// ifMatchVersions converts an If-Match header into the wishlist versions a write may
+// apply to. nil means the write is unconditional; an empty slice matches nothing.
+func ifMatchVersions(header *string) []int64 {
+	if header == nil || strings.TrimSpace(*header) == "" || strings.TrimSpace(*header) == "*" {
+		return nil
+	}
+
+	versions := []int64{}
+	for _, tag := range strings.Split(*header, ",") {
+		tag = strings.TrimSpace(tag)
+		// If-Match uses strong comparison, so weak tags never match
+		if strings.HasPrefix(tag, "W/") {
+			continue
+		}
+		if version, ok := parseETag(tag); ok {
+			versions = append(versions, version)
+		}
+	}
+	return versions
+}
+
+// ifNoneMatchHit reports whether an If-None-Match header already names the given version
+func ifNoneMatchHit(header *string, version int64) bool {
+	if header == nil {
+		return false
+	}
+	if strings.TrimSpace(*header) == "*" {
+		return true
+	}
+	for _, tag := range strings.Split(*header, ",") {
+		if v, ok := parseETag(tag); ok && v == version {
+			return true
+		}
+	}
+	return false
+}
+
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", formatETag(version))
+}
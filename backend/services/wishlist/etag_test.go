@@ -0,0 +1,91 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseETag(t *testing.T) {
+	tests := []struct {
+		name      string
+		tag       string
+		expected  int64
+		expectsOK bool
+	}{
+		{name: "strong_tag", tag: `"7"`, expected: 7, expectsOK: true},
+		{name: "weak_tag", tag: `W/"7"`, expected: 7, expectsOK: true},
+		{name: "surrounding_spaces", tag: ` "12" `, expected: 12, expectsOK: true},
+		{name: "unquoted", tag: `7`, expectsOK: false},
+		{name: "not_a_number", tag: `"abc"`, expectsOK: false},
+		{name: "negative", tag: `"-1"`, expectsOK: false},
+		{name: "empty", tag: ``, expectsOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, ok := parseETag(tt.tag)
+			if ok != tt.expectsOK {
+				t.Fatalf("Expected ok=%v, got %v", tt.expectsOK, ok)
+			}
+			if ok && version != tt.expected {
+				t.Errorf("Expected version %d, got %d", tt.expected, version)
+			}
+		})
+	}
+}
+
+func TestFormatETagRoundTrip(t *testing.T) {
+	version, ok := parseETag(formatETag(42))
+	if !ok || version != 42 {
+		t.Fatalf("Expected round trip to 42, got %d (ok=%v)", version, ok)
+	}
+}
+
+func TestIfMatchVersions(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   *string
+		expected []int64
+	}{
+		{name: "absent", header: nil, expected: nil},
+		{name: "blank", header: stringPtr("  "), expected: nil},
+		{name: "wildcard", header: stringPtr("*"), expected: nil},
+		{name: "single", header: stringPtr(`"3"`), expected: []int64{3}},
+		{name: "list", header: stringPtr(`"3", "4"`), expected: []int64{3, 4}},
+		{name: "weak_tags_never_match", header: stringPtr(`W/"3"`), expected: []int64{}},
+		{name: "garbage_matches_nothing", header: stringPtr(`foo`), expected: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ifMatchVersions(tt.header)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %#v, got %#v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIfNoneMatchHit(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   *string
+		version  int64
+		expected bool
+	}{
+		{name: "absent", header: nil, version: 1, expected: false},
+		{name: "wildcard", header: stringPtr("*"), version: 1, expected: true},
+		{name: "current", header: stringPtr(`"5"`), version: 5, expected: true},
+		{name: "weak_current", header: stringPtr(`W/"5"`), version: 5, expected: true},
+		{name: "stale", header: stringPtr(`"4"`), version: 5, expected: false},
+		{name: "list_with_current", header: stringPtr(`"4", "5"`), version: 5, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ifNoneMatchHit(tt.header, tt.version); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
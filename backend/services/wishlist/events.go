@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	sseHeartbeatInterval   = 15 * time.Second
+	sseRetryDelay          = 3 * time.Second
+	changeStreamRetryDelay = 5 * time.Second
+)
+
+// Event names sent on a wishlist stream
+const (
+	streamEventWishlist = "wishlist"
+	streamEventDeleted  = "deleted"
+)
+
+// wishlistChange is the state of a wishlist right after a write, as guests see it
+type wishlistChange struct {
+	WishlistID string
+	Version    int64
+	Deleted    bool
+	Wishlist   *wishlistgen.Wishlist
+}
+
+// eventBus fans wishlist changes out to the streams watching them. A slow
+// subscriber only misses intermediate states: every change carries the whole
+// wishlist, so delivering the latest one is enough.
+type eventBus struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan wishlistChange]struct{}
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subscribers: make(map[string]map[chan wishlistChange]struct{})}
+}
+
+// Subscribe returns the changes of a wishlist and a function to stop receiving them
+func (b *eventBus) Subscribe(wishlistID string) (<-chan wishlistChange, func()) {
+	ch := make(chan wishlistChange, 1)
+
+	b.mu.Lock()
+	if b.subscribers[wishlistID] == nil {
+		b.subscribers[wishlistID] = make(map[chan wishlistChange]struct{})
+	}
+	b.subscribers[wishlistID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.subscribers[wishlistID], ch)
+			if len(b.subscribers[wishlistID]) == 0 {
+				delete(b.subscribers, wishlistID)
+			}
+			close(ch)
+		})
+	}
+}
+
+// Publish hands change to every subscriber of its wishlist, replacing a
+// change the subscriber has not picked up yet.
+func (b *eventBus) Publish(change wishlistChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[change.WishlistID] {
+		select {
+		case ch <- change:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- change
+		}
+	}
+}
+
+func (r *MongoRepo) publishChange(mw *mongoWishlist) {
+	change := wishlistChange{
+		WishlistID: mw.UUID,
+		Version:    mw.Version,
+		Deleted:    mw.DeletedAt != nil,
+	}
+	if !change.Deleted {
+		wishlist := r.convertToAPIWishlist(*mw)
+		change.Wishlist = &wishlist
+	}
+	r.events.Publish(change)
+}
+
+// afterWrite runs the side effects of a committed write: the activity record
+// of its revision, and notifying streams unless a change stream does that.
+func (r *MongoRepo) afterWrite(ctx context.Context, mw *mongoWishlist, rec revisionRecord, actorID *openapi_types.UUID, action string) {
+	r.recordActivity(ctx, mw, rec.prev, rec.changes, actorID, action)
+	if !r.changeStream {
+		r.publishChange(mw)
+	}
+}
+
+// UseChangeStream makes streams follow the MongoDB change stream of the
+// wishlists collection instead of writes made by this instance, so that
+// subscribers see changes made through any replica. It needs a replica set.
+func (r *MongoRepo) UseChangeStream(ctx context.Context) {
+	r.changeStream = true
+	go r.watchChanges(ctx)
+}
+
+func (r *MongoRepo) watchChanges(ctx context.Context) {
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: bson.M{
+		"operationType": bson.M{"$in": []string{"insert", "update", "replace"}},
+	}}}}
+
+	var resumeToken bson.Raw
+	for ctx.Err() == nil {
+		opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+		if resumeToken != nil {
+			opts.SetResumeAfter(resumeToken)
+		}
+
+		stream, err := r.wishlists.Watch(ctx, pipeline, opts)
+		if err != nil {
+			r.logger.LogError(ctx, nil, "watch_changes", err, "failed to open change stream")
+		} else {
+			for stream.Next(ctx) {
+				var event struct {
+					FullDocument *mongoWishlist `bson:"fullDocument"`
+				}
+				resumeToken = stream.ResumeToken()
+				if err := stream.Decode(&event); err != nil {
+					r.logger.LogError(ctx, nil, "watch_changes", err, "failed to decode change event")
+					continue
+				}
+				if event.FullDocument != nil {
+					r.publishChange(event.FullDocument)
+				}
+			}
+			if err := stream.Err(); err != nil && ctx.Err() == nil {
+				r.logger.LogError(ctx, nil, "watch_changes", err, "change stream interrupted")
+			}
+			stream.Close(context.Background())
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(changeStreamRetryDelay):
+		}
+	}
+}
+
+// writeStreamEvent writes change as one SSE message
+func writeStreamEvent(w io.Writer, change wishlistChange) error {
+	event := streamEventWishlist
+	var data interface{} = change.Wishlist
+	if change.Deleted {
+		event = streamEventDeleted
+		data = map[string]interface{}{"id": change.WishlistID, "version": change.Version}
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", change.Version, event, payload)
+	return err
+}
+
+// streamChanges sends current unless the client already has it, then every
+// newer change, until the client goes away or the wishlist is deleted.
+func streamChanges(ctx context.Context, w io.Writer, flusher http.Flusher, changes <-chan wishlistChange, current wishlistChange, lastEventID int64, heartbeat time.Duration) error {
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", sseRetryDelay.Milliseconds()); err != nil {
+		return err
+	}
+	if current.Version != lastEventID {
+		if err := writeStreamEvent(w, current); err != nil {
+			return err
+		}
+	}
+	flusher.Flush()
+	lastSent := current.Version
+
+	ticker := time.NewTicker(heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+		case change, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if change.Version <= lastSent {
+				continue
+			}
+			if err := writeStreamEvent(w, change); err != nil {
+				return err
+			}
+			lastSent = change.Version
+			if change.Deleted {
+				flusher.Flush()
+				return nil
+			}
+		}
+		flusher.Flush()
+	}
+}
+
+// Stream wishlist updates as Server-Sent Events (public endpoint)
+func (s *WishlistServer) GetWishlistsWishlistIdEvents(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdEventsParams) {
+	s.logger.LogRequest(r, nil, "stream_events")
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		s.logger.LogError(r.Context(), nil, "stream_events", fmt.Errorf("response writer does not support flushing"), "cannot stream events")
+		s.writeError(w, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	// Subscribe before loading so that no change falls in between
+	changes, unsubscribe := s.repo.events.Subscribe(wishlistId.String())
+	defer unsubscribe()
+
+	wishlist, err := s.repo.GetWishlistByID(r.Context(), wishlistId)
+	if err != nil {
+		s.logger.LogError(r.Context(), nil, "stream_events", err, fmt.Sprintf("failed to retrieve wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve wishlist")
+		return
+	}
+	if wishlist == nil {
+		s.logger.LogNotFound(r.Context(), nil, "wishlist", wishlistId.String())
+		s.writeError(w, http.StatusNotFound, "Wishlist not found")
+		return
+	}
+
+	var lastEventID int64
+	if params.LastEventID != nil {
+		// An unparsable id just means the client gets the current state again
+		lastEventID, _ = strconv.ParseInt(strings.TrimSpace(*params.LastEventID), 10, 64)
+	}
+
+	// Streams outlive the server's write timeout
+	if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		s.logger.LogError(r.Context(), nil, "stream_events", err, "failed to lift the write deadline")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	s.logger.LogSuccess(r.Context(), nil, "stream_events", fmt.Sprintf("streaming wishlist %s from version %d", wishlistId.String(), wishlist.Version))
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	go func() {
+		select {
+		case <-s.streamsClosed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	current := wishlistChange{WishlistID: wishlistId.String(), Version: wishlist.Version, Wishlist: wishlist}
+	if err := streamChanges(ctx, w, flusher, changes, current, lastEventID, sseHeartbeatInterval); err != nil {
+		s.logger.LogError(r.Context(), nil, "stream_events", err, fmt.Sprintf("stream of wishlist %s ended", wishlistId.String()))
+	}
+}
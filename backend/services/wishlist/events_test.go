@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func testChange(wishlistID string, version int64) wishlistChange {
+	return wishlistChange{
+		WishlistID: wishlistID,
+		Version:    version,
+		Wishlist:   &wishlistgen.Wishlist{Title: "Birthday", Version: version, Items: []wishlistgen.WishlistItem{}},
+	}
+}
+
+func TestEventBusDeliversToSubscribersOfWishlist(t *testing.T) {
+	bus := newEventBus()
+	first, unsubscribeFirst := bus.Subscribe("a")
+	defer unsubscribeFirst()
+	other, unsubscribeOther := bus.Subscribe("b")
+	defer unsubscribeOther()
+
+	bus.Publish(testChange("a", 2))
+
+	select {
+	case change := <-first:
+		if change.Version != 2 {
+			t.Errorf("Expected version 2, got %d", change.Version)
+		}
+	default:
+		t.Fatal("Expected subscriber of wishlist a to receive the change")
+	}
+
+	select {
+	case change := <-other:
+		t.Errorf("Expected no change for wishlist b, got %+v", change)
+	default:
+	}
+}
+
+func TestEventBusCoalescesPendingChanges(t *testing.T) {
+	bus := newEventBus()
+	changes, unsubscribe := bus.Subscribe("a")
+	defer unsubscribe()
+
+	for version := int64(2); version <= 5; version++ {
+		bus.Publish(testChange("a", version))
+	}
+
+	if change := <-changes; change.Version != 5 {
+		t.Errorf("Expected only the latest version 5, got %d", change.Version)
+	}
+}
+
+func TestEventBusUnsubscribe(t *testing.T) {
+	bus := newEventBus()
+	changes, unsubscribe := bus.Subscribe("a")
+	unsubscribe()
+	unsubscribe()
+
+	bus.Publish(testChange("a", 2))
+
+	if _, ok := <-changes; ok {
+		t.Error("Expected channel to be closed after unsubscribing")
+	}
+	if len(bus.subscribers) != 0 {
+		t.Errorf("Expected no subscribers left, got %d", len(bus.subscribers))
+	}
+}
+
+func TestStreamChanges(t *testing.T) {
+	wishlistID := uuid.New().String()
+
+	tests := []struct {
+		name        string
+		lastEventID int64
+		changes     []wishlistChange
+		expectedIDs []string
+	}{
+		{
+			name:        "initial_state_then_changes",
+			changes:     []wishlistChange{testChange(wishlistID, 4), {WishlistID: wishlistID, Version: 5, Deleted: true}},
+			expectedIDs: []string{"id: 3", "id: 4", "id: 5"},
+		},
+		{
+			name:        "resume_without_missed_changes",
+			lastEventID: 3,
+			changes:     []wishlistChange{{WishlistID: wishlistID, Version: 4, Deleted: true}},
+			expectedIDs: []string{"id: 4"},
+		},
+		{
+			name:        "stale_changes_skipped",
+			changes:     []wishlistChange{testChange(wishlistID, 2), testChange(wishlistID, 3), {WishlistID: wishlistID, Version: 4, Deleted: true}},
+			expectedIDs: []string{"id: 3", "id: 4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := make(chan wishlistChange, len(tt.changes))
+			for _, change := range tt.changes {
+				changes <- change
+			}
+
+			rec := httptest.NewRecorder()
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			if err := streamChanges(ctx, rec, rec, changes, testChange(wishlistID, 3), tt.lastEventID, time.Hour); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if ctx.Err() != nil {
+				t.Fatal("Expected stream to end after the deleted event")
+			}
+
+			body := rec.Body.String()
+			var ids []string
+			for _, line := range strings.Split(body, "\n") {
+				if strings.HasPrefix(line, "id: ") {
+					ids = append(ids, line)
+				}
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.expectedIDs, ",") {
+				t.Errorf("Expected events %v, got %v", tt.expectedIDs, ids)
+			}
+			if !strings.HasSuffix(body, "event: deleted\ndata: {\"id\":\""+wishlistID+"\",\"version\":"+strings.TrimPrefix(tt.expectedIDs[len(tt.expectedIDs)-1], "id: ")+"}\n\n") {
+				t.Errorf("Expected stream to end with a deleted event, got %q", body)
+			}
+		})
+	}
+}
+
+func TestStreamChangesHeartbeat(t *testing.T) {
+	rec := httptest.NewRecorder()
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := streamChanges(ctx, rec, rec, make(chan wishlistChange), testChange("a", 1), 1, 5*time.Millisecond); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	body := rec.Body.String()
+	if strings.Contains(body, "event: wishlist") {
+		t.Errorf("Expected no initial event when Last-Event-ID is current, got %q", body)
+	}
+	if !strings.Contains(body, ": heartbeat\n\n") {
+		t.Errorf("Expected heartbeats, got %q", body)
+	}
+}
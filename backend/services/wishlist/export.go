@@ -0,0 +1,260 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const defaultItemType = "general"
+
+// Item data properties that portable documents carry as dedicated fields
+const (
+	itemPriceProperty    = "price"
+	itemCurrencyProperty = "currency"
+	itemSectionProperty  = "section"
+)
+
+var csvColumns = []string{"section", "name", "description", "url", "price", "currency", "type", "booked"}
+
+// toPortable converts a wishlist into its portable form, dropping booking details
+func toPortable(wishlist wishlistgen.Wishlist, exportedAt time.Time) wishlistgen.PortableWishlist {
+	doc := wishlistgen.PortableWishlist{
+		Title:      wishlist.Title,
+		ExportedAt: &exportedAt,
+		Items:      make([]wishlistgen.PortableItem, 0, len(wishlist.Items)),
+	}
+	if wishlist.Description != nil && *wishlist.Description != "" {
+		doc.Description = wishlist.Description
+	}
+
+	for _, item := range wishlist.Items {
+		itemType := item.Type
+		booked := item.Booking != nil
+		portable := wishlistgen.PortableItem{
+			Type:        &itemType,
+			Name:        item.Data.Name,
+			Description: item.Data.Description,
+			Url:         item.Data.Url,
+			Booked:      &booked,
+		}
+		if price, ok := numberProperty(item.Data.AdditionalProperties[itemPriceProperty]); ok {
+			portable.Price = &price
+		}
+		if currency, ok := item.Data.AdditionalProperties[itemCurrencyProperty].(string); ok && currency != "" {
+			portable.Currency = &currency
+		}
+		if section, ok := item.Data.AdditionalProperties[itemSectionProperty].(string); ok && section != "" {
+			portable.Section = &section
+		}
+		doc.Items = append(doc.Items, portable)
+	}
+
+	return doc
+}
+
+// numberProperty reads a numeric item property as stored by MongoDB or sent by clients
+func numberProperty(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func formatPrice(price float64) string {
+	return strconv.FormatFloat(price, 'f', -1, 64)
+}
+
+func renderCSV(doc wishlistgen.PortableWishlist) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write(csvColumns); err != nil {
+		return nil, err
+	}
+	for _, item := range doc.Items {
+		price := ""
+		if item.Price != nil {
+			price = formatPrice(*item.Price)
+		}
+		booked := ""
+		if item.Booked != nil && *item.Booked {
+			booked = "yes"
+		}
+		record := []string{
+			deref(item.Section), item.Name, deref(item.Description), deref(item.Url),
+			price, deref(item.Currency), deref(item.Type), booked,
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
+
+// renderMarkdown writes a task list grouped by section; booked items are checked
+func renderMarkdown(doc wishlistgen.PortableWishlist) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# %s\n", doc.Title)
+	if doc.Description != nil {
+		fmt.Fprintf(&buf, "\n%s\n", *doc.Description)
+	}
+
+	var sections []string
+	bySection := map[string][]wishlistgen.PortableItem{}
+	for _, item := range doc.Items {
+		section := deref(item.Section)
+		if _, ok := bySection[section]; !ok {
+			sections = append(sections, section)
+		}
+		bySection[section] = append(bySection[section], item)
+	}
+
+	// Items without a section come first so they are not mistaken for part of a section
+	if items := bySection[""]; len(items) > 0 {
+		buf.WriteString("\n")
+		for _, item := range items {
+			buf.WriteString(markdownItem(item))
+		}
+	}
+	for _, section := range sections {
+		if section == "" {
+			continue
+		}
+		fmt.Fprintf(&buf, "\n## %s\n\n", section)
+		for _, item := range bySection[section] {
+			buf.WriteString(markdownItem(item))
+		}
+	}
+
+	return buf.Bytes()
+}
+
+func markdownItem(item wishlistgen.PortableItem) string {
+	var line strings.Builder
+	if item.Booked != nil && *item.Booked {
+		line.WriteString("- [x] ")
+	} else {
+		line.WriteString("- [ ] ")
+	}
+
+	if item.Url != nil && *item.Url != "" {
+		fmt.Fprintf(&line, "[%s](%s)", escapeMarkdownLinkText(item.Name), *item.Url)
+	} else {
+		line.WriteString(item.Name)
+	}
+
+	if item.Price != nil {
+		line.WriteString(" — " + formatPrice(*item.Price))
+		if item.Currency != nil {
+			line.WriteString(" " + *item.Currency)
+		}
+	}
+	line.WriteString("\n")
+
+	if item.Description != nil && *item.Description != "" {
+		for _, descriptionLine := range strings.Split(*item.Description, "\n") {
+			line.WriteString("  " + descriptionLine + "\n")
+		}
+	}
+	return line.String()
+}
+
+var markdownLinkTextEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
+
+func escapeMarkdownLinkText(text string) string {
+	return markdownLinkTextEscaper.Replace(text)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// Export a wishlist as a portable document (public endpoint)
+func (s *WishlistServer) GetWishlistsWishlistIdExport(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdExportParams) {
+	s.logger.LogRequest(r, nil, "export_wishlist")
+
+	format := wishlistgen.GetWishlistsWishlistIdExportParamsFormatJson
+	if params.Format != nil {
+		format = *params.Format
+	}
+	switch format {
+	case wishlistgen.GetWishlistsWishlistIdExportParamsFormatJson,
+		wishlistgen.GetWishlistsWishlistIdExportParamsFormatCsv,
+		wishlistgen.GetWishlistsWishlistIdExportParamsFormatMd:
+	default:
+		s.logger.LogBadRequest(r.Context(), nil, "export_wishlist", fmt.Sprintf("unsupported format %q", format))
+		s.writeError(w, http.StatusBadRequest, "Unsupported format")
+		return
+	}
+
+	wishlist, err := s.repo.GetWishlistByID(r.Context(), wishlistId)
+	if err != nil {
+		s.logger.LogError(r.Context(), nil, "export_wishlist", err, fmt.Sprintf("failed to retrieve wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve wishlist")
+		return
+	}
+	if wishlist == nil {
+		s.logger.LogNotFound(r.Context(), nil, "wishlist", wishlistId.String())
+		s.writeError(w, http.StatusNotFound, "Wishlist not found")
+		return
+	}
+
+	doc := toPortable(*wishlist, time.Now().UTC())
+
+	if format == wishlistgen.GetWishlistsWishlistIdExportParamsFormatJson {
+		s.logger.LogSuccess(r.Context(), nil, "export_wishlist", fmt.Sprintf("exported wishlist %s as json", wishlistId.String()))
+		w.Header().Set("Content-Disposition", `attachment; filename="wishlist.json"`)
+		s.writeJSON(w, http.StatusOK, doc)
+		return
+	}
+
+	var (
+		body        []byte
+		contentType string
+	)
+	switch format {
+	case wishlistgen.GetWishlistsWishlistIdExportParamsFormatCsv:
+		body, err = renderCSV(doc)
+		if err != nil {
+			s.logger.LogError(r.Context(), nil, "export_wishlist", err, fmt.Sprintf("failed to render wishlist %s as csv", wishlistId.String()))
+			s.writeError(w, http.StatusInternalServerError, "Failed to export wishlist")
+			return
+		}
+		contentType = "text/csv; charset=utf-8"
+	case wishlistgen.GetWishlistsWishlistIdExportParamsFormatMd:
+		body = renderMarkdown(doc)
+		contentType = "text/markdown; charset=utf-8"
+	}
+
+	s.logger.LogSuccess(r.Context(), nil, "export_wishlist", fmt.Sprintf("exported wishlist %s as %s", wishlistId.String(), format))
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="wishlist.%s"`, format))
+	w.WriteHeader(http.StatusOK)
+	w.Write(body)
+}
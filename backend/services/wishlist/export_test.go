@@ -0,0 +1,125 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func exportFixture() wishlistgen.Wishlist {
+	description := "For my birthday"
+	itemDescription := "Hardcover\nSigned if possible"
+	url := "https://shop.example/book"
+	return wishlistgen.Wishlist{
+		Title:       "Birthday [2026]",
+		Description: &description,
+		Items: []wishlistgen.WishlistItem{
+			{
+				Type: "general",
+				Data: wishlistgen.WishlistItemData{
+					Name:        "Book [2nd ed.]",
+					Description: &itemDescription,
+					Url:         &url,
+					AdditionalProperties: map[string]interface{}{
+						"price": int32(25), "currency": "EUR", "section": "Reading",
+					},
+				},
+				Booking: &wishlistgen.ItemBooking{BookingId: uuid.New(), BookerName: stringPtr("Anna")},
+			},
+			{
+				Type: "general",
+				Data: wishlistgen.WishlistItemData{
+					Name:                 "Socks",
+					AdditionalProperties: map[string]interface{}{"price": 9.5},
+				},
+			},
+		},
+	}
+}
+
+func TestToPortable(t *testing.T) {
+	doc := toPortable(exportFixture(), time.Now())
+
+	book := doc.Items[0]
+	if book.Price == nil || *book.Price != 25 || deref(book.Currency) != "EUR" || deref(book.Section) != "Reading" {
+		t.Errorf("Expected price, currency and section from item data, got %+v", book)
+	}
+	if book.Booked == nil || !*book.Booked {
+		t.Error("Expected booked flag to be exported")
+	}
+	if doc.Items[1].Booked == nil || *doc.Items[1].Booked {
+		t.Error("Expected unbooked item to be exported as not booked")
+	}
+}
+
+func TestMarkdownRoundTrip(t *testing.T) {
+	doc := toPortable(exportFixture(), time.Now())
+	rendered := string(renderMarkdown(doc))
+
+	if strings.Contains(rendered, "Anna") {
+		t.Fatal("Expected booker details not to be exported")
+	}
+	if !strings.Contains(rendered, `- [x] [Book \[2nd ed.\]](https://shop.example/book) — 25 EUR`) {
+		t.Errorf("Unexpected markdown:\n%s", rendered)
+	}
+
+	parsed := parseMarkdownImport([]byte(rendered))
+	if len(parsed.errors) > 0 {
+		t.Fatalf("Unexpected row errors: %+v", parsed.errors)
+	}
+	if parsed.wishlist.Title != doc.Title || deref(parsed.wishlist.Description) != deref(doc.Description) {
+		t.Errorf("Expected title and description to survive, got %q / %q", parsed.wishlist.Title, deref(parsed.wishlist.Description))
+	}
+	// Items without a section are rendered first
+	assertSameItems(t, []wishlistgen.PortableItem{doc.Items[1], doc.Items[0]}, parsed.wishlist.Items)
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	doc := toPortable(exportFixture(), time.Now())
+	rendered, err := renderCSV(doc)
+	if err != nil {
+		t.Fatalf("Failed to render CSV: %v", err)
+	}
+
+	parsed, err := parseCSVImport(rendered)
+	if err != nil {
+		t.Fatalf("Failed to parse CSV: %v", err)
+	}
+	if !reflect.DeepEqual(parsed.rows, []int{2, 4}) {
+		t.Errorf("Expected rows to be the lines records start on, got %v", parsed.rows)
+	}
+	assertSameItems(t, doc.Items, parsed.wishlist.Items)
+}
+
+func assertSameItems(t *testing.T, expected, got []wishlistgen.PortableItem) {
+	t.Helper()
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d items, got %d", len(expected), len(got))
+	}
+	for i := range expected {
+		e, g := expected[i], got[i]
+		if e.Name != g.Name || deref(e.Url) != deref(g.Url) || deref(e.Description) != deref(g.Description) ||
+			deref(e.Section) != deref(g.Section) || deref(e.Currency) != deref(g.Currency) || !reflect.DeepEqual(e.Price, g.Price) {
+			t.Errorf("Item %d differs:\nexpected %+v\ngot      %+v", i, e, g)
+		}
+	}
+}
+
+func TestExportRejectsUnknownFormat(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	format := wishlistgen.GetWishlistsWishlistIdExportParamsFormat("pdf")
+
+	rec := httptest.NewRecorder()
+	s.GetWishlistsWishlistIdExport(rec, httptest.NewRequest(http.MethodGet, "/", nil), uuid.New(), wishlistgen.GetWishlistsWishlistIdExportParams{Format: &format})
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
@@ -19,10 +19,212 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for ActivityEventType.
+const (
+	ActivityEventTypeItemAdded           ActivityEventType = "item_added"
+	ActivityEventTypeItemBooked          ActivityEventType = "item_booked"
+	ActivityEventTypeItemEdited          ActivityEventType = "item_edited"
+	ActivityEventTypeItemRemoved         ActivityEventType = "item_removed"
+	ActivityEventTypeItemUnbookedByOwner ActivityEventType = "item_unbooked_by_owner"
+	ActivityEventTypeItemUnbookedByToken ActivityEventType = "item_unbooked_by_token"
+)
+
+// Defines values for BatchItemOperationOp.
+const (
+	BatchItemOperationOpCreate BatchItemOperationOp = "create"
+	BatchItemOperationOpDelete BatchItemOperationOp = "delete"
+	BatchItemOperationOpUpdate BatchItemOperationOp = "update"
+)
+
+// Defines values for BatchItemResultOp.
+const (
+	BatchItemResultOpCreate BatchItemResultOp = "create"
+	BatchItemResultOpDelete BatchItemResultOp = "delete"
+	BatchItemResultOpUpdate BatchItemResultOp = "update"
+)
+
+// Defines values for BatchItemResultStatus.
+const (
+	BatchItemResultStatusFailed BatchItemResultStatus = "failed"
+	BatchItemResultStatusOk     BatchItemResultStatus = "ok"
+)
+
+// Defines values for BookingStatusState.
+const (
+	BookingStatusStateActive          BookingStatusState = "active"
+	BookingStatusStateItemRemoved     BookingStatusState = "item_removed"
+	BookingStatusStateWishlistRemoved BookingStatusState = "wishlist_removed"
+)
+
+// Defines values for EmailCategory.
+const (
+	Bookings  EmailCategory = "bookings"
+	Reminders EmailCategory = "reminders"
+)
+
+// Defines values for EmailDelivery.
+const (
+	Digest  EmailDelivery = "digest"
+	Instant EmailDelivery = "instant"
+)
+
+// Defines values for RevisionChangeKind.
+const (
+	Added    RevisionChangeKind = "added"
+	Booked   RevisionChangeKind = "booked"
+	Modified RevisionChangeKind = "modified"
+	Removed  RevisionChangeKind = "removed"
+	Unbooked RevisionChangeKind = "unbooked"
+)
+
+// Defines values for RevisionChangeTarget.
+const (
+	RevisionChangeTargetItem     RevisionChangeTarget = "item"
+	RevisionChangeTargetWishlist RevisionChangeTarget = "wishlist"
+)
+
+// Defines values for TemplateLanguage.
+const (
+	En TemplateLanguage = "en"
+	Ru TemplateLanguage = "ru"
+)
+
+// Defines values for TransferItemsRequestBookingPolicy.
+const (
+	Keep    TransferItemsRequestBookingPolicy = "keep"
+	Reject  TransferItemsRequestBookingPolicy = "reject"
+	Release TransferItemsRequestBookingPolicy = "release"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+)
+
+// Defines values for WebhookEventType.
+const (
+	ItemAdded           WebhookEventType = "item_added"
+	ItemBooked          WebhookEventType = "item_booked"
+	ItemEdited          WebhookEventType = "item_edited"
+	ItemRemoved         WebhookEventType = "item_removed"
+	ItemUnbookedByOwner WebhookEventType = "item_unbooked_by_owner"
+	ItemUnbookedByToken WebhookEventType = "item_unbooked_by_token"
+)
+
+// Defines values for WishlistPrivacyBookerVisibility.
+const (
+	Anonymous WishlistPrivacyBookerVisibility = "anonymous"
+	Surprise  WishlistPrivacyBookerVisibility = "surprise"
+	Visible   WishlistPrivacyBookerVisibility = "visible"
+)
+
+// Defines values for PostWishlistsImportParamsFormat.
+const (
+	PostWishlistsImportParamsFormatCsv  PostWishlistsImportParamsFormat = "csv"
+	PostWishlistsImportParamsFormatJson PostWishlistsImportParamsFormat = "json"
+	PostWishlistsImportParamsFormatMd   PostWishlistsImportParamsFormat = "md"
+	PostWishlistsImportParamsFormatUrls PostWishlistsImportParamsFormat = "urls"
+)
+
+// Defines values for GetWishlistsWishlistIdExportParamsFormat.
+const (
+	GetWishlistsWishlistIdExportParamsFormatCsv  GetWishlistsWishlistIdExportParamsFormat = "csv"
+	GetWishlistsWishlistIdExportParamsFormatJson GetWishlistsWishlistIdExportParamsFormat = "json"
+	GetWishlistsWishlistIdExportParamsFormatMd   GetWishlistsWishlistIdExportParamsFormat = "md"
+)
+
+// ActivityBooker Who booked or released the item; null when the privacy settings anonymize bookers
+type ActivityBooker struct {
+	Message *string `json:"message"`
+	Name    *string `json:"name"`
+}
+
+// ActivityEvent defines model for ActivityEvent.
+type ActivityEvent struct {
+	// Booker Who booked or released the item; null when the privacy settings anonymize bookers
+	Booker    *ActivityBooker `json:"booker"`
+	CreatedAt time.Time       `json:"createdAt"`
+
+	// Fields Changed fields of "item_edited" events (e.g. "data.url")
+	Fields   *[]string          `json:"fields,omitempty"`
+	Id       string             `json:"id"`
+	ItemId   openapi_types.UUID `json:"itemId"`
+	ItemName string             `json:"itemName"`
+	Type     ActivityEventType  `json:"type"`
+}
+
+// ActivityEventType defines model for ActivityEvent.Type.
+type ActivityEventType string
+
+// ActivityFeed defines model for ActivityFeed.
+type ActivityFeed struct {
+	Events []ActivityEvent `json:"events"`
+
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// BatchItemOperation defines model for BatchItemOperation.
+type BatchItemOperation struct {
+	// Data Item-specific data payload. All items must have a name.
+	// Description and other properties are optional to support different item types
+	// (e.g., marketplace items with SKU, price, etc.).
+	Data *WishlistItemData `json:"data,omitempty"`
+
+	// ItemId Target item (update and delete)
+	ItemId *openapi_types.UUID  `json:"itemId,omitempty"`
+	Op     BatchItemOperationOp `json:"op"`
+
+	// Type Item type (required for create)
+	Type *string `json:"type,omitempty"`
+}
+
+// BatchItemOperationOp defines model for BatchItemOperation.Op.
+type BatchItemOperationOp string
+
+// BatchItemResult defines model for BatchItemResult.
+type BatchItemResult struct {
+	Error *string `json:"error,omitempty"`
+
+	// Index Position of the operation in the request
+	Index  int                   `json:"index"`
+	Item   *WishlistItem         `json:"item,omitempty"`
+	Op     BatchItemResultOp     `json:"op"`
+	Status BatchItemResultStatus `json:"status"`
+}
+
+// BatchItemResultOp defines model for BatchItemResult.Op.
+type BatchItemResultOp string
+
+// BatchItemResultStatus defines model for BatchItemResult.Status.
+type BatchItemResultStatus string
+
+// BatchItemsRequest defines model for BatchItemsRequest.
+type BatchItemsRequest struct {
+	Operations []BatchItemOperation `json:"operations"`
+}
+
+// BatchItemsResponse defines model for BatchItemsResponse.
+type BatchItemsResponse struct {
+	// Applied Whether the operations were applied
+	Applied bool              `json:"applied"`
+	Results []BatchItemResult `json:"results"`
+
+	// Version Wishlist version after the batch (only when applied)
+	Version *int64 `json:"version,omitempty"`
+}
+
 // BookItemRequest defines model for BookItemRequest.
 type BookItemRequest struct {
+	// BookerEmail Optional address the booking receipt with a cancel link is sent to.
+	// Never shown to the wishlist owner and deleted once the booking ends.
+	BookerEmail *openapi_types.Email `json:"bookerEmail,omitempty"`
+
 	// BookerName Optional name of the person booking the item. If not provided, booking will be anonymous.
-	BookerName *string `json:"bookerName,omitempty"`
+	BookerName *string           `json:"bookerName,omitempty"`
+	Language   *TemplateLanguage `json:"language,omitempty"`
 
 	// Message Optional message from the booker to the wishlist owner.
 	Message *string `json:"message,omitempty"`
@@ -46,6 +248,25 @@ type BookItemResponse struct {
 	Message *string `json:"message"`
 }
 
+// BookingStatus defines model for BookingStatus.
+type BookingStatus struct {
+	BookedAt  time.Time          `json:"bookedAt"`
+	BookingId openapi_types.UUID `json:"bookingId"`
+	ItemName  string             `json:"itemName"`
+
+	// Purchased Whether the booker marked the gift as bought; never shown to the owner
+	Purchased bool `json:"purchased"`
+
+	// RemovedAt When the item or wishlist was removed
+	RemovedAt *time.Time `json:"removedAt"`
+
+	// State Whether the booked item is still on the wishlist
+	State BookingStatusState `json:"state"`
+}
+
+// BookingStatusState Whether the booked item is still on the wishlist
+type BookingStatusState string
+
 // ConflictErrorResponse defines model for ConflictErrorResponse.
 type ConflictErrorResponse struct {
 	// Error Error type
@@ -55,6 +276,30 @@ type ConflictErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// CopyWishlistRequest defines model for CopyWishlistRequest.
+type CopyWishlistRequest struct {
+	// Description Description of the copy (defaults to the original description)
+	Description *string `json:"description"`
+
+	// OnlyUnbooked Copy only items that are not booked
+	OnlyUnbooked *bool `json:"onlyUnbooked,omitempty"`
+
+	// Title Title of the copy (defaults to the original title)
+	Title *string `json:"title,omitempty"`
+}
+
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Events Event types to send; all when omitted
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Url Absolute http(s) URL receiving the events
+	Url string `json:"url"`
+
+	// WishlistId Only send events of this wishlist; all wishlists of the user when omitted
+	WishlistId *openapi_types.UUID `json:"wishlistId"`
+}
+
 // CreateWishlistItemRequest defines model for CreateWishlistItemRequest.
 type CreateWishlistItemRequest struct {
 	// Data Item-specific data payload. All items must have a name.
@@ -69,12 +314,57 @@ type CreateWishlistItemRequest struct {
 // CreateWishlistRequest defines model for CreateWishlistRequest.
 type CreateWishlistRequest struct {
 	// Description Optional wishlist description
-	Description *string `json:"description"`
+	Description *string           `json:"description"`
+	Event       *WishlistEvent    `json:"event,omitempty"`
+	Language    *TemplateLanguage `json:"language,omitempty"`
+
+	// TemplateId Template whose items the new wishlist starts with (see GET /templates)
+	TemplateId *string `json:"templateId,omitempty"`
 
 	// Title Wishlist title
 	Title string `json:"title"`
 }
 
+// EmailCategory Category of email and inbox notifications. "bookings": items of your
+// wishlists were booked or unbooked; "reminders": upcoming events and
+// bookings about to expire.
+type EmailCategory string
+
+// EmailDelivery Send every email right away or collect them into one email a day
+type EmailDelivery string
+
+// EmailPreferences defines model for EmailPreferences.
+type EmailPreferences struct {
+	// Delivery Send every email right away or collect them into one email a day
+	Delivery EmailDelivery    `json:"delivery"`
+	Language TemplateLanguage `json:"language"`
+
+	// Unsubscribed Categories no email is sent for
+	Unsubscribed []EmailCategory `json:"unsubscribed"`
+}
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	Errors []ImportRowError `json:"errors"`
+
+	// Imported Number of items imported
+	Imported int      `json:"imported"`
+	Wishlist Wishlist `json:"wishlist"`
+}
+
+// ImportRowError defines model for ImportRowError.
+type ImportRowError struct {
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
+	Row     int     `json:"row"`
+}
+
+// InboxPreferences defines model for InboxPreferences.
+type InboxPreferences struct {
+	// Muted Categories that are not added to the inbox
+	Muted []EmailCategory `json:"muted"`
+}
+
 // ItemBooking defines model for ItemBooking.
 type ItemBooking struct {
 	// BookedAt When the item was booked
@@ -90,6 +380,160 @@ type ItemBooking struct {
 	Message *string `json:"message"`
 }
 
+// MarkNotificationsReadResponse defines model for MarkNotificationsReadResponse.
+type MarkNotificationsReadResponse struct {
+	// Updated Number of notifications that were unread
+	Updated int `json:"updated"`
+}
+
+// Notification defines model for Notification.
+type Notification struct {
+	// BookerName Left out unless the wishlist shows bookers
+	BookerName *string `json:"bookerName"`
+
+	// Category Category of email and inbox notifications. "bookings": items of your
+	// wishlists were booked or unbooked; "reminders": upcoming events and
+	// bookings about to expire.
+	Category  EmailCategory `json:"category"`
+	CreatedAt time.Time     `json:"createdAt"`
+
+	// DaysLeft Days until the event or expiry, for reminders
+	DaysLeft *int   `json:"daysLeft"`
+	Id       string `json:"id"`
+
+	// ItemName Left out for surprise wishlists
+	ItemName *string `json:"itemName"`
+	Message  *string `json:"message"`
+	Read     bool    `json:"read"`
+
+	// Type item_booked, item_unbooked, event_soon or booking_expiring
+	Type          string             `json:"type"`
+	WishlistId    openapi_types.UUID `json:"wishlistId"`
+	WishlistTitle string             `json:"wishlistTitle"`
+}
+
+// NotificationFeed defines model for NotificationFeed.
+type NotificationFeed struct {
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor    *string        `json:"nextCursor"`
+	Notifications []Notification `json:"notifications"`
+	UnreadCount   int            `json:"unreadCount"`
+}
+
+// PortableItem defines model for PortableItem.
+type PortableItem struct {
+	// Booked Export only; ignored on import
+	Booked *bool `json:"booked,omitempty"`
+
+	// Currency ISO 4217 code
+	Currency    *string  `json:"currency,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Price       *float64 `json:"price,omitempty"`
+	Section     *string  `json:"section,omitempty"`
+
+	// Type Item type, "general" when omitted
+	Type *string `json:"type,omitempty"`
+	Url  *string `json:"url,omitempty"`
+}
+
+// PortableWishlist defines model for PortableWishlist.
+type PortableWishlist struct {
+	Description *string        `json:"description,omitempty"`
+	ExportedAt  *time.Time     `json:"exportedAt,omitempty"`
+	Items       []PortableItem `json:"items"`
+	Title       string         `json:"title"`
+}
+
+// RevertRequest defines model for RevertRequest.
+type RevertRequest struct {
+	// Revision Revision to revert to
+	Revision int64 `json:"revision"`
+}
+
+// RevisionChange defines model for RevisionChange.
+type RevisionChange struct {
+	// Fields Changed fields for "modified" changes (e.g. "title", "data.url")
+	Fields   *[]string            `json:"fields,omitempty"`
+	ItemId   *openapi_types.UUID  `json:"itemId,omitempty"`
+	ItemName *string              `json:"itemName,omitempty"`
+	Kind     RevisionChangeKind   `json:"kind"`
+	Target   RevisionChangeTarget `json:"target"`
+}
+
+// RevisionChangeKind defines model for RevisionChange.Kind.
+type RevisionChangeKind string
+
+// RevisionChangeTarget defines model for RevisionChange.Target.
+type RevisionChangeTarget string
+
+// TemplateLanguage defines model for TemplateLanguage.
+type TemplateLanguage string
+
+// TemplatesResponse defines model for TemplatesResponse.
+type TemplatesResponse struct {
+	Templates []WishlistTemplate `json:"templates"`
+}
+
+// TransferItemsRequest defines model for TransferItemsRequest.
+type TransferItemsRequest struct {
+	// BookingPolicy Moves only. reject fails if any item is booked, keep moves bookings
+	// with their items, release cancels them.
+	BookingPolicy    *TransferItemsRequestBookingPolicy `json:"bookingPolicy,omitempty"`
+	ItemIds          []openapi_types.UUID               `json:"itemIds"`
+	TargetWishlistId openapi_types.UUID                 `json:"targetWishlistId"`
+}
+
+// TransferItemsRequestBookingPolicy Moves only. reject fails if any item is booked, keep moves bookings
+// with their items, release cancels them.
+type TransferItemsRequestBookingPolicy string
+
+// TransferItemsResponse defines model for TransferItemsResponse.
+type TransferItemsResponse struct {
+	Source Wishlist `json:"source"`
+	Target Wishlist `json:"target"`
+}
+
+// TrashResponse defines model for TrashResponse.
+type TrashResponse struct {
+	// Items Items deleted from wishlists that are not in the trash themselves
+	Items     []TrashedItem     `json:"items"`
+	Wishlists []TrashedWishlist `json:"wishlists"`
+}
+
+// TrashedItem defines model for TrashedItem.
+type TrashedItem struct {
+	DeletedAt time.Time    `json:"deletedAt"`
+	Item      WishlistItem `json:"item"`
+
+	// PurgeAt When the item will be permanently deleted
+	PurgeAt       time.Time          `json:"purgeAt"`
+	WishlistId    openapi_types.UUID `json:"wishlistId"`
+	WishlistTitle string             `json:"wishlistTitle"`
+}
+
+// TrashedWishlist defines model for TrashedWishlist.
+type TrashedWishlist struct {
+	DeletedAt time.Time `json:"deletedAt"`
+
+	// PurgeAt When the wishlist will be permanently deleted
+	PurgeAt  time.Time `json:"purgeAt"`
+	Wishlist Wishlist  `json:"wishlist"`
+}
+
+// UnreadNotificationCount defines model for UnreadNotificationCount.
+type UnreadNotificationCount struct {
+	Count int `json:"count"`
+}
+
+// UpdateEmailPreferencesRequest defines model for UpdateEmailPreferencesRequest.
+type UpdateEmailPreferencesRequest struct {
+	// Delivery Send every email right away or collect them into one email a day
+	Delivery     *EmailDelivery    `json:"delivery,omitempty"`
+	Language     *TemplateLanguage `json:"language,omitempty"`
+	Unsubscribed *[]EmailCategory  `json:"unsubscribed,omitempty"`
+}
+
 // UpdateWishlistItemRequest defines model for UpdateWishlistItemRequest.
 type UpdateWishlistItemRequest struct {
 	// Data Item-specific data payload. All items must have a name.
@@ -104,7 +548,9 @@ type UpdateWishlistItemRequest struct {
 // UpdateWishlistRequest defines model for UpdateWishlistRequest.
 type UpdateWishlistRequest struct {
 	// Description Updated wishlist description
-	Description *string `json:"description"`
+	Description *string          `json:"description"`
+	Event       *WishlistEvent   `json:"event,omitempty"`
+	Privacy     *WishlistPrivacy `json:"privacy,omitempty"`
 
 	// Title Updated wishlist title
 	Title *string `json:"title,omitempty"`
@@ -128,15 +574,98 @@ type ValidationErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Events Event types sent; empty means all
+	Events []WebhookEventType `json:"events"`
+	Id     openapi_types.UUID `json:"id"`
+
+	// Secret Signing secret, only returned when the webhook is created
+	Secret     *string             `json:"secret,omitempty"`
+	Url        string              `json:"url"`
+	WishlistId *openapi_types.UUID `json:"wishlistId"`
+}
+
+// WebhookDeliveries defines model for WebhookDeliveries.
+type WebhookDeliveries struct {
+	Deliveries []WebhookDelivery `json:"deliveries"`
+
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor *string `json:"nextCursor"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts      int                `json:"attempts"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	DeliveredAt   *time.Time         `json:"deliveredAt"`
+	EventId       string             `json:"eventId"`
+	EventType     WebhookEventType   `json:"eventType"`
+	Id            openapi_types.UUID `json:"id"`
+	LastError     *string            `json:"lastError"`
+	NextAttemptAt *time.Time         `json:"nextAttemptAt"`
+
+	// Payload Body POSTed to the webhook URL
+	Payload      WebhookEvent        `json:"payload"`
+	RedeliveryOf *openapi_types.UUID `json:"redeliveryOf"`
+
+	// ResponseStatus HTTP status of the last attempt
+	ResponseStatus *int                  `json:"responseStatus"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookId      openapi_types.UUID    `json:"webhookId"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEvent Body POSTed to the webhook URL
+type WebhookEvent struct {
+	// Booker Who booked or released the item; null when the privacy settings anonymize bookers
+	Booker *ActivityBooker `json:"booker"`
+	Fields *[]string       `json:"fields,omitempty"`
+
+	// Id Event id, the same across redeliveries
+	Id         string             `json:"id"`
+	ItemId     openapi_types.UUID `json:"itemId"`
+	ItemName   string             `json:"itemName"`
+	OccurredAt time.Time          `json:"occurredAt"`
+	Type       WebhookEventType   `json:"type"`
+	WishlistId openapi_types.UUID `json:"wishlistId"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
 // Wishlist defines model for Wishlist.
 type Wishlist struct {
 	CreatedAt   time.Time          `json:"createdAt"`
 	Description *string            `json:"description"`
+	Event       WishlistEvent      `json:"event"`
 	Id          openapi_types.UUID `json:"id"`
 	Items       []WishlistItem     `json:"items"`
+	Privacy     WishlistPrivacy    `json:"privacy"`
 	Title       string             `json:"title"`
 	UpdatedAt   time.Time          `json:"updatedAt"`
 	UserId      openapi_types.UUID `json:"userId"`
+
+	// Version Monotonically increasing revision, bumped on every change; exposed as ETag
+	Version int64 `json:"version"`
+}
+
+// WishlistEvent defines model for WishlistEvent.
+type WishlistEvent struct {
+	// Date Day of the occasion the wishlist is for. Bookers and owners get
+	// reminders ahead of it; null when there is no date.
+	Date *openapi_types.Date `json:"date"`
+}
+
+// WishlistHistory defines model for WishlistHistory.
+type WishlistHistory struct {
+	// NextCursor Cursor for the next (older) page, null on the last page
+	NextCursor *string            `json:"nextCursor"`
+	Revisions  []WishlistRevision `json:"revisions"`
 }
 
 // WishlistItem defines model for WishlistItem.
@@ -170,6 +699,243 @@ type WishlistItemData struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// WishlistPrivacy defines model for WishlistPrivacy.
+type WishlistPrivacy struct {
+	// BookerVisibility How bookers appear to the owner in the activity feed: "visible" shows
+	// names and messages, "anonymous" shows bookings without saying who made
+	// them, "surprise" hides booking events altogether.
+	BookerVisibility WishlistPrivacyBookerVisibility `json:"bookerVisibility"`
+}
+
+// WishlistPrivacyBookerVisibility How bookers appear to the owner in the activity feed: "visible" shows
+// names and messages, "anonymous" shows bookings without saying who made
+// them, "surprise" hides booking events altogether.
+type WishlistPrivacyBookerVisibility string
+
+// WishlistRevision defines model for WishlistRevision.
+type WishlistRevision struct {
+	// Action Operation that produced the revision (e.g. "update_item")
+	Action string `json:"action"`
+
+	// ActorId User who made the change (null for anonymous guests, e.g. bookings)
+	ActorId   *openapi_types.UUID `json:"actorId"`
+	Changes   []RevisionChange    `json:"changes"`
+	CreatedAt time.Time           `json:"createdAt"`
+
+	// Revision Wishlist version produced by this change
+	Revision int64 `json:"revision"`
+}
+
+// WishlistTemplate defines model for WishlistTemplate.
+type WishlistTemplate struct {
+	Description *string                     `json:"description,omitempty"`
+	Id          string                      `json:"id"`
+	Items       []CreateWishlistItemRequest `json:"items"`
+	Title       string                      `json:"title"`
+}
+
+// CancellationTokenQuery defines model for CancellationTokenQuery.
+type CancellationTokenQuery = openapi_types.UUID
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IfNoneMatch defines model for IfNoneMatch.
+type IfNoneMatch = string
+
+// ItemIdPath defines model for ItemIdPath.
+type ItemIdPath = openapi_types.UUID
+
+// NotificationIdPath defines model for NotificationIdPath.
+type NotificationIdPath = string
+
+// UnsubscribeToken defines model for UnsubscribeToken.
+type UnsubscribeToken = string
+
+// WishlistIdPath defines model for WishlistIdPath.
+type WishlistIdPath = openapi_types.UUID
+
+// PreconditionFailed defines model for PreconditionFailed.
+type PreconditionFailed = ConflictErrorResponse
+
+// GetNotificationsParams defines parameters for GetNotifications.
+type GetNotificationsParams struct {
+	// Unread Only list unread notifications
+	Unread *bool `form:"unread,omitempty" json:"unread,omitempty"`
+	Limit  *int  `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetNotificationsEmailUnsubscribeParams defines parameters for GetNotificationsEmailUnsubscribe.
+type GetNotificationsEmailUnsubscribeParams struct {
+	// Token Signed token from the unsubscribe link of an email
+	Token UnsubscribeToken `form:"token" json:"token"`
+}
+
+// PostNotificationsEmailUnsubscribeParams defines parameters for PostNotificationsEmailUnsubscribe.
+type PostNotificationsEmailUnsubscribeParams struct {
+	// Token Signed token from the unsubscribe link of an email
+	Token UnsubscribeToken `form:"token" json:"token"`
+}
+
+// GetTemplatesParams defines parameters for GetTemplates.
+type GetTemplatesParams struct {
+	Language *TemplateLanguage `form:"language,omitempty" json:"language,omitempty"`
+}
+
+// GetWebhooksWebhookIdDeliveriesParams defines parameters for GetWebhooksWebhookIdDeliveries.
+type GetWebhooksWebhookIdDeliveriesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostWishlistsImportTextBody defines parameters for PostWishlistsImport.
+type PostWishlistsImportTextBody = string
+
+// PostWishlistsImportParams defines parameters for PostWishlistsImport.
+type PostWishlistsImportParams struct {
+	Format PostWishlistsImportParamsFormat `form:"format" json:"format"`
+
+	// Title Title of the new wishlist; required when the document has none (csv, urls)
+	Title *string `form:"title,omitempty" json:"title,omitempty"`
+}
+
+// PostWishlistsImportParamsFormat defines parameters for PostWishlistsImport.
+type PostWishlistsImportParamsFormat string
+
+// DeleteWishlistsWishlistIdParams defines parameters for DeleteWishlistsWishlistId.
+type DeleteWishlistsWishlistIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWishlistsWishlistIdParams defines parameters for GetWishlistsWishlistId.
+type GetWishlistsWishlistIdParams struct {
+	// IfNoneMatch ETag of a cached wishlist; returns 304 if it is still current.
+	IfNoneMatch *IfNoneMatch `json:"If-None-Match,omitempty"`
+}
+
+// PutWishlistsWishlistIdParams defines parameters for PutWishlistsWishlistId.
+type PutWishlistsWishlistIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWishlistsWishlistIdActivityParams defines parameters for GetWishlistsWishlistIdActivity.
+type GetWishlistsWishlistIdActivityParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetWishlistsWishlistIdEventsParams defines parameters for GetWishlistsWishlistIdEvents.
+type GetWishlistsWishlistIdEventsParams struct {
+	// LastEventID Id of the last event received before reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetWishlistsWishlistIdExportParams defines parameters for GetWishlistsWishlistIdExport.
+type GetWishlistsWishlistIdExportParams struct {
+	Format *GetWishlistsWishlistIdExportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetWishlistsWishlistIdExportParamsFormat defines parameters for GetWishlistsWishlistIdExport.
+type GetWishlistsWishlistIdExportParamsFormat string
+
+// GetWishlistsWishlistIdHistoryParams defines parameters for GetWishlistsWishlistIdHistory.
+type GetWishlistsWishlistIdHistoryParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor nextCursor from a previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsParams defines parameters for PostWishlistsWishlistIdItems.
+type PostWishlistsWishlistIdItemsParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsCopyParams defines parameters for PostWishlistsWishlistIdItemsCopy.
+type PostWishlistsWishlistIdItemsCopyParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsMoveParams defines parameters for PostWishlistsWishlistIdItemsMove.
+type PostWishlistsWishlistIdItemsMoveParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdParams defines parameters for DeleteWishlistsWishlistIdItemsItemId.
+type DeleteWishlistsWishlistIdItemsItemIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutWishlistsWishlistIdItemsItemIdParams defines parameters for PutWishlistsWishlistIdItemsItemId.
+type PutWishlistsWishlistIdItemsItemIdParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookParams defines parameters for PostWishlistsWishlistIdItemsItemIdBook.
+type PostWishlistsWishlistIdItemsItemIdBookParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingParams defines parameters for GetWishlistsWishlistIdItemsItemIdBooking.
+type GetWishlistsWishlistIdItemsItemIdBookingParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken openapi_types.UUID `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingCancelParams defines parameters for GetWishlistsWishlistIdItemsItemIdBookingCancel.
+type GetWishlistsWishlistIdItemsItemIdBookingCancelParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookingCancelParams defines parameters for PostWishlistsWishlistIdItemsItemIdBookingCancel.
+type PostWishlistsWishlistIdItemsItemIdBookingCancelParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams defines parameters for DeleteWishlistsWishlistIdItemsItemIdBookingPurchased.
+type DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams defines parameters for PutWishlistsWishlistIdItemsItemIdBookingPurchased.
+type PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams struct {
+	// CancellationToken Cancellation token received when booking
+	CancellationToken CancellationTokenQuery `form:"cancellationToken" json:"cancellationToken"`
+}
+
+// PostWishlistsWishlistIdItemsItemIdRevertParams defines parameters for PostWishlistsWishlistIdItemsItemIdRevert.
+type PostWishlistsWishlistIdItemsItemIdRevertParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // DeleteWishlistsWishlistIdItemsItemIdUnbookParams defines parameters for DeleteWishlistsWishlistIdItemsItemIdUnbook.
 type DeleteWishlistsWishlistIdItemsItemIdUnbookParams struct {
 	// BookingId ID of the booking to unbook (for wishlist owner)
@@ -177,23 +943,74 @@ type DeleteWishlistsWishlistIdItemsItemIdUnbookParams struct {
 
 	// CancellationToken Cancellation token received when booking (for booker)
 	CancellationToken *openapi_types.UUID `form:"cancellationToken,omitempty" json:"cancellationToken,omitempty"`
+
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdItemsBatchParams defines parameters for PostWishlistsWishlistIdItemsBatch.
+type PostWishlistsWishlistIdItemsBatchParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostWishlistsWishlistIdRevertParams defines parameters for PostWishlistsWishlistIdRevert.
+type PostWishlistsWishlistIdRevertParams struct {
+	// IfMatch ETag of the wishlist the change is based on. When present and the wishlist
+	// has been modified since, the request fails with 412.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
+// PutNotificationsEmailPreferencesJSONRequestBody defines body for PutNotificationsEmailPreferences for application/json ContentType.
+type PutNotificationsEmailPreferencesJSONRequestBody = UpdateEmailPreferencesRequest
+
+// PutNotificationsPreferencesJSONRequestBody defines body for PutNotificationsPreferences for application/json ContentType.
+type PutNotificationsPreferencesJSONRequestBody = InboxPreferences
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = CreateWebhookRequest
+
 // PostWishlistsJSONRequestBody defines body for PostWishlists for application/json ContentType.
 type PostWishlistsJSONRequestBody = CreateWishlistRequest
 
+// PostWishlistsImportJSONRequestBody defines body for PostWishlistsImport for application/json ContentType.
+type PostWishlistsImportJSONRequestBody = PortableWishlist
+
+// PostWishlistsImportTextRequestBody defines body for PostWishlistsImport for text/plain ContentType.
+type PostWishlistsImportTextRequestBody = PostWishlistsImportTextBody
+
 // PutWishlistsWishlistIdJSONRequestBody defines body for PutWishlistsWishlistId for application/json ContentType.
 type PutWishlistsWishlistIdJSONRequestBody = UpdateWishlistRequest
 
+// PostWishlistsWishlistIdCopyJSONRequestBody defines body for PostWishlistsWishlistIdCopy for application/json ContentType.
+type PostWishlistsWishlistIdCopyJSONRequestBody = CopyWishlistRequest
+
 // PostWishlistsWishlistIdItemsJSONRequestBody defines body for PostWishlistsWishlistIdItems for application/json ContentType.
 type PostWishlistsWishlistIdItemsJSONRequestBody = CreateWishlistItemRequest
 
+// PostWishlistsWishlistIdItemsCopyJSONRequestBody defines body for PostWishlistsWishlistIdItemsCopy for application/json ContentType.
+type PostWishlistsWishlistIdItemsCopyJSONRequestBody = TransferItemsRequest
+
+// PostWishlistsWishlistIdItemsMoveJSONRequestBody defines body for PostWishlistsWishlistIdItemsMove for application/json ContentType.
+type PostWishlistsWishlistIdItemsMoveJSONRequestBody = TransferItemsRequest
+
 // PutWishlistsWishlistIdItemsItemIdJSONRequestBody defines body for PutWishlistsWishlistIdItemsItemId for application/json ContentType.
 type PutWishlistsWishlistIdItemsItemIdJSONRequestBody = UpdateWishlistItemRequest
 
 // PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody defines body for PostWishlistsWishlistIdItemsItemIdBook for application/json ContentType.
 type PostWishlistsWishlistIdItemsItemIdBookJSONRequestBody = BookItemRequest
 
+// PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody defines body for PostWishlistsWishlistIdItemsItemIdRevert for application/json ContentType.
+type PostWishlistsWishlistIdItemsItemIdRevertJSONRequestBody = RevertRequest
+
+// PostWishlistsWishlistIdItemsBatchJSONRequestBody defines body for PostWishlistsWishlistIdItemsBatch for application/json ContentType.
+type PostWishlistsWishlistIdItemsBatchJSONRequestBody = BatchItemsRequest
+
+// PostWishlistsWishlistIdRevertJSONRequestBody defines body for PostWishlistsWishlistIdRevert for application/json ContentType.
+type PostWishlistsWishlistIdRevertJSONRequestBody = RevertRequest
+
 // Getter for additional properties for WishlistItemData. Returns the specified
 // element and whether it was found
 func (a WishlistItemData) Get(fieldName string) (value interface{}, found bool) {
@@ -292,42 +1109,258 @@ func (a WishlistItemData) MarshalJSON() ([]byte, error) {
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the notification inbox of the authenticated user, newest first
+	// (GET /notifications)
+	GetNotifications(w http.ResponseWriter, r *http.Request, params GetNotificationsParams)
+	// Get the email notification preferences of the authenticated user
+	// (GET /notifications/email-preferences)
+	GetNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request)
+	// Update email notification preferences
+	// (PUT /notifications/email-preferences)
+	PutNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request)
+	// Unsubscribe from a category of emails via the link in an email
+	// (GET /notifications/email/unsubscribe)
+	GetNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request, params GetNotificationsEmailUnsubscribeParams)
+	// One-click unsubscribe (RFC 8058 List-Unsubscribe-Post)
+	// (POST /notifications/email/unsubscribe)
+	PostNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request, params PostNotificationsEmailUnsubscribeParams)
+	// Get the inbox preferences of the authenticated user
+	// (GET /notifications/preferences)
+	GetNotificationsPreferences(w http.ResponseWriter, r *http.Request)
+	// Replace the inbox preferences
+	// (PUT /notifications/preferences)
+	PutNotificationsPreferences(w http.ResponseWriter, r *http.Request)
+	// Mark every notification as read
+	// (POST /notifications/read-all)
+	PostNotificationsReadAll(w http.ResponseWriter, r *http.Request)
+	// Number of unread notifications, for the badge in the navigation bar
+	// (GET /notifications/unread-count)
+	GetNotificationsUnreadCount(w http.ResponseWriter, r *http.Request)
+	// Mark a notification as unread
+	// (DELETE /notifications/{notificationId}/read)
+	DeleteNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationIdPath)
+	// Mark a notification as read
+	// (POST /notifications/{notificationId}/read)
+	PostNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationIdPath)
+	// List wishlist templates
+	// (GET /templates)
+	GetTemplates(w http.ResponseWriter, r *http.Request, params GetTemplatesParams)
+	// List trashed wishlists and items of the authenticated user
+	// (GET /trash)
+	GetTrash(w http.ResponseWriter, r *http.Request)
+	// List webhooks of the authenticated user
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request)
+	// Register a webhook for one wishlist or for all wishlists of the user
+	// (POST /webhooks)
+	PostWebhooks(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook and its delivery log
+	// (DELETE /webhooks/{webhookId})
+	DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
+	// List deliveries of a webhook, newest first
+	// (GET /webhooks/{webhookId}/deliveries)
+	GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, params GetWebhooksWebhookIdDeliveriesParams)
+	// Send the event of a delivery again as a new delivery
+	// (POST /webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+	PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, deliveryId openapi_types.UUID)
 	// List wishlists of the authenticated user
 	// (GET /wishlists)
 	GetWishlists(w http.ResponseWriter, r *http.Request)
 	// Create a new wishlist for the authenticated user
 	// (POST /wishlists)
 	PostWishlists(w http.ResponseWriter, r *http.Request)
+	// Create a wishlist from an exported document or a list of URLs
+	// (POST /wishlists/import)
+	PostWishlistsImport(w http.ResponseWriter, r *http.Request, params PostWishlistsImportParams)
 	// Delete a wishlist (owner only)
 	// (DELETE /wishlists/{wishlistId})
-	DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID)
+	DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params DeleteWishlistsWishlistIdParams)
 	// Get a wishlist by ID (public endpoint)
 	// (GET /wishlists/{wishlistId})
-	GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID)
+	GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdParams)
 	// Update a wishlist (owner only)
 	// (PUT /wishlists/{wishlistId})
-	PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID)
+	PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PutWishlistsWishlistIdParams)
+	// List item and booking events of a wishlist, newest first (owner only)
+	// (GET /wishlists/{wishlistId}/activity)
+	GetWishlistsWishlistIdActivity(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdActivityParams)
+	// Copy a wishlist into a new one (owner only)
+	// (POST /wishlists/{wishlistId}/copy)
+	PostWishlistsWishlistIdCopy(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID)
+	// Stream wishlist updates as Server-Sent Events (public endpoint)
+	// (GET /wishlists/{wishlistId}/events)
+	GetWishlistsWishlistIdEvents(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdEventsParams)
+	// Export a wishlist as a portable document (public endpoint)
+	// (GET /wishlists/{wishlistId}/export)
+	GetWishlistsWishlistIdExport(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdExportParams)
+	// List revisions of a wishlist, newest first (owner only)
+	// (GET /wishlists/{wishlistId}/history)
+	GetWishlistsWishlistIdHistory(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdHistoryParams)
 	// Add an item to a wishlist (owner only)
 	// (POST /wishlists/{wishlistId}/items)
-	PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID)
+	PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsParams)
+	// Copy items to another wishlist of the same owner
+	// (POST /wishlists/{wishlistId}/items/copy)
+	PostWishlistsWishlistIdItemsCopy(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsCopyParams)
+	// Move items to another wishlist of the same owner
+	// (POST /wishlists/{wishlistId}/items/move)
+	PostWishlistsWishlistIdItemsMove(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsMoveParams)
 	// Remove an item from a wishlist (owner only)
 	// (DELETE /wishlists/{wishlistId}/items/{itemId})
-	DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID)
+	DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params DeleteWishlistsWishlistIdItemsItemIdParams)
 	// Update a wishlist item (owner only)
 	// (PUT /wishlists/{wishlistId}/items/{itemId})
-	PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID)
+	PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params PutWishlistsWishlistIdItemsItemIdParams)
 	// Book a wishlist item (public endpoint)
 	// (POST /wishlists/{wishlistId}/items/{itemId}/book)
-	PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID)
+	PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params PostWishlistsWishlistIdItemsItemIdBookParams)
+	// Get the state of a booking by its cancellation token (public endpoint)
+	// (GET /wishlists/{wishlistId}/items/{itemId}/booking)
+	GetWishlistsWishlistIdItemsItemIdBooking(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params GetWishlistsWishlistIdItemsItemIdBookingParams)
+	// Confirmation page for the cancel link of a booking receipt (public endpoint)
+	// (GET /wishlists/{wishlistId}/items/{itemId}/booking/cancel)
+	GetWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params GetWishlistsWishlistIdItemsItemIdBookingCancelParams)
+	// Cancel a booking from its receipt email (public endpoint)
+	// (POST /wishlists/{wishlistId}/items/{itemId}/booking/cancel)
+	PostWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params PostWishlistsWishlistIdItemsItemIdBookingCancelParams)
+	// Mark a booked gift as not bought yet (public endpoint)
+	// (DELETE /wishlists/{wishlistId}/items/{itemId}/booking/purchased)
+	DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams)
+	// Mark a booked gift as bought (public endpoint)
+	// (PUT /wishlists/{wishlistId}/items/{itemId}/booking/purchased)
+	PutWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams)
+	// Restore an item from the trash (owner only)
+	// (POST /wishlists/{wishlistId}/items/{itemId}/restore)
+	PostWishlistsWishlistIdItemsItemIdRestore(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID)
+	// Revert a single item to a previous revision (owner only)
+	// (POST /wishlists/{wishlistId}/items/{itemId}/revert)
+	PostWishlistsWishlistIdItemsItemIdRevert(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params PostWishlistsWishlistIdItemsItemIdRevertParams)
 	// Unbook a wishlist item
 	// (DELETE /wishlists/{wishlistId}/items/{itemId}/unbook)
 	DeleteWishlistsWishlistIdItemsItemIdUnbook(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params DeleteWishlistsWishlistIdItemsItemIdUnbookParams)
+	// Create, update and delete items in one request (owner only)
+	// (POST /wishlists/{wishlistId}/items:batch)
+	PostWishlistsWishlistIdItemsBatch(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsBatchParams)
+	// Restore a wishlist from the trash (owner only)
+	// (POST /wishlists/{wishlistId}/restore)
+	PostWishlistsWishlistIdRestore(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID)
+	// Revert a wishlist to a previous revision (owner only)
+	// (POST /wishlists/{wishlistId}/revert)
+	PostWishlistsWishlistIdRevert(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdRevertParams)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// List the notification inbox of the authenticated user, newest first
+// (GET /notifications)
+func (_ Unimplemented) GetNotifications(w http.ResponseWriter, r *http.Request, params GetNotificationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the email notification preferences of the authenticated user
+// (GET /notifications/email-preferences)
+func (_ Unimplemented) GetNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update email notification preferences
+// (PUT /notifications/email-preferences)
+func (_ Unimplemented) PutNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Unsubscribe from a category of emails via the link in an email
+// (GET /notifications/email/unsubscribe)
+func (_ Unimplemented) GetNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request, params GetNotificationsEmailUnsubscribeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// One-click unsubscribe (RFC 8058 List-Unsubscribe-Post)
+// (POST /notifications/email/unsubscribe)
+func (_ Unimplemented) PostNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request, params PostNotificationsEmailUnsubscribeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the inbox preferences of the authenticated user
+// (GET /notifications/preferences)
+func (_ Unimplemented) GetNotificationsPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace the inbox preferences
+// (PUT /notifications/preferences)
+func (_ Unimplemented) PutNotificationsPreferences(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark every notification as read
+// (POST /notifications/read-all)
+func (_ Unimplemented) PostNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Number of unread notifications, for the badge in the navigation bar
+// (GET /notifications/unread-count)
+func (_ Unimplemented) GetNotificationsUnreadCount(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a notification as unread
+// (DELETE /notifications/{notificationId}/read)
+func (_ Unimplemented) DeleteNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a notification as read
+// (POST /notifications/{notificationId}/read)
+func (_ Unimplemented) PostNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId NotificationIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List wishlist templates
+// (GET /templates)
+func (_ Unimplemented) GetTemplates(w http.ResponseWriter, r *http.Request, params GetTemplatesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List trashed wishlists and items of the authenticated user
+// (GET /trash)
+func (_ Unimplemented) GetTrash(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List webhooks of the authenticated user
+// (GET /webhooks)
+func (_ Unimplemented) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register a webhook for one wishlist or for all wishlists of the user
+// (POST /webhooks)
+func (_ Unimplemented) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a webhook and its delivery log
+// (DELETE /webhooks/{webhookId})
+func (_ Unimplemented) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List deliveries of a webhook, newest first
+// (GET /webhooks/{webhookId}/deliveries)
+func (_ Unimplemented) GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, params GetWebhooksWebhookIdDeliveriesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Send the event of a delivery again as a new delivery
+// (POST /webhooks/{webhookId}/deliveries/{deliveryId}/redeliver)
+func (_ Unimplemented) PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, deliveryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List wishlists of the authenticated user
 // (GET /wishlists)
 func (_ Unimplemented) GetWishlists(w http.ResponseWriter, r *http.Request) {
@@ -340,45 +1373,135 @@ func (_ Unimplemented) PostWishlists(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create a wishlist from an exported document or a list of URLs
+// (POST /wishlists/import)
+func (_ Unimplemented) PostWishlistsImport(w http.ResponseWriter, r *http.Request, params PostWishlistsImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete a wishlist (owner only)
 // (DELETE /wishlists/{wishlistId})
-func (_ Unimplemented) DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (_ Unimplemented) DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params DeleteWishlistsWishlistIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Get a wishlist by ID (public endpoint)
 // (GET /wishlists/{wishlistId})
-func (_ Unimplemented) GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (_ Unimplemented) GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Update a wishlist (owner only)
 // (PUT /wishlists/{wishlistId})
-func (_ Unimplemented) PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (_ Unimplemented) PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PutWishlistsWishlistIdParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List item and booking events of a wishlist, newest first (owner only)
+// (GET /wishlists/{wishlistId}/activity)
+func (_ Unimplemented) GetWishlistsWishlistIdActivity(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdActivityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Copy a wishlist into a new one (owner only)
+// (POST /wishlists/{wishlistId}/copy)
+func (_ Unimplemented) PostWishlistsWishlistIdCopy(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stream wishlist updates as Server-Sent Events (public endpoint)
+// (GET /wishlists/{wishlistId}/events)
+func (_ Unimplemented) GetWishlistsWishlistIdEvents(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdEventsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export a wishlist as a portable document (public endpoint)
+// (GET /wishlists/{wishlistId}/export)
+func (_ Unimplemented) GetWishlistsWishlistIdExport(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdExportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List revisions of a wishlist, newest first (owner only)
+// (GET /wishlists/{wishlistId}/history)
+func (_ Unimplemented) GetWishlistsWishlistIdHistory(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params GetWishlistsWishlistIdHistoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Add an item to a wishlist (owner only)
 // (POST /wishlists/{wishlistId}/items)
-func (_ Unimplemented) PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (_ Unimplemented) PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Copy items to another wishlist of the same owner
+// (POST /wishlists/{wishlistId}/items/copy)
+func (_ Unimplemented) PostWishlistsWishlistIdItemsCopy(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsCopyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Move items to another wishlist of the same owner
+// (POST /wishlists/{wishlistId}/items/move)
+func (_ Unimplemented) PostWishlistsWishlistIdItemsMove(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsMoveParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Remove an item from a wishlist (owner only)
 // (DELETE /wishlists/{wishlistId}/items/{itemId})
-func (_ Unimplemented) DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID) {
+func (_ Unimplemented) DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params DeleteWishlistsWishlistIdItemsItemIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Update a wishlist item (owner only)
 // (PUT /wishlists/{wishlistId}/items/{itemId})
-func (_ Unimplemented) PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID) {
+func (_ Unimplemented) PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params PutWishlistsWishlistIdItemsItemIdParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Book a wishlist item (public endpoint)
 // (POST /wishlists/{wishlistId}/items/{itemId}/book)
-func (_ Unimplemented) PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID) {
+func (_ Unimplemented) PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params PostWishlistsWishlistIdItemsItemIdBookParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the state of a booking by its cancellation token (public endpoint)
+// (GET /wishlists/{wishlistId}/items/{itemId}/booking)
+func (_ Unimplemented) GetWishlistsWishlistIdItemsItemIdBooking(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params GetWishlistsWishlistIdItemsItemIdBookingParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Confirmation page for the cancel link of a booking receipt (public endpoint)
+// (GET /wishlists/{wishlistId}/items/{itemId}/booking/cancel)
+func (_ Unimplemented) GetWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params GetWishlistsWishlistIdItemsItemIdBookingCancelParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Cancel a booking from its receipt email (public endpoint)
+// (POST /wishlists/{wishlistId}/items/{itemId}/booking/cancel)
+func (_ Unimplemented) PostWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params PostWishlistsWishlistIdItemsItemIdBookingCancelParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a booked gift as not bought yet (public endpoint)
+// (DELETE /wishlists/{wishlistId}/items/{itemId}/booking/purchased)
+func (_ Unimplemented) DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Mark a booked gift as bought (public endpoint)
+// (PUT /wishlists/{wishlistId}/items/{itemId}/booking/purchased)
+func (_ Unimplemented) PutWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request, wishlistId WishlistIdPath, itemId ItemIdPath, params PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore an item from the trash (owner only)
+// (POST /wishlists/{wishlistId}/items/{itemId}/restore)
+func (_ Unimplemented) PostWishlistsWishlistIdItemsItemIdRestore(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revert a single item to a previous revision (owner only)
+// (POST /wishlists/{wishlistId}/items/{itemId}/revert)
+func (_ Unimplemented) PostWishlistsWishlistIdItemsItemIdRevert(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params PostWishlistsWishlistIdItemsItemIdRevertParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -388,6 +1511,24 @@ func (_ Unimplemented) DeleteWishlistsWishlistIdItemsItemIdUnbook(w http.Respons
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create, update and delete items in one request (owner only)
+// (POST /wishlists/{wishlistId}/items:batch)
+func (_ Unimplemented) PostWishlistsWishlistIdItemsBatch(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdItemsBatchParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Restore a wishlist from the trash (owner only)
+// (POST /wishlists/{wishlistId}/restore)
+func (_ Unimplemented) PostWishlistsWishlistIdRestore(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revert a wishlist to a previous revision (owner only)
+// (POST /wishlists/{wishlistId}/revert)
+func (_ Unimplemented) PostWishlistsWishlistIdRevert(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params PostWishlistsWishlistIdRevertParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -397,8 +1538,57 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// GetWishlists operation middleware
-func (siw *ServerInterfaceWrapper) GetWishlists(w http.ResponseWriter, r *http.Request) {
+// GetNotifications operation middleware
+func (siw *ServerInterfaceWrapper) GetNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationsParams
+
+	// ------------- Optional query parameter "unread" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unread", r.URL.Query(), &params.Unread)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unread", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationsEmailPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -407,7 +1597,7 @@ func (siw *ServerInterfaceWrapper) GetWishlists(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWishlists(w, r)
+		siw.Handler.GetNotificationsEmailPreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -417,17 +1607,1453 @@ func (siw *ServerInterfaceWrapper) GetWishlists(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PostWishlists operation middleware
-func (siw *ServerInterfaceWrapper) PostWishlists(w http.ResponseWriter, r *http.Request) {
+// PutNotificationsEmailPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutNotificationsEmailPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutNotificationsEmailPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationsEmailUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetNotificationsEmailUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationsEmailUnsubscribe(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostNotificationsEmailUnsubscribe operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsEmailUnsubscribe(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostNotificationsEmailUnsubscribeParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := r.URL.Query().Get("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "token"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", r.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostNotificationsEmailUnsubscribe(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationsPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationsPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutNotificationsPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutNotificationsPreferences(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutNotificationsPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostNotificationsReadAll operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostNotificationsReadAll(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationsUnreadCount operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationsUnreadCount(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationsUnreadCount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteNotificationsNotificationIdRead operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "notificationId" -------------
+	var notificationId NotificationIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", chi.URLParam(r, "notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "notificationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNotificationsNotificationIdRead(w, r, notificationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostNotificationsNotificationIdRead operation middleware
+func (siw *ServerInterfaceWrapper) PostNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "notificationId" -------------
+	var notificationId NotificationIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", chi.URLParam(r, "notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "notificationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostNotificationsNotificationIdRead(w, r, notificationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTemplates operation middleware
+func (siw *ServerInterfaceWrapper) GetTemplates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesParams
+
+	// ------------- Optional query parameter "language" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "language", r.URL.Query(), &params.Language)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "language", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTemplates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTrash(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTrash(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhooksWebhookId(w, r, webhookId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksWebhookIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksWebhookIdDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksWebhookIdDeliveries(w, r, webhookId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", chi.URLParam(r, "deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w, r, webhookId, deliveryId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlists operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlists(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlists(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlists operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlists(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlists(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsImportParams
+
+	// ------------- Required query parameter "format" -------------
+
+	if paramValue := r.URL.Query().Get("format"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "title" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "title", r.URL.Query(), &params.Title)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "title", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlistsImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWishlistsWishlistId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWishlistsWishlistIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWishlistsWishlistId(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistId operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistId(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWishlistsWishlistId operation middleware
+func (siw *ServerInterfaceWrapper) PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutWishlistsWishlistIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWishlistsWishlistId(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistIdActivity operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistIdActivity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdActivityParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistIdActivity(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsWishlistIdCopy operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdCopy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlistsWishlistIdCopy(w, r, wishlistId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistIdEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistIdEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistIdEvents(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistIdExport operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistIdExport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdExportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistIdExport(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistIdHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistIdHistory(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsWishlistIdItems operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlistsWishlistIdItems(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsWishlistIdItemsCopy operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsCopy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsCopyParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlistsWishlistIdItemsCopy(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsWishlistIdItemsMove operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsMove(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsMoveParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlistsWishlistIdItemsMove(w, r, wishlistId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWishlistsWishlistIdItemsItemId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWishlistsWishlistIdItemsItemIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWishlistsWishlistIdItemsItemId(w, r, wishlistId, itemId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutWishlistsWishlistIdItemsItemId operation middleware
+func (siw *ServerInterfaceWrapper) PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutWishlistsWishlistIdItemsItemIdParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutWishlistsWishlistIdItemsItemId(w, r, wishlistId, itemId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsWishlistIdItemsItemIdBook operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsItemIdBookParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWishlistsWishlistIdItemsItemIdBook(w, r, wishlistId, itemId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistIdItemsItemIdBooking operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistIdItemsItemIdBooking(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdItemsItemIdBookingParams
+
+	// ------------- Required query parameter "cancellationToken" -------------
+
+	if paramValue := r.URL.Query().Get("cancellationToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cancellationToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cancellationToken", r.URL.Query(), &params.CancellationToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistIdItemsItemIdBooking(w, r, wishlistId, itemId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWishlistsWishlistIdItemsItemIdBookingCancel operation middleware
+func (siw *ServerInterfaceWrapper) GetWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId WishlistIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWishlistsWishlistIdItemsItemIdBookingCancelParams
+
+	// ------------- Required query parameter "cancellationToken" -------------
+
+	if paramValue := r.URL.Query().Get("cancellationToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cancellationToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cancellationToken", r.URL.Query(), &params.CancellationToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWishlistsWishlistIdItemsItemIdBookingCancel(w, r, wishlistId, itemId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWishlistsWishlistIdItemsItemIdBookingCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdBookingCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "wishlistId" -------------
+	var wishlistId WishlistIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wishlistId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsItemIdBookingCancelParams
 
-	ctx := r.Context()
+	// ------------- Required query parameter "cancellationToken" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	if paramValue := r.URL.Query().Get("cancellationToken"); paramValue != "" {
 
-	r = r.WithContext(ctx)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cancellationToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cancellationToken", r.URL.Query(), &params.CancellationToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostWishlists(w, r)
+		siw.Handler.PostWishlistsWishlistIdItemsItemIdBookingCancel(w, r, wishlistId, itemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -437,13 +3063,13 @@ func (siw *ServerInterfaceWrapper) PostWishlists(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWishlistsWishlistId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request) {
+// DeleteWishlistsWishlistIdItemsItemIdBookingPurchased operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "wishlistId" -------------
-	var wishlistId openapi_types.UUID
+	var wishlistId WishlistIdPath
 
 	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -451,14 +3077,41 @@ func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistId(w http.ResponseWrit
 		return
 	}
 
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams
+
+	// ------------- Required query parameter "cancellationToken" -------------
+
+	if paramValue := r.URL.Query().Get("cancellationToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cancellationToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cancellationToken", r.URL.Query(), &params.CancellationToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWishlistsWishlistId(w, r, wishlistId)
+		siw.Handler.DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(w, r, wishlistId, itemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -468,13 +3121,13 @@ func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistId(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetWishlistsWishlistId operation middleware
-func (siw *ServerInterfaceWrapper) GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request) {
+// PutWishlistsWishlistIdItemsItemIdBookingPurchased operation middleware
+func (siw *ServerInterfaceWrapper) PutWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "wishlistId" -------------
-	var wishlistId openapi_types.UUID
+	var wishlistId WishlistIdPath
 
 	err = runtime.BindStyledParameterWithOptions("simple", "wishlistId", chi.URLParam(r, "wishlistId"), &wishlistId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -482,14 +3135,41 @@ func (siw *ServerInterfaceWrapper) GetWishlistsWishlistId(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "itemId" -------------
+	var itemId ItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams
+
+	// ------------- Required query parameter "cancellationToken" -------------
+
+	if paramValue := r.URL.Query().Get("cancellationToken"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cancellationToken"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cancellationToken", r.URL.Query(), &params.CancellationToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWishlistsWishlistId(w, r, wishlistId)
+		siw.Handler.PutWishlistsWishlistIdItemsItemIdBookingPurchased(w, r, wishlistId, itemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -499,8 +3179,8 @@ func (siw *ServerInterfaceWrapper) GetWishlistsWishlistId(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PutWishlistsWishlistId operation middleware
-func (siw *ServerInterfaceWrapper) PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request) {
+// PostWishlistsWishlistIdItemsItemIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdRestore(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -513,6 +3193,15 @@ func (siw *ServerInterfaceWrapper) PutWishlistsWishlistId(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -520,7 +3209,7 @@ func (siw *ServerInterfaceWrapper) PutWishlistsWishlistId(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutWishlistsWishlistId(w, r, wishlistId)
+		siw.Handler.PostWishlistsWishlistIdItemsItemIdRestore(w, r, wishlistId, itemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -530,8 +3219,8 @@ func (siw *ServerInterfaceWrapper) PutWishlistsWishlistId(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// PostWishlistsWishlistIdItems operation middleware
-func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request) {
+// PostWishlistsWishlistIdItemsItemIdRevert operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdRevert(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -544,14 +3233,47 @@ func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItems(w http.ResponseW
 		return
 	}
 
+	// ------------- Path parameter "itemId" -------------
+	var itemId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsItemIdRevertParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostWishlistsWishlistIdItems(w, r, wishlistId)
+		siw.Handler.PostWishlistsWishlistIdItemsItemIdRevert(w, r, wishlistId, itemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -561,8 +3283,8 @@ func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItems(w http.ResponseW
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWishlistsWishlistIdItemsItemId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request) {
+// DeleteWishlistsWishlistIdItemsItemIdUnbook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemIdUnbook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -590,8 +3312,48 @@ func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemId(w http.R
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteWishlistsWishlistIdItemsItemIdUnbookParams
+
+	// ------------- Optional query parameter "bookingId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bookingId", r.URL.Query(), &params.BookingId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bookingId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cancellationToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cancellationToken", r.URL.Query(), &params.CancellationToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWishlistsWishlistIdItemsItemId(w, r, wishlistId, itemId)
+		siw.Handler.DeleteWishlistsWishlistIdItemsItemIdUnbook(w, r, wishlistId, itemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -601,8 +3363,8 @@ func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemId(w http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PutWishlistsWishlistIdItemsItemId operation middleware
-func (siw *ServerInterfaceWrapper) PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request) {
+// PostWishlistsWishlistIdItemsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsBatch(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -615,23 +3377,38 @@ func (siw *ServerInterfaceWrapper) PutWishlistsWishlistIdItemsItemId(w http.Resp
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWishlistsWishlistIdItemsBatchParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutWishlistsWishlistIdItemsItemId(w, r, wishlistId, itemId)
+		siw.Handler.PostWishlistsWishlistIdItemsBatch(w, r, wishlistId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -641,8 +3418,8 @@ func (siw *ServerInterfaceWrapper) PutWishlistsWishlistIdItemsItemId(w http.Resp
 	handler.ServeHTTP(w, r)
 }
 
-// PostWishlistsWishlistIdItemsItemIdBook operation middleware
-func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request) {
+// PostWishlistsWishlistIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdRestore(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -655,15 +3432,6 @@ func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdBook(w http
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -671,7 +3439,7 @@ func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdBook(w http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostWishlistsWishlistIdItemsItemIdBook(w, r, wishlistId, itemId)
+		siw.Handler.PostWishlistsWishlistIdRestore(w, r, wishlistId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -681,8 +3449,8 @@ func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdItemsItemIdBook(w http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWishlistsWishlistIdItemsItemIdUnbook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemIdUnbook(w http.ResponseWriter, r *http.Request) {
+// PostWishlistsWishlistIdRevert operation middleware
+func (siw *ServerInterfaceWrapper) PostWishlistsWishlistIdRevert(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -695,15 +3463,6 @@ func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemIdUnbook(w
 		return
 	}
 
-	// ------------- Path parameter "itemId" -------------
-	var itemId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "itemId", chi.URLParam(r, "itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "itemId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -711,26 +3470,31 @@ func (siw *ServerInterfaceWrapper) DeleteWishlistsWishlistIdItemsItemIdUnbook(w
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteWishlistsWishlistIdItemsItemIdUnbookParams
+	var params PostWishlistsWishlistIdRevertParams
 
-	// ------------- Optional query parameter "bookingId" -------------
+	headers := r.Header
 
-	err = runtime.BindQueryParameter("form", true, false, "bookingId", r.URL.Query(), &params.BookingId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "bookingId", Err: err})
-		return
-	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
 
-	// ------------- Optional query parameter "cancellationToken" -------------
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
 
-	err = runtime.BindQueryParameter("form", true, false, "cancellationToken", r.URL.Query(), &params.CancellationToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cancellationToken", Err: err})
-		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWishlistsWishlistIdItemsItemIdUnbook(w, r, wishlistId, itemId, params)
+		siw.Handler.PostWishlistsWishlistIdRevert(w, r, wishlistId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -853,12 +3617,69 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications", wrapper.GetNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/email-preferences", wrapper.GetNotificationsEmailPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/notifications/email-preferences", wrapper.PutNotificationsEmailPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/email/unsubscribe", wrapper.GetNotificationsEmailUnsubscribe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/email/unsubscribe", wrapper.PostNotificationsEmailUnsubscribe)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/preferences", wrapper.GetNotificationsPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/notifications/preferences", wrapper.PutNotificationsPreferences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/read-all", wrapper.PostNotificationsReadAll)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/notifications/unread-count", wrapper.GetNotificationsUnreadCount)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/notifications/{notificationId}/read", wrapper.DeleteNotificationsNotificationIdRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/notifications/{notificationId}/read", wrapper.PostNotificationsNotificationIdRead)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/templates", wrapper.GetTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/trash", wrapper.GetTrash)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhookId}", wrapper.DeleteWebhooksWebhookId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/{webhookId}/deliveries", wrapper.GetWebhooksWebhookIdDeliveries)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver", wrapper.PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/wishlists", wrapper.GetWishlists)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/wishlists", wrapper.PostWishlists)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/import", wrapper.PostWishlistsImport)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/wishlists/{wishlistId}", wrapper.DeleteWishlistsWishlistId)
 	})
@@ -868,9 +3689,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/wishlists/{wishlistId}", wrapper.PutWishlistsWishlistId)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wishlists/{wishlistId}/activity", wrapper.GetWishlistsWishlistIdActivity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/copy", wrapper.PostWishlistsWishlistIdCopy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wishlists/{wishlistId}/events", wrapper.GetWishlistsWishlistIdEvents)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wishlists/{wishlistId}/export", wrapper.GetWishlistsWishlistIdExport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wishlists/{wishlistId}/history", wrapper.GetWishlistsWishlistIdHistory)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items", wrapper.PostWishlistsWishlistIdItems)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items/copy", wrapper.PostWishlistsWishlistIdItemsCopy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items/move", wrapper.PostWishlistsWishlistIdItemsMove)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}", wrapper.DeleteWishlistsWishlistIdItemsItemId)
 	})
@@ -880,9 +3722,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/book", wrapper.PostWishlistsWishlistIdItemsItemIdBook)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/booking", wrapper.GetWishlistsWishlistIdItemsItemIdBooking)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/booking/cancel", wrapper.GetWishlistsWishlistIdItemsItemIdBookingCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/booking/cancel", wrapper.PostWishlistsWishlistIdItemsItemIdBookingCancel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/booking/purchased", wrapper.DeleteWishlistsWishlistIdItemsItemIdBookingPurchased)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/booking/purchased", wrapper.PutWishlistsWishlistIdItemsItemIdBookingPurchased)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/restore", wrapper.PostWishlistsWishlistIdItemsItemIdRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/revert", wrapper.PostWishlistsWishlistIdItemsItemIdRevert)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/wishlists/{wishlistId}/items/{itemId}/unbook", wrapper.DeleteWishlistsWishlistIdItemsItemIdUnbook)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/items:batch", wrapper.PostWishlistsWishlistIdItemsBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/restore", wrapper.PostWishlistsWishlistIdRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/wishlists/{wishlistId}/revert", wrapper.PostWishlistsWishlistIdRevert)
+	})
 
 	return r
 }
@@ -0,0 +1,711 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	defaultHistoryLimit = 20
+	maxHistoryLimit     = 100
+)
+
+// ErrItemMoved is returned when reverting an item that has been moved to
+// another wishlist since the revision.
+var ErrItemMoved = errors.New("item moved to another wishlist")
+
+// mongoRevision is the state of a wishlist right after the write that produced
+// version Version, together with what that write changed.
+type mongoRevision struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	WishlistID string             `bson:"wishlistId"`
+	Version    int64              `bson:"version"`
+	ActorID    *string            `bson:"actorId,omitempty"`
+	Action     string             `bson:"action"`
+	Changes    []revisionChange   `bson:"changes"`
+	Snapshot   revisionSnapshot   `bson:"snapshot"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+}
+
+// revisionSnapshot holds what a revert can bring back. Bookings are only
+// tracked as a flag: reverting never books or unbooks anything.
+type revisionSnapshot struct {
+	Title       string         `bson:"title"`
+	Description *string        `bson:"description,omitempty"`
+	Deleted     bool           `bson:"deleted,omitempty"`
+	Items       []revisionItem `bson:"items"`
+}
+
+type revisionItem struct {
+	ID     string                 `bson:"id"`
+	Type   string                 `bson:"type"`
+	Data   map[string]interface{} `bson:"data"`
+	Booked bool                   `bson:"booked,omitempty"`
+	// BookerName lets the activity feed say who released a booking
+	BookerName *string   `bson:"bookerName,omitempty"`
+	CreatedAt  time.Time `bson:"createdAt"`
+}
+
+type revisionChange struct {
+	Target   string   `bson:"target"`
+	ItemID   string   `bson:"itemId,omitempty"`
+	ItemName string   `bson:"itemName,omitempty"`
+	Kind     string   `bson:"kind"`
+	Fields   []string `bson:"fields,omitempty"`
+}
+
+// snapshotOf captures the revertable state of a wishlist; trashed items are left out
+func snapshotOf(mw *mongoWishlist) revisionSnapshot {
+	snapshot := revisionSnapshot{
+		Title:       mw.Title,
+		Description: mw.Description,
+		Deleted:     mw.DeletedAt != nil,
+		Items:       []revisionItem{},
+	}
+	for _, item := range mw.Items {
+		if item.DeletedAt != nil {
+			continue
+		}
+		snap := revisionItem{
+			ID:        item.ID,
+			Type:      item.Type,
+			Data:      item.Data,
+			Booked:    item.Booking != nil,
+			CreatedAt: item.CreatedAt,
+		}
+		if item.Booking != nil {
+			snap.BookerName = item.Booking.BookerName
+		}
+		snapshot.Items = append(snapshot.Items, snap)
+	}
+	return snapshot
+}
+
+func itemName(item revisionItem) string {
+	name, _ := item.Data["name"].(string)
+	return name
+}
+
+// diffSnapshots lists what changed between two consecutive snapshots. A nil
+// prev means the wishlist was just created.
+func diffSnapshots(prev *revisionSnapshot, next revisionSnapshot) []revisionChange {
+	changes := []revisionChange{}
+	wishlistTarget := string(wishlistgen.RevisionChangeTargetWishlist)
+	itemTarget := string(wishlistgen.RevisionChangeTargetItem)
+
+	if prev == nil {
+		prev = &revisionSnapshot{Title: next.Title, Description: next.Description}
+		changes = append(changes, revisionChange{Target: wishlistTarget, Kind: string(wishlistgen.Added)})
+	}
+
+	switch {
+	case !prev.Deleted && next.Deleted:
+		changes = append(changes, revisionChange{Target: wishlistTarget, Kind: string(wishlistgen.Removed)})
+	case prev.Deleted && !next.Deleted:
+		changes = append(changes, revisionChange{Target: wishlistTarget, Kind: string(wishlistgen.Added)})
+	}
+
+	var fields []string
+	if prev.Title != next.Title {
+		fields = append(fields, "title")
+	}
+	if !reflect.DeepEqual(prev.Description, next.Description) {
+		fields = append(fields, "description")
+	}
+	if len(fields) > 0 {
+		changes = append(changes, revisionChange{Target: wishlistTarget, Kind: string(wishlistgen.Modified), Fields: fields})
+	}
+
+	prevItems := make(map[string]revisionItem, len(prev.Items))
+	for _, item := range prev.Items {
+		prevItems[item.ID] = item
+	}
+	nextIDs := make(map[string]bool, len(next.Items))
+
+	for _, item := range next.Items {
+		nextIDs[item.ID] = true
+		change := revisionChange{Target: itemTarget, ItemID: item.ID, ItemName: itemName(item)}
+
+		old, existed := prevItems[item.ID]
+		if !existed {
+			change.Kind = string(wishlistgen.Added)
+			changes = append(changes, change)
+			if item.Booked {
+				change.Kind = string(wishlistgen.Booked)
+				changes = append(changes, change)
+			}
+			continue
+		}
+
+		if itemFields := diffItemFields(old, item); len(itemFields) > 0 {
+			modified := change
+			modified.Kind = string(wishlistgen.Modified)
+			modified.Fields = itemFields
+			changes = append(changes, modified)
+		}
+		switch {
+		case !old.Booked && item.Booked:
+			change.Kind = string(wishlistgen.Booked)
+			changes = append(changes, change)
+		case old.Booked && !item.Booked:
+			change.Kind = string(wishlistgen.Unbooked)
+			changes = append(changes, change)
+		}
+	}
+
+	for _, item := range prev.Items {
+		if !nextIDs[item.ID] {
+			changes = append(changes, revisionChange{
+				Target:   itemTarget,
+				ItemID:   item.ID,
+				ItemName: itemName(item),
+				Kind:     string(wishlistgen.Removed),
+			})
+		}
+	}
+
+	return changes
+}
+
+func diffItemFields(old, next revisionItem) []string {
+	var fields []string
+	if old.Type != next.Type {
+		fields = append(fields, "type")
+	}
+
+	var dataFields []string
+	for key, value := range next.Data {
+		if oldValue, ok := old.Data[key]; !ok || !reflect.DeepEqual(oldValue, value) {
+			dataFields = append(dataFields, "data."+key)
+		}
+	}
+	for key := range old.Data {
+		if _, ok := next.Data[key]; !ok {
+			dataFields = append(dataFields, "data."+key)
+		}
+	}
+	sort.Strings(dataFields)
+
+	return append(fields, dataFields...)
+}
+
+// revertItems rebuilds the items array as it was in snapshot. Current items
+// keep their bookings; items missing from the snapshot are moved to the trash.
+// Items moved to another wishlist since, listed in elsewhere, are left there
+// so that an item ID stays in one wishlist.
+func revertItems(current []mongoWishlistItem, snapshot []revisionItem, elsewhere map[string]bool, now time.Time) []mongoWishlistItem {
+	byID := make(map[string]mongoWishlistItem, len(current))
+	for _, item := range current {
+		byID[item.ID] = item
+	}
+
+	items := make([]mongoWishlistItem, 0, len(current)+len(snapshot))
+	inSnapshot := make(map[string]bool, len(snapshot))
+	for _, snap := range snapshot {
+		inSnapshot[snap.ID] = true
+		if _, ok := byID[snap.ID]; !ok && elsewhere[snap.ID] {
+			continue
+		}
+		items = append(items, revertItem(byID[snap.ID], snap, now))
+	}
+
+	for _, item := range current {
+		if inSnapshot[item.ID] {
+			continue
+		}
+		if item.DeletedAt == nil {
+			item.DeletedAt = &now
+			item.UpdatedAt = now
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// revertItem returns current with its type and data taken from snap, out of
+// the trash. A zero current (item purged since) is re-created from snap.
+func revertItem(current mongoWishlistItem, snap revisionItem, now time.Time) mongoWishlistItem {
+	if current.ID == "" {
+		return mongoWishlistItem{
+			ID:        snap.ID,
+			Type:      snap.Type,
+			Data:      snap.Data,
+			CreatedAt: snap.CreatedAt,
+			UpdatedAt: now,
+		}
+	}
+
+	if current.DeletedAt != nil || current.Type != snap.Type || !reflect.DeepEqual(current.Data, snap.Data) {
+		current.UpdatedAt = now
+	}
+	current.Type = snap.Type
+	current.Data = snap.Data
+	current.DeletedAt = nil
+	return current
+}
+
+// itemsElsewhere returns which of the snapshot items missing from mw are now
+// in another wishlist, trashed ones included
+func (r *MongoRepo) itemsElsewhere(ctx context.Context, mw *mongoWishlist, snapshot []revisionItem) (map[string]bool, error) {
+	var missing []string
+	for _, snap := range snapshot {
+		if findItem(mw, snap.ID) == nil {
+			missing = append(missing, snap.ID)
+		}
+	}
+	elsewhere := make(map[string]bool)
+	if len(missing) == 0 {
+		return elsewhere, nil
+	}
+
+	opts := options.Find().SetProjection(bson.M{"items.id": 1})
+	cursor, err := r.wishlists.Find(ctx, bson.M{"uuid": bson.M{"$ne": mw.UUID}, "items.id": bson.M{"$in": missing}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	var others []mongoWishlist
+	if err := cursor.All(ctx, &others); err != nil {
+		return nil, err
+	}
+	for _, other := range others {
+		for _, item := range other.Items {
+			elsewhere[item.ID] = true
+		}
+	}
+	return elsewhere, nil
+}
+
+// revisionRecord is what appendRevision worked out, kept for the activity feed
+type revisionRecord struct {
+	prev    *revisionSnapshot
+	changes []revisionChange
+}
+
+// appendRevision appends the revision produced by a write to the history;
+// ctx carries the transaction of the write when there is one.
+func (r *MongoRepo) appendRevision(ctx context.Context, mw *mongoWishlist, actorID *openapi_types.UUID, action string) (revisionRecord, error) {
+	snapshot := snapshotOf(mw)
+
+	var prev mongoRevision
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+	err := r.history.FindOne(ctx, bson.M{"wishlistId": mw.UUID, "version": bson.M{"$lt": mw.Version}}, opts).Decode(&prev)
+
+	var (
+		changes      []revisionChange
+		prevSnapshot *revisionSnapshot
+	)
+	switch {
+	case err == nil:
+		prevSnapshot = &prev.Snapshot
+		changes = diffSnapshots(prevSnapshot, snapshot)
+	case err == mongo.ErrNoDocuments && mw.Version <= 1:
+		changes = diffSnapshots(nil, snapshot)
+	case err == mongo.ErrNoDocuments:
+		// Wishlist predates history: this revision becomes the baseline
+		changes = []revisionChange{}
+	default:
+		return revisionRecord{}, fmt.Errorf("failed to load previous revision: %w", err)
+	}
+
+	revision := mongoRevision{
+		WishlistID: mw.UUID,
+		Version:    mw.Version,
+		Action:     action,
+		Changes:    changes,
+		Snapshot:   snapshot,
+		CreatedAt:  time.Now(),
+	}
+	if actorID != nil {
+		actor := actorID.String()
+		revision.ActorID = &actor
+	}
+
+	if _, err := r.history.InsertOne(ctx, revision); err != nil {
+		return revisionRecord{}, fmt.Errorf("failed to record revision: %w", err)
+	}
+	return revisionRecord{prev: prevSnapshot, changes: changes}, nil
+}
+
+// GetHistory returns up to limit revisions older than before (all when before is 0), newest first
+func (r *MongoRepo) GetHistory(ctx context.Context, wishlistID, userID openapi_types.UUID, before int64, limit int) (*wishlistgen.WishlistHistory, error) {
+	count, err := r.wishlists.CountDocuments(ctx, bson.M{
+		"uuid":   wishlistID.String(),
+		"userId": userID.String(),
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist: %w", err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	}
+
+	filter := bson.M{"wishlistId": wishlistID.String()}
+	if before > 0 {
+		filter["version"] = bson.M{"$lt": before}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "version", Value: -1}}).
+		SetLimit(int64(limit + 1)).
+		SetProjection(bson.M{"snapshot": 0})
+
+	cursor, err := r.history.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revisions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var revisions []mongoRevision
+	if err = cursor.All(ctx, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to decode revisions: %w", err)
+	}
+
+	history := &wishlistgen.WishlistHistory{Revisions: []wishlistgen.WishlistRevision{}}
+	if len(revisions) > limit {
+		revisions = revisions[:limit]
+		next := strconv.FormatInt(revisions[limit-1].Version, 10)
+		history.NextCursor = &next
+	}
+	for _, revision := range revisions {
+		history.Revisions = append(history.Revisions, convertToAPIRevision(revision))
+	}
+
+	return history, nil
+}
+
+func convertToAPIRevision(revision mongoRevision) wishlistgen.WishlistRevision {
+	var actorID *openapi_types.UUID
+	if revision.ActorID != nil {
+		if id, err := uuid.Parse(*revision.ActorID); err == nil {
+			actorID = &id
+		}
+	}
+
+	changes := make([]wishlistgen.RevisionChange, 0, len(revision.Changes))
+	for _, change := range revision.Changes {
+		apiChange := wishlistgen.RevisionChange{
+			Target: wishlistgen.RevisionChangeTarget(change.Target),
+			Kind:   wishlistgen.RevisionChangeKind(change.Kind),
+		}
+		if change.ItemID != "" {
+			if id, err := uuid.Parse(change.ItemID); err == nil {
+				apiChange.ItemId = &id
+			}
+		}
+		if change.ItemName != "" {
+			name := change.ItemName
+			apiChange.ItemName = &name
+		}
+		if len(change.Fields) > 0 {
+			fields := change.Fields
+			apiChange.Fields = &fields
+		}
+		changes = append(changes, apiChange)
+	}
+
+	return wishlistgen.WishlistRevision{
+		Revision:  revision.Version,
+		ActorId:   actorID,
+		Action:    revision.Action,
+		Changes:   changes,
+		CreatedAt: revision.CreatedAt,
+	}
+}
+
+// loadRevert fetches the live wishlist owned by userID and the requested
+// revision of it, checking ifMatch against the current version.
+func (r *MongoRepo) loadRevert(ctx context.Context, wishlistID, userID openapi_types.UUID, revision int64, ifMatch []int64) (*mongoWishlist, *mongoRevision, error) {
+	current, err := r.findOwned(ctx, wishlistID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if ifMatch != nil && !containsVersion(ifMatch, current.Version) {
+		return nil, nil, ErrVersionMismatch
+	}
+
+	var target mongoRevision
+	err = r.history.FindOne(ctx, bson.M{"wishlistId": wishlistID.String(), "version": revision}).Decode(&target)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil, fmt.Errorf("revision not found")
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to find revision: %w", err)
+	}
+
+	return current, &target, nil
+}
+
+func containsVersion(versions []int64, version int64) bool {
+	for _, v := range versions {
+		if v == version {
+			return true
+		}
+	}
+	return false
+}
+
+// RevertWishlist restores title, description and items of a wishlist as of revision
+func (r *MongoRepo) RevertWishlist(ctx context.Context, wishlistID, userID openapi_types.UUID, revision int64, ifMatch []int64) (*wishlistgen.Wishlist, error) {
+	current, target, err := r.loadRevert(ctx, wishlistID, userID, revision, ifMatch)
+	if err != nil {
+		return nil, err
+	}
+
+	elsewhere, err := r.itemsElsewhere(ctx, current, target.Snapshot.Items)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find moved items: %w", err)
+	}
+
+	now := time.Now()
+	filter := bson.M{
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"title":       target.Snapshot.Title,
+			"description": target.Snapshot.Description,
+			"items":       revertItems(current.Items, target.Snapshot.Items, elsewhere, now),
+			"updatedAt":   now,
+		},
+	}
+
+	// The items array is rewritten from current, so it must not have moved on meanwhile
+	mw, err := r.commitUpdate(ctx, filter, update, []int64{current.Version}, &userID, "revert_wishlist")
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revert wishlist: %w", err)
+	}
+
+	wishlist := r.convertToAPIWishlist(*mw)
+	return &wishlist, nil
+}
+
+// RevertWishlistItem restores a single item as of revision, re-adding it if needed
+func (r *MongoRepo) RevertWishlistItem(ctx context.Context, wishlistID, itemID, userID openapi_types.UUID, revision int64, ifMatch []int64) (*wishlistgen.WishlistItem, int64, error) {
+	current, target, err := r.loadRevert(ctx, wishlistID, userID, revision, ifMatch)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var snap *revisionItem
+	for i := range target.Snapshot.Items {
+		if target.Snapshot.Items[i].ID == itemID.String() {
+			snap = &target.Snapshot.Items[i]
+			break
+		}
+	}
+	if snap == nil {
+		return nil, 0, fmt.Errorf("item not found in revision")
+	}
+
+	now := time.Now()
+	items := make([]mongoWishlistItem, 0, len(current.Items)+1)
+	found := false
+	for _, item := range current.Items {
+		if item.ID == snap.ID {
+			item = revertItem(item, *snap, now)
+			found = true
+		}
+		items = append(items, item)
+	}
+	if !found {
+		elsewhere, err := r.itemsElsewhere(ctx, current, []revisionItem{*snap})
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to find moved items: %w", err)
+		}
+		if elsewhere[snap.ID] {
+			return nil, 0, ErrItemMoved
+		}
+		items = append(items, revertItem(mongoWishlistItem{}, *snap, now))
+	}
+
+	filter := bson.M{
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"items":     items,
+			"updatedAt": now,
+		},
+	}
+
+	mw, err := r.commitUpdate(ctx, filter, update, []int64{current.Version}, &userID, "revert_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return nil, 0, fmt.Errorf("wishlist not found or not owned by user")
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to revert wishlist item: %w", err)
+	}
+
+	item := findItem(mw, itemID.String())
+	if item == nil {
+		return nil, 0, fmt.Errorf("item not found in revision")
+	}
+	reverted := convertToAPIItem(*item)
+	return &reverted, mw.Version, nil
+}
+
+// List revisions of a wishlist (owner only)
+func (s *WishlistServer) GetWishlistsWishlistIdHistory(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdHistoryParams) {
+	s.logger.LogRequest(r, nil, "get_history")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	limit := defaultHistoryLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxHistoryLimit {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_history", fmt.Sprintf("invalid limit %d", *params.Limit))
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxHistoryLimit))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	var before int64
+	if params.Cursor != nil {
+		before, err = strconv.ParseInt(*params.Cursor, 10, 64)
+		if err != nil || before < 1 {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_history", "invalid cursor")
+			s.writeError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	history, err := s.repo.GetHistory(r.Context(), wishlistId, userID, before, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "get_history", err, fmt.Sprintf("failed to retrieve history of wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve history")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_history", fmt.Sprintf("retrieved %d revisions of wishlist %s", len(history.Revisions), wishlistId.String()))
+	s.writeJSON(w, http.StatusOK, history)
+}
+
+// decodeRevertRequest reads and validates a revert body, writing the error response itself
+func (s *WishlistServer) decodeRevertRequest(w http.ResponseWriter, r *http.Request, userID openapi_types.UUID, action string) (*wishlistgen.RevertRequest, bool) {
+	var req wishlistgen.RevertRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.LogBadRequest(r.Context(), &userID, action, fmt.Sprintf("malformed JSON: %v", err))
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return nil, false
+	}
+	if req.Revision < 1 {
+		s.logger.LogBadRequest(r.Context(), &userID, action, fmt.Sprintf("invalid revision %d", req.Revision))
+		s.writeError(w, http.StatusBadRequest, "revision must be a positive number")
+		return nil, false
+	}
+	return &req, true
+}
+
+// Revert a wishlist to a previous revision (owner only)
+func (s *WishlistServer) PostWishlistsWishlistIdRevert(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdRevertParams) {
+	s.logger.LogRequest(r, nil, "revert_wishlist")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	req, ok := s.decodeRevertRequest(w, r, userID, "revert_wishlist")
+	if !ok {
+		return
+	}
+
+	wishlist, err := s.repo.RevertWishlist(r.Context(), wishlistId, userID, req.Revision, ifMatchVersions(params.IfMatch))
+	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "revert_wishlist", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "revision", fmt.Sprintf("%d of wishlist %s", req.Revision, wishlistId.String()))
+			s.writeError(w, http.StatusNotFound, "Wishlist or revision not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "revert_wishlist", err, fmt.Sprintf("failed to revert wishlist %s to revision %d", wishlistId.String(), req.Revision))
+		s.writeError(w, http.StatusInternalServerError, "Failed to revert wishlist")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "revert_wishlist", fmt.Sprintf("reverted wishlist %s to revision %d", wishlistId.String(), req.Revision))
+	setETag(w, wishlist.Version)
+	s.writeJSON(w, http.StatusOK, wishlist)
+}
+
+// Revert a single item to a previous revision (owner only)
+func (s *WishlistServer) PostWishlistsWishlistIdItemsItemIdRevert(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsItemIdRevertParams) {
+	s.logger.LogRequest(r, nil, "revert_item")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	req, ok := s.decodeRevertRequest(w, r, userID, "revert_item")
+	if !ok {
+		return
+	}
+
+	item, version, err := s.repo.RevertWishlistItem(r.Context(), wishlistId, itemId, userID, req.Revision, ifMatchVersions(params.IfMatch))
+	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "revert_item", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
+		if errors.Is(err, ErrItemMoved) {
+			s.logger.LogConflict(r.Context(), &userID, "revert_item", fmt.Sprintf("item %s was moved out of wishlist %s", itemId.String(), wishlistId.String()))
+			s.writeError(w, http.StatusConflict, "Item has been moved to another wishlist")
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "revision", fmt.Sprintf("%d of item %s in wishlist %s", req.Revision, itemId.String(), wishlistId.String()))
+			s.writeError(w, http.StatusNotFound, "Wishlist, revision or item not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "revert_item", err, fmt.Sprintf("failed to revert item %s in wishlist %s to revision %d", itemId.String(), wishlistId.String(), req.Revision))
+		s.writeError(w, http.StatusInternalServerError, "Failed to revert item")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "revert_item", fmt.Sprintf("reverted item %s in wishlist %s to revision %d", itemId.String(), wishlistId.String(), req.Revision))
+	setETag(w, version)
+	s.writeJSON(w, http.StatusOK, item)
+}
@@ -0,0 +1,225 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func changeKinds(changes []revisionChange) []string {
+	kinds := make([]string, len(changes))
+	for i, c := range changes {
+		kinds[i] = c.Target + ":" + c.ItemID + ":" + c.Kind
+	}
+	return kinds
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	desc := "birthday"
+	base := revisionSnapshot{
+		Title: "Wishes",
+		Items: []revisionItem{
+			{ID: "a", Type: "external", Data: map[string]interface{}{"name": "Book", "url": "https://x"}},
+			{ID: "b", Type: "external", Data: map[string]interface{}{"name": "Pen"}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		prev     *revisionSnapshot
+		next     revisionSnapshot
+		expected []string
+	}{
+		{
+			name:     "created",
+			prev:     nil,
+			next:     revisionSnapshot{Title: "Wishes", Items: []revisionItem{}},
+			expected: []string{"wishlist::added"},
+		},
+		{
+			name:     "unchanged",
+			prev:     &base,
+			next:     base,
+			expected: []string{},
+		},
+		{
+			name:     "title_and_description",
+			prev:     &base,
+			next:     revisionSnapshot{Title: "Gifts", Description: &desc, Items: base.Items},
+			expected: []string{"wishlist::modified"},
+		},
+		{
+			name:     "trashed",
+			prev:     &base,
+			next:     revisionSnapshot{Title: "Wishes", Deleted: true, Items: base.Items},
+			expected: []string{"wishlist::removed"},
+		},
+		{
+			name: "item_added_and_removed",
+			prev: &base,
+			next: revisionSnapshot{Title: "Wishes", Items: []revisionItem{
+				base.Items[0],
+				{ID: "c", Type: "external", Data: map[string]interface{}{"name": "Cup"}},
+			}},
+			expected: []string{"item:c:added", "item:b:removed"},
+		},
+		{
+			name: "item_modified_and_booked",
+			prev: &base,
+			next: revisionSnapshot{Title: "Wishes", Items: []revisionItem{
+				{ID: "a", Type: "external", Data: map[string]interface{}{"name": "Book 2", "url": "https://x"}, Booked: true},
+				base.Items[1],
+			}},
+			expected: []string{"item:a:modified", "item:a:booked"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := changeKinds(diffSnapshots(tt.prev, tt.next))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDiffSnapshotsFields(t *testing.T) {
+	prev := revisionSnapshot{Title: "Wishes", Items: []revisionItem{
+		{ID: "a", Type: "external", Data: map[string]interface{}{"name": "Book", "url": "https://x", "price": 10}},
+	}}
+	next := revisionSnapshot{Title: "Gifts", Items: []revisionItem{
+		{ID: "a", Type: "custom", Data: map[string]interface{}{"name": "Book", "url": "https://y", "image": "i"}},
+	}}
+
+	changes := diffSnapshots(&prev, next)
+	if len(changes) != 2 {
+		t.Fatalf("Expected 2 changes, got %d: %+v", len(changes), changes)
+	}
+	if !reflect.DeepEqual(changes[0].Fields, []string{"title"}) {
+		t.Errorf("Expected wishlist fields [title], got %v", changes[0].Fields)
+	}
+	expected := []string{"type", "data.image", "data.price", "data.url"}
+	if !reflect.DeepEqual(changes[1].Fields, expected) {
+		t.Errorf("Expected item fields %v, got %v", expected, changes[1].Fields)
+	}
+	if changes[1].ItemName != "Book" {
+		t.Errorf("Expected item name Book, got %q", changes[1].ItemName)
+	}
+}
+
+func TestRevertItems(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	booking := &mongoItemBooking{BookingID: "bk"}
+
+	current := []mongoWishlistItem{
+		{ID: "a", Type: "external", Data: map[string]interface{}{"name": "Book 2"}, Booking: booking, UpdatedAt: earlier},
+		{ID: "b", Type: "external", Data: map[string]interface{}{"name": "Pen"}, DeletedAt: &earlier, UpdatedAt: earlier},
+		{ID: "c", Type: "external", Data: map[string]interface{}{"name": "Cup"}, UpdatedAt: earlier},
+	}
+	snapshot := []revisionItem{
+		{ID: "a", Type: "external", Data: map[string]interface{}{"name": "Book"}},
+		{ID: "b", Type: "external", Data: map[string]interface{}{"name": "Pen"}},
+		{ID: "d", Type: "external", Data: map[string]interface{}{"name": "Lamp"}, CreatedAt: earlier},
+	}
+
+	items := revertItems(current, snapshot, nil, now)
+
+	ids := make([]string, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	if !reflect.DeepEqual(ids, []string{"a", "b", "d", "c"}) {
+		t.Fatalf("Unexpected item order %v", ids)
+	}
+
+	if items[0].Data["name"] != "Book" || items[0].Booking != booking || !items[0].UpdatedAt.Equal(now) {
+		t.Errorf("Expected item a reverted with booking kept, got %+v", items[0])
+	}
+	if items[1].DeletedAt != nil {
+		t.Errorf("Expected item b restored from trash")
+	}
+	if !items[2].CreatedAt.Equal(earlier) || items[2].Booking != nil {
+		t.Errorf("Expected item d re-created from snapshot, got %+v", items[2])
+	}
+	if items[3].DeletedAt == nil || !items[3].DeletedAt.Equal(now) {
+		t.Errorf("Expected item c moved to trash")
+	}
+}
+
+func TestRevertItemsAfterMove(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+	source := &mongoWishlist{UUID: "a", Items: []mongoWishlistItem{
+		{ID: "lamp", Type: "external", Data: map[string]interface{}{"name": "Lamp"}, CreatedAt: earlier},
+		{ID: "book", Type: "external", Data: map[string]interface{}{"name": "Book"}, CreatedAt: earlier},
+	}}
+	before := snapshotOf(source)
+
+	// lamp moves to another wishlist, book is purged from this one
+	moved, err := movedItems(source.Items[:1], wishlistgen.Keep, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	target := &mongoWishlist{UUID: "b", Items: moved}
+	elsewhere := map[string]bool{}
+	for _, item := range target.Items {
+		elsewhere[item.ID] = true
+	}
+
+	items := revertItems(nil, before.Items, elsewhere, now)
+	if len(items) != 1 || items[0].ID != "book" {
+		t.Fatalf("Expected only the purged item to be re-created, got %+v", items)
+	}
+	if findItem(target, "lamp") == nil {
+		t.Error("Expected the moved item to stay in the other wishlist")
+	}
+}
+
+func TestHistoryEndpointsRequireAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	wishlistID := uuid.New()
+	itemID := uuid.New()
+
+	tests := []struct {
+		name    string
+		handler func(w http.ResponseWriter, r *http.Request)
+	}{
+		{
+			name: "get_history",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.GetWishlistsWishlistIdHistory(w, r, wishlistID, wishlistgen.GetWishlistsWishlistIdHistoryParams{})
+			},
+		},
+		{
+			name: "revert_wishlist",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.PostWishlistsWishlistIdRevert(w, r, wishlistID, wishlistgen.PostWishlistsWishlistIdRevertParams{})
+			},
+		},
+		{
+			name: "revert_item",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.PostWishlistsWishlistIdItemsItemIdRevert(w, r, wishlistID, itemID, wishlistgen.PostWishlistsWishlistIdItemsItemIdRevertParams{})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
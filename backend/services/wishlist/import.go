@@ -0,0 +1,385 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	MaxImportBytes       = 1 << 20
+	MaxImportItems       = 500
+	MaxItemSectionLength = 100
+)
+
+// importedDocument is a parsed import before item validation. rows[i] is the
+// row reported in errors for wishlist.Items[i].
+type importedDocument struct {
+	wishlist wishlistgen.PortableWishlist
+	rows     []int
+	errors   []wishlistgen.ImportRowError
+}
+
+func (d *importedDocument) add(row int, item wishlistgen.PortableItem) {
+	d.wishlist.Items = append(d.wishlist.Items, item)
+	d.rows = append(d.rows, row)
+}
+
+func (d *importedDocument) fail(row int, field, message string) {
+	rowError := wishlistgen.ImportRowError{Row: row, Message: message}
+	if field != "" {
+		rowError.Field = &field
+	}
+	d.errors = append(d.errors, rowError)
+}
+
+func parseImport(format wishlistgen.PostWishlistsImportParamsFormat, body []byte) (*importedDocument, error) {
+	switch format {
+	case wishlistgen.PostWishlistsImportParamsFormatJson:
+		return parseJSONImport(body)
+	case wishlistgen.PostWishlistsImportParamsFormatCsv:
+		return parseCSVImport(body)
+	case wishlistgen.PostWishlistsImportParamsFormatMd:
+		return parseMarkdownImport(body), nil
+	case wishlistgen.PostWishlistsImportParamsFormatUrls:
+		return parseURLListImport(body), nil
+	}
+	return nil, fmt.Errorf("unsupported format %q", format)
+}
+
+func parseJSONImport(body []byte) (*importedDocument, error) {
+	doc := &importedDocument{}
+	if err := json.Unmarshal(body, &doc.wishlist); err != nil {
+		return nil, fmt.Errorf("malformed JSON: %w", err)
+	}
+	for i := range doc.wishlist.Items {
+		doc.rows = append(doc.rows, i+1)
+	}
+	return doc, nil
+}
+
+func parseCSVImport(body []byte) (*importedDocument, error) {
+	reader := csv.NewReader(bytes.NewReader(body))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, fmt.Errorf("missing header row")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("malformed CSV: %w", err)
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\uFEFF")))] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, fmt.Errorf("header must contain a name column")
+	}
+
+	doc := &importedDocument{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed CSV: %w", err)
+		}
+		row, _ := reader.FieldPos(0)
+
+		field := func(name string) *string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) || strings.TrimSpace(record[i]) == "" {
+				return nil
+			}
+			value := strings.TrimSpace(record[i])
+			return &value
+		}
+
+		item := wishlistgen.PortableItem{
+			Name:        deref(field("name")),
+			Description: field("description"),
+			Url:         field("url"),
+			Currency:    field("currency"),
+			Section:     field("section"),
+			Type:        field("type"),
+		}
+		if price := field("price"); price != nil {
+			value, err := strconv.ParseFloat(strings.ReplaceAll(*price, ",", "."), 64)
+			if err != nil {
+				doc.fail(row, "price", "price must be a number")
+				continue
+			}
+			item.Price = &value
+		}
+		doc.add(row, item)
+	}
+	return doc, nil
+}
+
+var (
+	markdownLinkPattern  = regexp.MustCompile(`^\[((?:\\.|[^\]\\])*)\]\(([^)\s]+)\)(.*)$`)
+	markdownPricePattern = regexp.MustCompile(`^(\d+(?:[.,]\d+)?)(?:\s+([A-Za-z]{3}))?$`)
+	markdownUnescaper    = strings.NewReplacer(`\\`, `\`, `\[`, `[`, `\]`, `]`)
+)
+
+// parseMarkdownImport reads the task list produced by export: "# title", free text
+// as description, "## section" headings, "- [ ] [name](url) — price currency" items
+// and indented lines as item descriptions. Plain "- name" bullets work too.
+func parseMarkdownImport(body []byte) *importedDocument {
+	doc := &importedDocument{}
+	var (
+		section     *string
+		description []string
+		lastItem    = -1
+	)
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxImportBytes)
+	for row := 1; scanner.Scan(); row++ {
+		line := strings.TrimRight(scanner.Text(), " \t\r")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "":
+			continue
+		case strings.HasPrefix(trimmed, "## "):
+			name := strings.TrimSpace(strings.TrimPrefix(trimmed, "## "))
+			section = &name
+			lastItem = -1
+		case strings.HasPrefix(trimmed, "# ") && doc.wishlist.Title == "" && len(doc.rows) == 0:
+			doc.wishlist.Title = strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			item, err := parseMarkdownItem(trimmed[2:])
+			if err != "" {
+				doc.fail(row, "price", err)
+				lastItem = -1
+				continue
+			}
+			item.Section = section
+			doc.add(row, item)
+			lastItem = len(doc.wishlist.Items) - 1
+		case lastItem >= 0 && line != trimmed:
+			item := &doc.wishlist.Items[lastItem]
+			if item.Description == nil {
+				item.Description = &trimmed
+			} else {
+				joined := *item.Description + "\n" + trimmed
+				item.Description = &joined
+			}
+		case len(doc.rows) == 0 && section == nil:
+			description = append(description, trimmed)
+		}
+	}
+
+	if len(description) > 0 {
+		joined := strings.Join(description, "\n")
+		doc.wishlist.Description = &joined
+	}
+	return doc
+}
+
+// parseMarkdownItem parses the text of a list item after its bullet
+func parseMarkdownItem(text string) (wishlistgen.PortableItem, string) {
+	var item wishlistgen.PortableItem
+
+	text = strings.TrimSpace(text)
+	for _, checkbox := range []string{"[ ] ", "[x] ", "[X] "} {
+		text = strings.TrimPrefix(text, checkbox)
+	}
+
+	rest := text
+	if match := markdownLinkPattern.FindStringSubmatch(text); match != nil {
+		item.Name = markdownUnescaper.Replace(match[1])
+		item.Url = &match[2]
+		rest = match[3]
+	} else if i := strings.Index(text, " — "); i >= 0 {
+		item.Name = text[:i]
+		rest = text[i:]
+	} else {
+		item.Name = text
+		rest = ""
+	}
+	item.Name = strings.TrimSpace(item.Name)
+
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return item, ""
+	}
+	price := strings.TrimSpace(strings.TrimPrefix(rest, "—"))
+	match := markdownPricePattern.FindStringSubmatch(price)
+	if match == nil {
+		return item, fmt.Sprintf("cannot read price %q", price)
+	}
+	value, _ := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", "."), 64)
+	item.Price = &value
+	if match[2] != "" {
+		item.Currency = &match[2]
+	}
+	return item, ""
+}
+
+// parseURLListImport turns every non-empty line into an item named after its link
+func parseURLListImport(body []byte) *importedDocument {
+	doc := &importedDocument{}
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxImportBytes)
+	for row := 1; scanner.Scan(); row++ {
+		link := strings.TrimSpace(scanner.Text())
+		if link == "" {
+			continue
+		}
+		name := link
+		if utf8.RuneCountInString(name) > MaxItemNameLength {
+			name = string([]rune(name)[:MaxItemNameLength])
+		}
+		doc.add(row, wishlistgen.PortableItem{Name: name, Url: &link})
+	}
+	return doc
+}
+
+// portableItemRequest converts an imported item into a create request,
+// validating it the same way as items added through the API
+func portableItemRequest(item wishlistgen.PortableItem) (wishlistgen.CreateWishlistItemRequest, ValidationErrors) {
+	req := wishlistgen.CreateWishlistItemRequest{
+		Type: defaultItemType,
+		Data: wishlistgen.WishlistItemData{
+			Name:                 strings.TrimSpace(item.Name),
+			Description:          item.Description,
+			Url:                  item.Url,
+			AdditionalProperties: map[string]interface{}{},
+		},
+	}
+	if item.Type != nil {
+		req.Type = *item.Type
+	}
+
+	errors := ValidateCreateWishlistItemRequest(req)
+	for i := range errors {
+		errors[i].Field = strings.TrimPrefix(errors[i].Field, "data.")
+	}
+
+	if item.Price != nil {
+		if *item.Price < 0 {
+			errors = append(errors, ValidationError{Field: "price", Message: "price must not be negative"})
+		}
+		req.Data.AdditionalProperties[itemPriceProperty] = *item.Price
+	}
+	if item.Currency != nil {
+		currency := strings.ToUpper(strings.TrimSpace(*item.Currency))
+		if len(currency) != 3 || strings.Trim(currency, "ABCDEFGHIJKLMNOPQRSTUVWXYZ") != "" {
+			errors = append(errors, ValidationError{Field: "currency", Message: "currency must be a 3-letter ISO 4217 code"})
+		}
+		req.Data.AdditionalProperties[itemCurrencyProperty] = currency
+	}
+	if item.Section != nil && strings.TrimSpace(*item.Section) != "" {
+		if err := validateStringField("section", *item.Section, 0, MaxItemSectionLength, false); err != nil {
+			errors = append(errors, *err)
+		}
+		req.Data.AdditionalProperties[itemSectionProperty] = strings.TrimSpace(*item.Section)
+	}
+
+	return req, errors
+}
+
+// importItems validates every parsed item, returning the valid ones and row
+// errors for the rest (including errors found while parsing)
+func importItems(doc *importedDocument) ([]wishlistgen.CreateWishlistItemRequest, []wishlistgen.ImportRowError) {
+	items := make([]wishlistgen.CreateWishlistItemRequest, 0, len(doc.wishlist.Items))
+	rowErrors := append([]wishlistgen.ImportRowError{}, doc.errors...)
+
+	for i, item := range doc.wishlist.Items {
+		req, validationErrors := portableItemRequest(item)
+		if len(validationErrors) > 0 {
+			for _, validationError := range validationErrors {
+				field := validationError.Field
+				rowErrors = append(rowErrors, wishlistgen.ImportRowError{Row: doc.rows[i], Field: &field, Message: validationError.Message})
+			}
+			continue
+		}
+		items = append(items, req)
+	}
+
+	return items, rowErrors
+}
+
+// Create a wishlist from an exported document or a list of URLs
+func (s *WishlistServer) PostWishlistsImport(w http.ResponseWriter, r *http.Request, params wishlistgen.PostWishlistsImportParams) {
+	s.logger.LogRequest(r, nil, "import_wishlist")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxImportBytes))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			s.logger.LogBadRequest(r.Context(), &userID, "import_wishlist", "document too large")
+			s.writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("Document must not exceed %d bytes", MaxImportBytes))
+			return
+		}
+		s.logger.LogBadRequest(r.Context(), &userID, "import_wishlist", fmt.Sprintf("failed to read body: %v", err))
+		s.writeError(w, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+
+	doc, err := parseImport(params.Format, body)
+	if err != nil {
+		validationErrors := ValidationErrors{{Field: "document", Message: err.Error()}}
+		s.logger.LogValidationError(r.Context(), &userID, "import_wishlist", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	if params.Title != nil {
+		doc.wishlist.Title = strings.TrimSpace(*params.Title)
+	}
+	create := wishlistgen.CreateWishlistRequest{Title: doc.wishlist.Title, Description: doc.wishlist.Description}
+	validationErrors := ValidateCreateWishlistRequest(create)
+	if len(doc.wishlist.Items) > MaxImportItems {
+		validationErrors = append(validationErrors, ValidationError{
+			Field:   "items",
+			Message: fmt.Sprintf("must not contain more than %d items", MaxImportItems),
+		})
+	}
+	if len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, "import_wishlist", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	items, rowErrors := importItems(doc)
+
+	wishlist, err := s.repo.CreateWishlist(r.Context(), userID, create, items)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "import_wishlist", err, "failed to create wishlist in database")
+		s.writeError(w, http.StatusInternalServerError, "Failed to import wishlist")
+		return
+	}
+
+	wishlistsCreatedTotal.WithLabelValues("import").Inc()
+	s.logger.LogSuccess(r.Context(), &userID, "import_wishlist", fmt.Sprintf("imported wishlist %s from %s with %d items and %d row errors", wishlist.Id.String(), params.Format, len(items), len(rowErrors)))
+	setETag(w, wishlist.Version)
+	s.writeJSON(w, http.StatusCreated, wishlistgen.ImportResult{
+		Wishlist: *wishlist,
+		Imported: len(items),
+		Errors:   rowErrors,
+	})
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestImportRowErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         wishlistgen.PostWishlistsImportParamsFormat
+		body           string
+		expectedItems  int
+		expectedErrors map[int]string
+	}{
+		{
+			name:          "csv",
+			format:        wishlistgen.PostWishlistsImportParamsFormatCsv,
+			body:          "Name,URL,Price,Currency\nBook,https://x.example,10,usd\n,https://y.example,,\nPen,ftp://pen,abc,\nCup,,5,euros\n",
+			expectedItems: 1,
+			expectedErrors: map[int]string{
+				3: "name",
+				4: "price",
+				5: "currency",
+			},
+		},
+		{
+			name:          "urls",
+			format:        wishlistgen.PostWishlistsImportParamsFormatUrls,
+			body:          "https://a.example/1\n\nnot a link\nhttps://b.example/2\n",
+			expectedItems: 2,
+			expectedErrors: map[int]string{
+				3: "url",
+			},
+		},
+		{
+			name:          "markdown",
+			format:        wishlistgen.PostWishlistsImportParamsFormatMd,
+			body:          "# Gifts\n\n- Book — 10 USD\n- Lamp — cheap\n- [Cup](https://cup.example)\n",
+			expectedItems: 2,
+			expectedErrors: map[int]string{
+				4: "price",
+			},
+		},
+		{
+			name:          "json",
+			format:        wishlistgen.PostWishlistsImportParamsFormatJson,
+			body:          `{"title":"Gifts","items":[{"name":"Book","price":-1},{"name":"Pen","section":"Office"}]}`,
+			expectedItems: 1,
+			expectedErrors: map[int]string{
+				1: "price",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parseImport(tt.format, []byte(tt.body))
+			if err != nil {
+				t.Fatalf("Unexpected parse error: %v", err)
+			}
+
+			items, rowErrors := importItems(doc)
+			if len(items) != tt.expectedItems {
+				t.Errorf("Expected %d items, got %d", tt.expectedItems, len(items))
+			}
+			if len(rowErrors) != len(tt.expectedErrors) {
+				t.Fatalf("Expected %d row errors, got %+v", len(tt.expectedErrors), rowErrors)
+			}
+			for _, rowError := range rowErrors {
+				if rowError.Field == nil || tt.expectedErrors[rowError.Row] != *rowError.Field {
+					t.Errorf("Unexpected row error %d %v: %s", rowError.Row, rowError.Field, rowError.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestPortableItemRequestStoresExtraFields(t *testing.T) {
+	price := 12.5
+	req, errs := portableItemRequest(wishlistgen.PortableItem{
+		Name:     "Book",
+		Price:    &price,
+		Currency: stringPtr("eur"),
+		Section:  stringPtr(" Reading "),
+	})
+	if len(errs) > 0 {
+		t.Fatalf("Unexpected errors: %v", errs)
+	}
+	if req.Type != defaultItemType {
+		t.Errorf("Expected default type, got %q", req.Type)
+	}
+	props := req.Data.AdditionalProperties
+	if props["price"] != 12.5 || props["currency"] != "EUR" || props["section"] != "Reading" {
+		t.Errorf("Unexpected item data %v", props)
+	}
+}
+
+func TestParseImportDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format wishlistgen.PostWishlistsImportParamsFormat
+		body   string
+	}{
+		{name: "csv_without_name_column", format: wishlistgen.PostWishlistsImportParamsFormatCsv, body: "url\nhttps://x.example\n"},
+		{name: "empty_csv", format: wishlistgen.PostWishlistsImportParamsFormatCsv, body: ""},
+		{name: "malformed_json", format: wishlistgen.PostWishlistsImportParamsFormatJson, body: "{"},
+		{name: "unknown_format", format: "xlsx", body: "anything"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseImport(tt.format, []byte(tt.body)); err == nil {
+				t.Error("Expected document error")
+			}
+		})
+	}
+}
+
+func TestImportRequiresAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/wishlists/import?format=urls", strings.NewReader("https://x.example"))
+	s.PostWishlistsImport(rec, req, wishlistgen.PostWishlistsImportParams{Format: wishlistgen.PostWishlistsImportParamsFormatUrls})
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
@@ -0,0 +1,434 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	notificationRetention = 90 * 24 * time.Hour
+	maxInboxSize          = 200
+
+	defaultNotificationLimit = 20
+	maxNotificationLimit     = 100
+)
+
+// mongoNotification is an inbox entry. Its content is the same emailData the
+// email of the event is rendered from, privacy rules already applied.
+type mongoNotification struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	IdempotencyKey string             `bson:"idempotencyKey"`
+	UserID         string             `bson:"userId"`
+	WishlistID     string             `bson:"wishlistId"`
+	Data           emailData          `bson:"data"`
+	ReadAt         *time.Time         `bson:"readAt,omitempty"`
+	CreatedAt      time.Time          `bson:"createdAt"`
+}
+
+type mongoInboxPreferences struct {
+	UserID    string    `bson:"userId"`
+	Muted     []string  `bson:"muted"`
+	UpdatedAt time.Time `bson:"updatedAt"`
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func convertToAPINotification(n mongoNotification) wishlistgen.Notification {
+	notification := wishlistgen.Notification{
+		Id:            n.ID.Hex(),
+		Category:      emailCategoryOf(n.Data.Kind),
+		Type:          n.Data.Kind,
+		WishlistTitle: n.Data.WishlistTitle,
+		ItemName:      optionalString(n.Data.ItemName),
+		BookerName:    optionalString(n.Data.BookerName),
+		Message:       optionalString(n.Data.Message),
+		Read:          n.ReadAt != nil,
+		CreatedAt:     n.CreatedAt,
+	}
+	if id, err := uuid.Parse(n.WishlistID); err == nil {
+		notification.WishlistId = id
+	}
+	if n.Data.DaysLeft > 0 {
+		days := n.Data.DaysLeft
+		notification.DaysLeft = &days
+	}
+	return notification
+}
+
+func (r *MongoRepo) findInboxPreferences(ctx context.Context, userID string) (*mongoInboxPreferences, error) {
+	prefs := &mongoInboxPreferences{UserID: userID, Muted: []string{}}
+	err := r.inboxPreferences.FindOne(ctx, bson.M{"userId": userID}).Decode(prefs)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("failed to find inbox preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+func (p *mongoInboxPreferences) toAPI() wishlistgen.InboxPreferences {
+	prefs := wishlistgen.InboxPreferences{Muted: []wishlistgen.EmailCategory{}}
+	for _, category := range p.Muted {
+		prefs.Muted = append(prefs.Muted, wishlistgen.EmailCategory(category))
+	}
+	return prefs
+}
+
+func (r *MongoRepo) GetInboxPreferences(ctx context.Context, userID openapi_types.UUID) (*wishlistgen.InboxPreferences, error) {
+	prefs, err := r.findInboxPreferences(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	api := prefs.toAPI()
+	return &api, nil
+}
+
+func (r *MongoRepo) UpdateInboxPreferences(ctx context.Context, userID openapi_types.UUID, req wishlistgen.InboxPreferences) (*wishlistgen.InboxPreferences, error) {
+	prefs := mongoInboxPreferences{UserID: userID.String(), Muted: []string{}, UpdatedAt: time.Now()}
+	for _, category := range req.Muted {
+		prefs.Muted = append(prefs.Muted, string(category))
+	}
+
+	_, err := r.inboxPreferences.ReplaceOne(ctx, bson.M{"userId": prefs.UserID}, prefs, options.Replace().SetUpsert(true))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update inbox preferences: %w", err)
+	}
+
+	api := prefs.toAPI()
+	return &api, nil
+}
+
+// AddNotification puts a notification into a user's inbox unless its category
+// is muted. key makes repeated deliveries of the same event no-ops; the oldest
+// notifications beyond maxInboxSize are dropped.
+func (r *MongoRepo) AddNotification(ctx context.Context, key, userID, wishlistID string, data emailData) error {
+	prefs, err := r.findInboxPreferences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	category := string(emailCategoryOf(data.Kind))
+	for _, muted := range prefs.Muted {
+		if muted == category {
+			return nil
+		}
+	}
+
+	_, err = r.notifications.InsertOne(ctx, mongoNotification{
+		IdempotencyKey: key,
+		UserID:         userID,
+		WishlistID:     wishlistID,
+		Data:           data,
+		CreatedAt:      time.Now(),
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to add notification: %w", err)
+	}
+
+	var oldest mongoNotification
+	err = r.notifications.FindOne(ctx, bson.M{"userId": userID}, options.FindOne().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetSkip(maxInboxSize).
+		SetProjection(bson.M{"_id": 1}),
+	).Decode(&oldest)
+	if err == mongo.ErrNoDocuments {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find overflowing notifications: %w", err)
+	}
+	if _, err := r.notifications.DeleteMany(ctx, bson.M{"userId": userID, "_id": bson.M{"$lte": oldest.ID}}); err != nil {
+		return fmt.Errorf("failed to trim inbox: %w", err)
+	}
+	return nil
+}
+
+func (r *MongoRepo) CountUnreadNotifications(ctx context.Context, userID openapi_types.UUID) (int, error) {
+	count, err := r.notifications.CountDocuments(ctx, bson.M{"userId": userID.String(), "readAt": nil})
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unread notifications: %w", err)
+	}
+	return int(count), nil
+}
+
+// ListNotifications returns up to limit notifications older than before (all when zero), newest first
+func (r *MongoRepo) ListNotifications(ctx context.Context, userID openapi_types.UUID, unreadOnly bool, before primitive.ObjectID, limit int) (*wishlistgen.NotificationFeed, error) {
+	filter := bson.M{"userId": userID.String()}
+	if unreadOnly {
+		filter["readAt"] = nil
+	}
+	if !before.IsZero() {
+		filter["_id"] = bson.M{"$lt": before}
+	}
+
+	cursor, err := r.notifications.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit+1)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifications: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var notifications []mongoNotification
+	if err = cursor.All(ctx, &notifications); err != nil {
+		return nil, fmt.Errorf("failed to decode notifications: %w", err)
+	}
+
+	unread, err := r.CountUnreadNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &wishlistgen.NotificationFeed{Notifications: []wishlistgen.Notification{}, UnreadCount: unread}
+	if len(notifications) > limit {
+		notifications = notifications[:limit]
+		next := notifications[limit-1].ID.Hex()
+		feed.NextCursor = &next
+	}
+	for _, n := range notifications {
+		feed.Notifications = append(feed.Notifications, convertToAPINotification(n))
+	}
+	return feed, nil
+}
+
+// SetNotificationRead marks one notification of a user as read or unread
+func (r *MongoRepo) SetNotificationRead(ctx context.Context, userID openapi_types.UUID, id primitive.ObjectID, read bool) error {
+	update := bson.M{"$unset": bson.M{"readAt": ""}}
+	if read {
+		// Keep the first read time when marked read twice
+		update = bson.M{"$min": bson.M{"readAt": time.Now()}}
+	}
+
+	res, err := r.notifications.UpdateOne(ctx, bson.M{"_id": id, "userId": userID.String()}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update notification: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("notification not found")
+	}
+	return nil
+}
+
+func (r *MongoRepo) MarkAllNotificationsRead(ctx context.Context, userID openapi_types.UUID) (int, error) {
+	res, err := r.notifications.UpdateMany(ctx,
+		bson.M{"userId": userID.String(), "readAt": nil},
+		bson.M{"$set": bson.M{"readAt": time.Now()}},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark notifications read: %w", err)
+	}
+	return int(res.ModifiedCount), nil
+}
+
+// inboxNotifier is a domainBus handler adding owner notifications to inboxes
+type inboxNotifier struct {
+	repo *MongoRepo
+}
+
+func (n *inboxNotifier) Notify(ctx context.Context, event domainEvent) error {
+	notification := ownerNotificationOf(event)
+	if notification == nil {
+		return nil
+	}
+	return n.repo.AddNotification(ctx, event.ID, event.OwnerID, event.WishlistID, emailDataOf(notification, ""))
+}
+
+// List the notification inbox of the authenticated user
+func (s *WishlistServer) GetNotifications(w http.ResponseWriter, r *http.Request, params wishlistgen.GetNotificationsParams) {
+	s.logger.LogRequest(r, nil, "get_notifications")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	limit := defaultNotificationLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxNotificationLimit {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_notifications", fmt.Sprintf("invalid limit %d", *params.Limit))
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxNotificationLimit))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	var before primitive.ObjectID
+	if params.Cursor != nil {
+		before, err = primitive.ObjectIDFromHex(*params.Cursor)
+		if err != nil {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_notifications", "invalid cursor")
+			s.writeError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	feed, err := s.repo.ListNotifications(r.Context(), userID, params.Unread != nil && *params.Unread, before, limit)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "get_notifications", err, "failed to retrieve notifications")
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve notifications")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_notifications", fmt.Sprintf("retrieved %d notifications", len(feed.Notifications)))
+	s.writeJSON(w, http.StatusOK, feed)
+}
+
+// Number of unread notifications of the authenticated user
+func (s *WishlistServer) GetNotificationsUnreadCount(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "get_unread_count")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	count, err := s.repo.CountUnreadNotifications(r.Context(), userID)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "get_unread_count", err, "failed to count unread notifications")
+		s.writeError(w, http.StatusInternalServerError, "Failed to count unread notifications")
+		return
+	}
+
+	s.writeJSON(w, http.StatusOK, wishlistgen.UnreadNotificationCount{Count: count})
+}
+
+// Mark every notification of the authenticated user as read
+func (s *WishlistServer) PostNotificationsReadAll(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "mark_all_notifications_read")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	updated, err := s.repo.MarkAllNotificationsRead(r.Context(), userID)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "mark_all_notifications_read", err, "failed to mark notifications read")
+		s.writeError(w, http.StatusInternalServerError, "Failed to mark notifications read")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "mark_all_notifications_read", fmt.Sprintf("marked %d notifications read", updated))
+	s.writeJSON(w, http.StatusOK, wishlistgen.MarkNotificationsReadResponse{Updated: updated})
+}
+
+func (s *WishlistServer) setNotificationRead(w http.ResponseWriter, r *http.Request, notificationId string, read bool) {
+	operation := "mark_notification_unread"
+	if read {
+		operation = "mark_notification_read"
+	}
+	s.logger.LogRequest(r, nil, operation)
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	id, err := primitive.ObjectIDFromHex(notificationId)
+	if err != nil {
+		s.logger.LogNotFound(r.Context(), &userID, "notification", notificationId)
+		s.writeError(w, http.StatusNotFound, "Notification not found")
+		return
+	}
+
+	if err := s.repo.SetNotificationRead(r.Context(), userID, id, read); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "notification", notificationId)
+			s.writeError(w, http.StatusNotFound, "Notification not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, operation, err, fmt.Sprintf("failed to update notification %s", notificationId))
+		s.writeError(w, http.StatusInternalServerError, "Failed to update notification")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, operation, fmt.Sprintf("updated notification %s", notificationId))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Mark a notification as read
+func (s *WishlistServer) PostNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId string) {
+	s.setNotificationRead(w, r, notificationId, true)
+}
+
+// Mark a notification as unread
+func (s *WishlistServer) DeleteNotificationsNotificationIdRead(w http.ResponseWriter, r *http.Request, notificationId string) {
+	s.setNotificationRead(w, r, notificationId, false)
+}
+
+// Get inbox preferences of the authenticated user
+func (s *WishlistServer) GetNotificationsPreferences(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "get_inbox_preferences")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	prefs, err := s.repo.GetInboxPreferences(r.Context(), userID)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "get_inbox_preferences", err, "failed to retrieve inbox preferences")
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve inbox preferences")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_inbox_preferences", "retrieved inbox preferences")
+	s.writeJSON(w, http.StatusOK, prefs)
+}
+
+// Replace inbox preferences of the authenticated user
+func (s *WishlistServer) PutNotificationsPreferences(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "update_inbox_preferences")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	var req wishlistgen.InboxPreferences
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.LogBadRequest(r.Context(), &userID, "update_inbox_preferences", "malformed JSON")
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return
+	}
+
+	if validationErrors := ValidateInboxPreferences(req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, "update_inbox_preferences", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	prefs, err := s.repo.UpdateInboxPreferences(r.Context(), userID, req)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "update_inbox_preferences", err, "failed to update inbox preferences")
+		s.writeError(w, http.StatusInternalServerError, "Failed to update inbox preferences")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "update_inbox_preferences", "updated inbox preferences")
+	s.writeJSON(w, http.StatusOK, prefs)
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestConvertToAPINotification(t *testing.T) {
+	wishlistID := uuid.New()
+	readAt := time.Now()
+
+	t.Run("booking", func(t *testing.T) {
+		n := convertToAPINotification(mongoNotification{
+			ID:         primitive.NewObjectID(),
+			WishlistID: wishlistID.String(),
+			Data:       emailData{Kind: emailKindItemBooked, WishlistTitle: "Birthday", ItemName: "Kettle"},
+			ReadAt:     &readAt,
+		})
+		if n.Category != wishlistgen.Bookings || n.Type != emailKindItemBooked || n.WishlistId != wishlistID {
+			t.Errorf("Unexpected notification: %+v", n)
+		}
+		if n.ItemName == nil || *n.ItemName != "Kettle" || n.BookerName != nil || n.DaysLeft != nil {
+			t.Errorf("Expected only the item name, got %+v", n)
+		}
+		if !n.Read {
+			t.Error("Expected notification to be read")
+		}
+	})
+
+	t.Run("reminder", func(t *testing.T) {
+		n := convertToAPINotification(mongoNotification{
+			ID:         primitive.NewObjectID(),
+			WishlistID: wishlistID.String(),
+			Data:       emailData{Kind: emailKindEventSoon, WishlistTitle: "Birthday", DaysLeft: 3},
+		})
+		if n.Category != wishlistgen.Reminders || n.DaysLeft == nil || *n.DaysLeft != 3 || n.Read {
+			t.Errorf("Unexpected reminder: %+v", n)
+		}
+	})
+}
+
+func TestInboxNotificationHidesSurpriseDetails(t *testing.T) {
+	data := emailDataOf(ownerNotificationOf(testBookingEvent("book_item", wishlistgen.Surprise)), "")
+	n := convertToAPINotification(mongoNotification{Data: data})
+	if n.ItemName != nil || n.BookerName != nil || n.Message != nil {
+		t.Errorf("Expected no item or booker details, got %+v", n)
+	}
+}
+
+func TestValidateInboxPreferences(t *testing.T) {
+	if errs := ValidateInboxPreferences(wishlistgen.InboxPreferences{Muted: []wishlistgen.EmailCategory{wishlistgen.Reminders}}); len(errs) != 0 {
+		t.Errorf("Expected no errors, got %v", errs)
+	}
+	if errs := ValidateInboxPreferences(wishlistgen.InboxPreferences{Muted: []wishlistgen.EmailCategory{"news"}}); len(errs) != 1 || errs[0].Field != "muted[0]" {
+		t.Errorf("Expected one error on muted[0], got %v", errs)
+	}
+}
+
+func TestInboxRoutesRequireAuth(t *testing.T) {
+	handler := wishlistgen.Handler(NewWishlistServer(nil, nil, time.Hour))
+	id := primitive.NewObjectID().Hex()
+
+	tests := []struct {
+		method string
+		path   string
+	}{
+		{http.MethodGet, "/notifications"},
+		{http.MethodGet, "/notifications/unread-count"},
+		{http.MethodPost, "/notifications/read-all"},
+		{http.MethodPost, "/notifications/" + id + "/read"},
+		{http.MethodDelete, "/notifications/" + id + "/read"},
+		{http.MethodGet, "/notifications/preferences"},
+		{http.MethodPut, "/notifications/preferences"},
+	}
+
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(tt.method, tt.path, strings.NewReader(`{}`)))
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("%s %s: expected status %d, got %d", tt.method, tt.path, http.StatusUnauthorized, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// jwksMaxAge is how long fetched keys are used before they are fetched
+	// again; keys rotated out disappear from the verifier after that
+	jwksMaxAge = time.Hour
+	// jwksMinInterval bounds how often keys are fetched after a successful
+	// fetch, also when a token names a key that is not known yet
+	jwksMinInterval = time.Minute
+	// after failed fetches, the next waits jwksRetryBackoff, doubling up to
+	// jwksMinInterval
+	jwksRetryBackoff = time.Second
+	// jwksFetchTimeout bounds a fetch, which does not end with the request
+	// that started it
+	jwksFetchTimeout = 5 * time.Second
+)
+
+// errKeysUnavailable is returned for tokens that can not be checked because
+// the keys of the user service could not be fetched
+var errKeysUnavailable = errors.New("token keys unavailable")
+
+// jwksKey is a public key of the user service and the algorithm it signs with
+type jwksKey struct {
+	alg    string
+	public interface{}
+}
+
+// jwksKeys holds the JSON Web Key Set of the user service, fetched from url
+// on first use and refreshed as keys are rotated. When fetching fails, the
+// keys fetched before keep working, and tokens of other keys are neither
+// accepted nor rejected until a fetch succeeds.
+type jwksKeys struct {
+	url    string
+	client *http.Client
+	now    func() time.Time
+
+	// fetchMu makes callers wait for a fetch in progress rather than start
+	// their own; mu guards the keys and is not held while fetching
+	fetchMu   sync.Mutex
+	mu        sync.Mutex
+	keys      map[string]jwksKey
+	fetchedAt time.Time
+	failures  int
+	retryAt   time.Time
+}
+
+func newJWKSKeys(url string, client *http.Client) *jwksKeys {
+	return &jwksKeys{url: url, client: client, now: time.Now}
+}
+
+// Key returns the key named kid. It returns errKeysUnavailable when kid is
+// not known and the keys could not be fetched.
+func (j *jwksKeys) Key(ctx context.Context, kid string) (jwksKey, error) {
+	if key, ok, err := j.cached(kid); ok {
+		return key, err
+	}
+	j.fetchMu.Lock()
+	defer j.fetchMu.Unlock()
+	// the keys may have been fetched while waiting for another fetch
+	if key, ok, err := j.cached(kid); ok {
+		return key, err
+	}
+
+	fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jwksFetchTimeout)
+	defer cancel()
+	keys, err := j.fetch(fetchCtx)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := j.now()
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to fetch token keys", "url", j.url, "error", err)
+		j.failures++
+		backoff := jwksRetryBackoff << min(j.failures-1, 6)
+		j.retryAt = now.Add(min(backoff, jwksMinInterval))
+		return j.stale(kid)
+	}
+	j.keys, j.fetchedAt, j.failures, j.retryAt = keys, now, 0, time.Time{}
+	key, ok := keys[kid]
+	if !ok {
+		return jwksKey{}, fmt.Errorf("unknown key %q", kid)
+	}
+	return key, nil
+}
+
+// cached returns the key named kid when no fetch is due, with ok false when
+// the keys should be fetched first
+func (j *jwksKeys) cached(kid string) (_ jwksKey, ok bool, _ error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	now := j.now()
+	key, known := j.keys[kid]
+	switch {
+	case known && now.Sub(j.fetchedAt) < jwksMaxAge:
+		return key, true, nil
+	case now.Before(j.retryAt):
+		key, err := j.stale(kid)
+		return key, true, err
+	case !known && !j.fetchedAt.IsZero() && now.Sub(j.fetchedAt) < jwksMinInterval:
+		return jwksKey{}, true, fmt.Errorf("unknown key %q", kid)
+	}
+	return jwksKey{}, false, nil
+}
+
+// stale returns the key named kid from the last successful fetch, while
+// fetching fails
+func (j *jwksKeys) stale(kid string) (jwksKey, error) {
+	if key, ok := j.keys[kid]; ok {
+		return key, nil
+	}
+	return jwksKey{}, fmt.Errorf("%w: key %q", errKeysUnavailable, kid)
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+func (j *jwksKeys) fetch(ctx context.Context) (_ map[string]jwksKey, err error) {
+	defer observeUserCall("get_jwks", time.Now(), &err)
+	req, err := http.NewRequestWithContext(ctx, "GET", j.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("user service returned status: %d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	keys := make(map[string]jwksKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := parseJWK(jwk)
+		if err != nil {
+			slog.WarnContext(ctx, "skipping token key", "kid", jwk.Kid, "error", err)
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	return keys, nil
+}
+
+// parseJWK decodes an RS256 or EdDSA signing key
+func parseJWK(jwk jsonWebKey) (jwksKey, error) {
+	if jwk.Use != "" && jwk.Use != "sig" {
+		return jwksKey{}, fmt.Errorf("key is for %q", jwk.Use)
+	}
+	switch {
+	case jwk.Kty == "RSA" && jwk.Alg == "RS256":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return jwksKey{}, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return jwksKey{}, errors.New("invalid exponent")
+		}
+		public := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		return jwksKey{alg: jwk.Alg, public: public}, nil
+	case jwk.Kty == "OKP" && jwk.Crv == "Ed25519" && jwk.Alg == "EdDSA":
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return jwksKey{}, errors.New("invalid Ed25519 key")
+		}
+		return jwksKey{alg: jwk.Alg, public: ed25519.PublicKey(x)}, nil
+	}
+	return jwksKey{}, fmt.Errorf("unsupported key type %s/%s", jwk.Kty, jwk.Alg)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func TestJWKSVerifier(t *testing.T) {
+	rsaKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	edPublic, edPrivate, _ := ed25519.GenerateKey(rand.Reader)
+	rsaJWK := jsonWebKey{
+		Kty: "RSA", Kid: "2026-01", Use: "sig", Alg: "RS256",
+		N: base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		E: base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+	}
+	edJWK := jsonWebKey{Kty: "OKP", Kid: "2026-02", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edPublic)}
+
+	var mu sync.Mutex
+	published := []jsonWebKey{rsaJWK}
+	var fetches atomic.Int32
+	userService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		mu.Lock()
+		defer mu.Unlock()
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": published})
+	}))
+	defer userService.Close()
+
+	now := time.Now()
+	jwks := newJWKSKeys(userService.URL, userService.Client())
+	jwks.now = func() time.Time { return now }
+	verifier := newTokenVerifier(jwks, "", "wili-user-service", "wili")
+
+	userID := uuid.New()
+	claims := jwt.MapClaims{"sub": userID.String(), "iss": "wili-user-service", "aud": "wili", "exp": time.Now().Add(time.Hour).Unix()}
+	sign := func(method jwt.SigningMethod, kid string, key interface{}) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatalf("Failed to sign token: %v", err)
+		}
+		return signed
+	}
+	rsaToken := sign(jwt.SigningMethodRS256, "2026-01", rsaKey)
+	edToken := sign(jwt.SigningMethodEdDSA, "2026-02", edPrivate)
+
+	if got, _, err := verifier.Verify(context.Background(), rsaToken); err != nil || got != userID {
+		t.Fatalf("Expected user %s, got %s (%v)", userID, got, err)
+	}
+	if _, _, err := verifier.Verify(context.Background(), edToken); !errors.Is(err, errTokenRejected) {
+		t.Fatalf("Expected a token of an unpublished key to be rejected, got %v", err)
+	}
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("Expected unknown keys to be fetched at most once a minute, got %d fetches", got)
+	}
+
+	// rotation: the new key is published and picked up on the next fetch
+	mu.Lock()
+	published = []jsonWebKey{rsaJWK, edJWK}
+	mu.Unlock()
+	now = now.Add(jwksMinInterval)
+	for _, token := range []string{edToken, rsaToken} {
+		if got, _, err := verifier.Verify(context.Background(), token); err != nil || got != userID {
+			t.Errorf("Expected user %s, got %s (%v)", userID, got, err)
+		}
+	}
+
+	// an RSA key must not verify a token claiming to be HMAC-signed with it
+	forged := sign(jwt.SigningMethodHS256, "2026-01", []byte(rsaJWK.N))
+	if _, _, err := verifier.Verify(context.Background(), forged); !errors.Is(err, errTokenRejected) {
+		t.Errorf("Expected a token with a mismatched algorithm to be rejected, got %v", err)
+	}
+
+	// keys fetched before keep working while the user service is down
+	userService.Close()
+	now = now.Add(jwksMaxAge)
+	if _, _, err := verifier.Verify(context.Background(), edToken); err != nil {
+		t.Errorf("Expected stale keys to keep working, got %v", err)
+	}
+}
+
+func TestJWKSKeysRecoverFromFailedFetch(t *testing.T) {
+	edPublic, edPrivate, _ := ed25519.GenerateKey(rand.Reader)
+	edJWK := jsonWebKey{Kty: "OKP", Kid: "2026-02", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edPublic)}
+
+	var down atomic.Bool
+	down.Store(true)
+	userService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []jsonWebKey{edJWK}})
+	}))
+	defer userService.Close()
+
+	now := time.Now()
+	jwks := newJWKSKeys(userService.URL, userService.Client())
+	jwks.now = func() time.Time { return now }
+	verifier := newTokenVerifier(jwks, "", "wili-user-service", "wili")
+
+	userID := uuid.New()
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.MapClaims{"sub": userID.String(), "iss": "wili-user-service", "aud": "wili", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = "2026-02"
+	signed, _ := token.SignedString(edPrivate)
+
+	// a cold start while the user service is down must not reject the token
+	_, _, err := verifier.Verify(context.Background(), signed)
+	if !errors.Is(err, errKeysUnavailable) || errors.Is(err, errTokenRejected) {
+		t.Fatalf("Expected the keys to be unavailable, got %v", err)
+	}
+	s := NewWishlistServer(nil, nil, time.Hour)
+	rec := httptest.NewRecorder()
+	s.writeAuthError(rec, err)
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status %d, got %d", http.StatusServiceUnavailable, rec.Code)
+	}
+
+	// the next fetch waits for the backoff, not for jwksMinInterval, and
+	// does not end with the cancelled request that started it
+	down.Store(false)
+	if _, _, err := verifier.Verify(context.Background(), signed); !errors.Is(err, errKeysUnavailable) {
+		t.Errorf("Expected no fetch during the backoff, got %v", err)
+	}
+	now = now.Add(jwksRetryBackoff)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got, _, err := verifier.Verify(ctx, signed); err != nil || got != userID {
+		t.Errorf("Expected user %s, got %s (%v)", userID, got, err)
+	}
+}
@@ -1,144 +1,87 @@
 package main
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"log/slog"
 	"net/http"
 	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// LogLevel represents different log levels
-type LogLevel int
-
-const (
-	LogLevelInfo LogLevel = iota
-	LogLevelWarn
-	LogLevelError
-)
-
-// Logger provides structured logging for the wishlist service
-type Logger struct {
-	serviceName string
-}
+// Logger writes the service's logs as structured slog records with consistent
+// fields: action, outcome and user_id. Records logged with a request context
+// also carry the request ID (see telemetry.SetupLogging).
+type Logger struct{}
 
 // NewLogger creates a new logger instance
-func NewLogger(serviceName string) *Logger {
-	return &Logger{serviceName: serviceName}
+func NewLogger() *Logger {
+	return &Logger{}
 }
 
-// LogRequest logs HTTP request information
-func (l *Logger) LogRequest(r *http.Request, userID *openapi_types.UUID, action string) {
-	userStr := "anonymous"
+func (l *Logger) log(ctx context.Context, level slog.Level, msg string, userID *openapi_types.UUID, attrs ...any) {
 	if userID != nil {
-		userStr = userID.String()
+		attrs = append(attrs, "user_id", userID.String())
 	}
+	slog.Log(ctx, level, msg, attrs...)
+}
 
-	log.Printf("[%s] %s request from %s (user: %s) - %s %s",
-		l.serviceName, action, r.RemoteAddr, userStr, r.Method, r.URL.Path)
+// LogRequest logs that a handler started; the access log has the outcome
+func (l *Logger) LogRequest(r *http.Request, userID *openapi_types.UUID, action string) {
+	l.log(r.Context(), slog.LevelDebug, "request received", userID,
+		"action", action, "method", r.Method, "path", r.URL.Path, "remote", r.RemoteAddr)
 }
 
 // LogSuccess logs successful operations
-func (l *Logger) LogSuccess(userID *openapi_types.UUID, action string, details string) {
-	userStr := "anonymous"
-	if userID != nil {
-		userStr = userID.String()
-	}
-
-	log.Printf("[%s] SUCCESS - %s (user: %s): %s",
-		l.serviceName, action, userStr, details)
+func (l *Logger) LogSuccess(ctx context.Context, userID *openapi_types.UUID, action string, details string) {
+	l.log(ctx, slog.LevelInfo, details, userID, "action", action, "outcome", "success")
 }
 
 // LogValidationError logs validation errors with details
-func (l *Logger) LogValidationError(userID *openapi_types.UUID, action string, errors ValidationErrors) {
-	userStr := "anonymous"
-	if userID != nil {
-		userStr = userID.String()
-	}
-
-	log.Printf("[%s] VALIDATION_ERROR - %s (user: %s): %v",
-		l.serviceName, action, userStr, errors)
+func (l *Logger) LogValidationError(ctx context.Context, userID *openapi_types.UUID, action string, errors ValidationErrors) {
+	l.log(ctx, slog.LevelInfo, "validation failed", userID, "action", action, "outcome", "validation_error", "errors", errors)
 }
 
 // LogError logs general errors
-func (l *Logger) LogError(userID *openapi_types.UUID, action string, err error, context string) {
-	userStr := "anonymous"
-	if userID != nil {
-		userStr = userID.String()
-	}
-
-	log.Printf("[%s] ERROR - %s (user: %s): %s - %v",
-		l.serviceName, action, userStr, context, err)
+func (l *Logger) LogError(ctx context.Context, userID *openapi_types.UUID, action string, err error, details string) {
+	l.log(ctx, slog.LevelError, details, userID, "action", action, "outcome", "error", "error", err)
 }
 
 // LogUnauthorized logs unauthorized access attempts
 func (l *Logger) LogUnauthorized(r *http.Request, action string, reason string) {
-	log.Printf("[%s] UNAUTHORIZED - %s from %s: %s",
-		l.serviceName, action, r.RemoteAddr, reason)
+	l.log(r.Context(), slog.LevelInfo, reason, nil, "action", action, "outcome", "unauthorized", "remote", r.RemoteAddr)
 }
 
 // LogNotFound logs resource not found errors
-func (l *Logger) LogNotFound(userID *openapi_types.UUID, resourceType string, resourceID string) {
-	userStr := "anonymous"
-	if userID != nil {
-		userStr = userID.String()
-	}
-
-	log.Printf("[%s] NOT_FOUND - %s '%s' (user: %s)",
-		l.serviceName, resourceType, resourceID, userStr)
+func (l *Logger) LogNotFound(ctx context.Context, userID *openapi_types.UUID, resourceType string, resourceID string) {
+	l.log(ctx, slog.LevelInfo, resourceType+" not found", userID, "outcome", "not_found", "resource", resourceType, "resource_id", resourceID)
 }
 
 // LogBadRequest logs bad request errors with context
-func (l *Logger) LogBadRequest(userID *openapi_types.UUID, action string, reason string) {
-	userStr := "anonymous"
-	if userID != nil {
-		userStr = userID.String()
-	}
-
-	log.Printf("[%s] BAD_REQUEST - %s (user: %s): %s",
-		l.serviceName, action, userStr, reason)
+func (l *Logger) LogBadRequest(ctx context.Context, userID *openapi_types.UUID, action string, reason string) {
+	l.log(ctx, slog.LevelInfo, reason, userID, "action", action, "outcome", "bad_request")
 }
 
 // LogConflict logs conflict errors (e.g., resource already exists)
-func (l *Logger) LogConflict(userID *openapi_types.UUID, action string, reason string) {
-	userStr := "anonymous"
-	if userID != nil {
-		userStr = userID.String()
-	}
-
-	log.Printf("[%s] CONFLICT - %s (user: %s): %s",
-		l.serviceName, action, userStr, reason)
+func (l *Logger) LogConflict(ctx context.Context, userID *openapi_types.UUID, action string, reason string) {
+	l.log(ctx, slog.LevelInfo, reason, userID, "action", action, "outcome", "conflict")
 }
 
 // LogDatabaseOperation logs database operations with performance info
-func (l *Logger) LogDatabaseOperation(operation string, duration time.Duration, success bool, details string) {
-	status := "SUCCESS"
+func (l *Logger) LogDatabaseOperation(ctx context.Context, operation string, duration time.Duration, success bool, details string) {
+	level, outcome := slog.LevelInfo, "success"
 	if !success {
-		status = "FAILED"
+		level, outcome = slog.LevelError, "error"
 	}
-
-	log.Printf("[%s] DB_%s - %s in %v: %s",
-		l.serviceName, status, operation, duration, details)
+	l.log(ctx, level, details, nil, "action", operation, "outcome", outcome, "duration_ms", float64(duration.Microseconds())/1000)
 }
 
 // LogStartup logs service startup information
-func (l *Logger) LogStartup(port string, dbConnection string) {
-	log.Printf("[%s] STARTUP - Service starting on port %s, connected to database: %s",
-		l.serviceName, port, dbConnection)
+func (l *Logger) LogStartup(addr string, database string) {
+	slog.Info("service starting", "addr", addr, "database", database)
 }
 
 // LogShutdown logs service shutdown information
 func (l *Logger) LogShutdown(reason string) {
-	log.Printf("[%s] SHUTDOWN - Service stopping: %s",
-		l.serviceName, reason)
-}
-
-// Helper function to format resource details
-func FormatResourceDetails(resourceType string, resourceID interface{}, additionalInfo ...string) string {
-	details := fmt.Sprintf("%s: %v", resourceType, resourceID)
-	if len(additionalInfo) > 0 {
-		details += fmt.Sprintf(" (%s)", additionalInfo[0])
-	}
-	return details
+	slog.Info("service stopping", "reason", reason)
 }
@@ -4,25 +4,36 @@ import (
 	"context"
 	"encoding/json"
 	"log"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/joho/godotenv"
 	"github.com/theseems/wili/backend/devutil"
+	"github.com/theseems/wili/backend/jobs"
 	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+	"github.com/theseems/wili/backend/telemetry"
 )
 
 func main() {
-	logger := NewLogger("WISHLIST")
-
-	log.Printf("Starting Wili Wishlist Service...")
-
 	if err := godotenv.Load(); err != nil {
 		log.Printf("Warning: .env file not found: %v", err)
 	}
 
+	telemetry.SetupLogging("wishlist")
+	logger := NewLogger()
+	slog.Info("starting Wili Wishlist Service")
+	shutdownTracing, err := telemetry.SetupTracing(context.Background(), "wishlist")
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
+
 	mongoURI := getEnv("MONGODB_URI", "")
 	if mongoURI == "" {
 		panic("MONGODB_URI is not set")
@@ -43,6 +54,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to initialize MongoDB repository: %v", err)
 	}
+	// Without transactions a crash between the writes of a mutation loses its
+	// outbox entry or revision, so standalone servers are for development only
+	if !repo.transactions {
+		if getEnv("MONGO_ALLOW_STANDALONE", "") != "true" {
+			log.Fatalf("MongoDB is a standalone server, which does not support transactions; run it as a replica set (a single-node one is enough) or set MONGO_ALLOW_STANDALONE=true for development")
+		}
+		slog.Warn("MONGO_ALLOW_STANDALONE: MongoDB is a standalone server, mutations and their outbox entries and revisions are written without transactions and moves of items between wishlists are refused; do not run this in production")
+	}
 	defer func() {
 		if err := repo.Close(context.Background()); err != nil {
 			logger.LogShutdown("Error closing MongoDB connection: " + err.Error())
@@ -51,12 +70,127 @@ func main() {
 		}
 	}()
 
-	userClient := NewUserClient(userServiceURL)
-	server := NewWishlistServer(repo, userClient)
+	// Background work stops on SIGINT or SIGTERM
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	jobStore, err := jobs.NewMongoStore(ctx, repo.db, getDurationEnv("JOB_RUN_RETENTION", 30*24*time.Hour))
+	if err != nil {
+		log.Fatalf("Failed to initialize job store: %v", err)
+	}
+	scheduler := jobs.New(jobStore)
+
+	switch source := getEnv("EVENTS_SOURCE", "local"); source {
+	case "local":
+	case "changestream":
+		repo.UseChangeStream(ctx)
+	default:
+		log.Fatalf("Invalid EVENTS_SOURCE: %q (expected local or changestream)", source)
+	}
+
+	trashRetention := getDurationEnv("TRASH_RETENTION", 30*24*time.Hour)
+	addJob(scheduler, "purge_trash", getScheduleEnv("TRASH_PURGE_INTERVAL", "1h"), purgeTrashJob(repo, trashRetention, logger))
+
+	addDispatchJob(scheduler, "dispatch_webhooks", getScheduleEnv("WEBHOOK_DISPATCH_INTERVAL", "5s"), dispatchWebhooksJob(repo, newWebhookClient(), logger))
+
+	userServiceTimeout := getDurationEnv("USER_SERVICE_TIMEOUT", 2*time.Second)
+	var jwks *jwksKeys
+	if jwksURL := getEnv("JWKS_URL", ""); jwksURL != "" {
+		jwks = newJWKSKeys(jwksURL, &http.Client{Timeout: userServiceTimeout, Transport: &telemetry.Transport{}})
+	}
+	verifier := newTokenVerifier(jwks, strings.TrimSpace(getEnv("JWT_SECRET", "")), getEnv("JWT_ISSUER", "wili-user-service"), getEnv("JWT_AUDIENCE", "wili"))
+	if verifier == nil {
+		slog.Warn("neither JWKS_URL nor JWT_SECRET is set, validating every token with the user service")
+	}
+	userCacheSize, err := strconv.Atoi(getEnv("USER_CACHE_SIZE", "10000"))
+	if err != nil {
+		log.Fatalf("Invalid USER_CACHE_SIZE: %v", err)
+	}
+	userCache := newUserCache(getDurationEnv("USER_CACHE_TTL", time.Minute), userCacheSize)
+	userClient := NewUserClient(userServiceURL, getEnv("SERVICE_TOKEN", ""), userServiceTimeout, verifier, userCache)
+	signer := unsubscribeSigner{key: []byte(getEnv("EMAIL_UNSUBSCRIBE_SECRET", ""))}
+
+	bus := newDomainBus()
+	bus.Subscribe((&inboxNotifier{repo: repo}).Notify)
+	var telegram *telegramNotifier
+	if notifyURL := getEnv("TELEGRAM_NOTIFY_URL", ""); notifyURL != "" {
+		telegram = newTelegramNotifier(notifyURL, getEnv("TELEGRAM_NOTIFY_TOKEN", ""), &http.Client{Timeout: outboxSinkTimeout, Transport: &telemetry.Transport{}}, repo)
+		bus.Subscribe(telegram.Notify)
+	}
+	var emailer *emailNotifier
+	smtpHost := getEnv("SMTP_HOST", "")
+	if smtpHost != "" {
+		if len(signer.key) == 0 {
+			log.Fatalf("EMAIL_UNSUBSCRIBE_SECRET is required when SMTP_HOST is set")
+		}
+		smtpPort, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+		if err != nil {
+			log.Fatalf("Invalid SMTP_PORT: %v", err)
+		}
+		if smtpHost == "local" {
+			standin, err := startSMTPStandin("127.0.0.1:2525")
+			if err != nil {
+				log.Fatalf("Failed to start SMTP stand-in: %v", err)
+			}
+			defer standin.Close()
+			smtpHost, smtpPort = "127.0.0.1", 2525
+			slog.Info("SMTP stand-in listening", "addr", standin.Addr())
+		}
+		m, err := newSMTPMailer(smtpHost, smtpPort, getEnv("SMTP_USERNAME", ""), getEnv("SMTP_PASSWORD", ""), getEnv("SMTP_FROM", "Wili <noreply@wili.me>"))
+		if err != nil {
+			log.Fatalf("Invalid SMTP configuration: %v", err)
+		}
+		emailer = newEmailNotifier(repo, userClient, m, signer, getEnv("FRONTEND_URL", "http://localhost:5173"), getEnv("PUBLIC_API_URL", "http://localhost:8081"))
+		bus.Subscribe(emailer.Notify)
+		bus.Subscribe(emailer.SendReceipt)
+		repo.KeepBookerContacts(getDurationEnv("BOOKER_CONTACT_RETENTION", 90*24*time.Hour))
+		addJob(scheduler, "send_email_digests", getScheduleEnv("EMAIL_DIGEST_INTERVAL", "24h"), sendEmailDigestsJob(emailer, logger))
+	}
+
+	leads, err := parseLeadDays(getEnv("REMINDER_LEAD_DAYS", "7,1"))
+	if err != nil {
+		log.Fatalf("Invalid REMINDER_LEAD_DAYS: %v", err)
+	}
+	minViews, err := strconv.ParseInt(getEnv("REMINDER_MIN_VIEWS", "5"), 10, 64)
+	if err != nil {
+		log.Fatalf("Invalid REMINDER_MIN_VIEWS: %v", err)
+	}
+	reminders := &reminderScheduler{repo: repo, telegram: telegram, email: emailer, leads: leads, minViews: minViews, logger: logger}
+	addJob(scheduler, "send_reminders", getScheduleEnv("REMINDER_INTERVAL", "1h"), sendRemindersJob(reminders, logger))
+	natsURL := getEnv("OUTBOX_NATS_URL", "")
+	if natsURL == "local" {
+		standin, err := startNATSStandin("127.0.0.1:4222")
+		if err != nil {
+			log.Fatalf("Failed to start NATS stand-in: %v", err)
+		}
+		defer standin.Close()
+		natsURL = standin.Addr()
+		slog.Info("NATS stand-in listening", "addr", natsURL)
+	}
+	sinks, err := parseOutboxSinks(getEnv("OUTBOX_SINKS", "bus"), bus, getEnv("OUTBOX_HTTP_URL", ""), natsURL, getEnv("OUTBOX_NATS_SUBJECT", defaultNATSSubject))
+	if err != nil {
+		log.Fatalf("Invalid outbox configuration: %v", err)
+	}
+	addDispatchJob(scheduler, "dispatch_outbox", getScheduleEnv("OUTBOX_DISPATCH_INTERVAL", "1s"), dispatchOutboxJob(repo, sinks, logger))
+
+	server := NewWishlistServer(repo, userClient, trashRetention)
+	server.unsubscribeSigner = signer
 
 	r := chi.NewRouter()
 	devutil.EnableCORS(r)
+	r.Use(telemetry.RequestID, telemetry.Tracing, telemetry.AccessLog, telemetry.Metrics)
+	r.Use(auditMiddleware)
 
+	probes := telemetry.NewProbes()
+	probes.AddCheck("mongodb", repo.Ping)
+	// With a local verifier the service keeps serving while the user service
+	// is down, so that is no reason to take it out of rotation
+	if verifier == nil {
+		probes.AddCheck("user-service", userClient.Ping)
+	}
+	r.Handle("/livez", probes.Live())
+	r.Handle("/readyz", probes.Ready())
+	// Kept for probes configured before /livez and /readyz; it checks nothing
 	r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
@@ -67,12 +201,37 @@ func main() {
 		})
 	})
 
+	if metricsAddr := getEnv("METRICS_ADDR", ""); metricsAddr != "" {
+		go telemetry.ServeMetrics(metricsAddr)
+	} else {
+		r.Handle("/metrics", telemetry.MetricsHandler())
+	}
+	r.Handle("/debug/log-level", telemetry.LevelHandler(getEnv("SERVICE_TOKEN", "")))
+
 	r.Mount("/", wishlistgen.Handler(server))
 	devutil.MountSwagger(r, "Wili Wishlist Service API")
 
-	logger.LogStartup(addr, mongoURI+"/"+dbName)
-	log.Printf("User Service URL: %s (cors=%s)", userServiceURL, corsProfile())
-	log.Fatal(http.ListenAndServe(addr, r))
+	logger.LogStartup(addr, dbName)
+	slog.Info("using user service", "url", userServiceURL, "cors", corsProfile())
+	scheduler.Start(ctx)
+
+	httpServer := telemetry.NewServer(addr, r)
+	httpServer.RegisterOnShutdown(server.CloseStreams)
+	if err := telemetry.Serve(ctx, httpServer, probes); err != nil {
+		logger.LogShutdown("HTTP server stopped: " + err.Error())
+	}
+	// Serve also returns when listening fails; stop everything else then too
+	stop()
+
+	logger.LogShutdown("Stopping background jobs")
+	stopCtx, cancel := context.WithTimeout(context.Background(), getDurationEnv("JOB_SHUTDOWN_TIMEOUT", 30*time.Second))
+	defer cancel()
+	if err := scheduler.Stop(stopCtx); err != nil {
+		logger.LogShutdown("Cancelled background jobs still running: " + err.Error())
+	}
+	if err := shutdownTracing(stopCtx); err != nil {
+		logger.LogShutdown("Failed to flush traces: " + err.Error())
+	}
 }
 
 func getEnv(key, defaultValue string) string {
@@ -82,6 +241,49 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		log.Fatalf("Invalid duration in %s: %q", key, value)
+	}
+	return d
+}
+
+// getScheduleEnv reads a job schedule: an interval such as "1h" or a cron
+// expression such as "0 9 * * *" (UTC)
+func getScheduleEnv(key, defaultValue string) jobs.Schedule {
+	value := getEnv(key, defaultValue)
+	schedule, err := jobs.ParseSchedule(value)
+	if err != nil {
+		log.Fatalf("Invalid schedule in %s: %v", key, err)
+	}
+	return schedule
+}
+
+func addJob(scheduler *jobs.Scheduler, name string, schedule jobs.Schedule, run func(ctx context.Context) error) {
+	if err := scheduler.Add(jobs.Job{Name: name, Schedule: schedule, Run: run}); err != nil {
+		log.Fatalf("Failed to schedule %s: %v", name, err)
+	}
+}
+
+// dispatchJobTimeout bounds a dispatcher run: a full batch of calls that each
+// time out after 10s
+const dispatchJobTimeout = 20 * time.Minute
+
+// addDispatchJob schedules a dispatcher: it runs every few seconds, so only
+// failed runs are kept, and a run may outlast its interval to finish a batch
+// whose sinks are slow; claiming entries keeps overlapping runs apart
+func addDispatchJob(scheduler *jobs.Scheduler, name string, schedule jobs.Schedule, run func(ctx context.Context) error) {
+	job := jobs.Job{Name: name, Schedule: schedule, Timeout: dispatchJobTimeout, FailuresOnly: true, Run: run}
+	if err := scheduler.Add(job); err != nil {
+		log.Fatalf("Failed to schedule %s: %v", name, err)
+	}
+}
+
 func corsProfile() string {
 	if len(devutil.AllowedOrigins()) > 0 && devutil.AllowedOrigins()[0] == "http://localhost:5173" {
 		return "dev"
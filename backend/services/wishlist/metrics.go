@@ -0,0 +1,90 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// Business metrics, served on /metrics with the HTTP ones from telemetry
+var (
+	wishlistsCreatedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "wili_wishlists_created_total",
+		Help: "Wishlists created, by source: create, copy or import.",
+	}, []string{"source"})
+	itemsBookedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "wili_items_booked_total",
+		Help: "Items booked.",
+	})
+)
+
+// Outbox dispatch metrics
+var (
+	outboxDispatchedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "wili_outbox_dispatched_total",
+		Help: "Outbox entries every sink accepted.",
+	})
+	outboxFailedAttemptsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "wili_outbox_failed_attempts_total",
+		Help: "Attempts to dispatch an outbox entry that a sink failed.",
+	})
+	outboxDispatchLag = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "wili_outbox_dispatch_lag_seconds",
+		Help:    "Time from a write to its outbox entry reaching every sink.",
+		Buckets: []float64{.1, .5, 1, 2, 5, 10, 30, 60, 300, 600, 1800},
+	})
+	outboxOldestPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "wili_outbox_oldest_pending_seconds",
+		Help: "Age of the oldest outbox entry not dispatched yet, 0 when there is none.",
+	})
+)
+
+// observeOutboxDispatch records an attempt to dispatch entry, as saved after it
+func observeOutboxDispatch(entry mongoOutboxEntry) {
+	if entry.Status != outboxStatusDispatched || entry.DispatchedAt == nil {
+		outboxFailedAttemptsTotal.Inc()
+		return
+	}
+	outboxDispatchedTotal.Inc()
+	outboxDispatchLag.Observe(entry.DispatchedAt.Sub(entry.CreatedAt).Seconds())
+}
+
+var (
+	mongoPoolConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "wili_mongo_pool_connections",
+		Help: "MongoDB pool connections, by state: open or in_use.",
+	}, []string{"state"})
+	mongoPoolCheckoutFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "wili_mongo_pool_checkout_failures_total",
+		Help: "Failed MongoDB connection checkouts, by reason.",
+	}, []string{"reason"})
+	mongoPoolCheckoutWait = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "wili_mongo_pool_checkout_wait_seconds",
+		Help:    "Time to check a connection out of the MongoDB pool.",
+		Buckets: []float64{.0001, .0005, .001, .005, .01, .05, .1, .5, 1, 5},
+	})
+)
+
+// mongoPoolMonitor keeps the MongoDB pool metrics up to date from the
+// driver's connection events
+func mongoPoolMonitor() *event.PoolMonitor {
+	open := mongoPoolConnections.WithLabelValues("open")
+	inUse := mongoPoolConnections.WithLabelValues("in_use")
+	return &event.PoolMonitor{
+		Event: func(e *event.PoolEvent) {
+			switch e.Type {
+			case event.ConnectionCreated:
+				open.Inc()
+			case event.ConnectionClosed:
+				open.Dec()
+			case event.GetSucceeded:
+				inUse.Inc()
+				mongoPoolCheckoutWait.Observe(e.Duration.Seconds())
+			case event.ConnectionReturned:
+				inUse.Dec()
+			case event.GetFailed:
+				mongoPoolCheckoutFailuresTotal.WithLabelValues(e.Reason).Inc()
+			}
+		},
+	}
+}
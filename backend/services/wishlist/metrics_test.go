@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/theseems/wili/backend/telemetry"
+	"go.mongodb.org/mongo-driver/event"
+)
+
+func TestMongoPoolMonitor(t *testing.T) {
+	monitor := mongoPoolMonitor()
+	open := mongoPoolConnections.WithLabelValues("open")
+	inUse := mongoPoolConnections.WithLabelValues("in_use")
+	failures := mongoPoolCheckoutFailuresTotal.WithLabelValues(event.ReasonTimedOut)
+	openBefore, inUseBefore, failuresBefore := testutil.ToFloat64(open), testutil.ToFloat64(inUse), testutil.ToFloat64(failures)
+
+	for _, eventType := range []string{
+		event.ConnectionCreated, event.ConnectionCreated,
+		event.GetSucceeded, event.GetSucceeded, event.ConnectionReturned,
+		event.ConnectionClosed,
+	} {
+		monitor.Event(&event.PoolEvent{Type: eventType, Duration: time.Millisecond})
+	}
+	monitor.Event(&event.PoolEvent{Type: event.GetFailed, Reason: event.ReasonTimedOut})
+
+	if got := testutil.ToFloat64(open) - openBefore; got != 1 {
+		t.Errorf("Expected 1 more open connection, got %v", got)
+	}
+	if got := testutil.ToFloat64(inUse) - inUseBefore; got != 1 {
+		t.Errorf("Expected 1 more connection in use, got %v", got)
+	}
+	if got := testutil.ToFloat64(failures) - failuresBefore; got != 1 {
+		t.Errorf("Expected 1 checkout failure, got %v", got)
+	}
+}
+
+func TestObserveOutboxDispatch(t *testing.T) {
+	created := time.Now().Add(-2 * time.Second)
+	dispatchedAt := created.Add(2 * time.Second)
+	dispatchedBefore, failedBefore := testutil.ToFloat64(outboxDispatchedTotal), testutil.ToFloat64(outboxFailedAttemptsTotal)
+
+	observeOutboxDispatch(mongoOutboxEntry{Status: outboxStatusDispatched, CreatedAt: created, DispatchedAt: &dispatchedAt})
+	observeOutboxDispatch(mongoOutboxEntry{Status: outboxStatusPending, CreatedAt: created})
+
+	if got := testutil.ToFloat64(outboxDispatchedTotal) - dispatchedBefore; got != 1 {
+		t.Errorf("Expected 1 dispatched entry, got %v", got)
+	}
+	if got := testutil.ToFloat64(outboxFailedAttemptsTotal) - failedBefore; got != 1 {
+		t.Errorf("Expected 1 failed attempt, got %v", got)
+	}
+	if !hasSeries(t, "wili_outbox_dispatch_lag_seconds_count") {
+		t.Error("Expected the dispatch lag on /metrics")
+	}
+}
+
+func TestObserveUserCall(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		outcome string
+	}{
+		{"ok", nil, "ok"},
+		{"rejected token", errTokenRejected, "rejected"},
+		{"failed call", errors.New("connection refused"), "error"},
+		{"open circuit", errCircuitOpen, "open_circuit"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := "test_" + tt.outcome
+			observeUserCall(operation, time.Now(), &tt.err)
+
+			expected := `wili_client_request_duration_seconds_count{operation="` + operation + `",outcome="` + tt.outcome + `",target="user"}`
+			if !hasSeries(t, expected) {
+				t.Errorf("Expected %s", expected)
+			}
+		})
+	}
+}
+
+func hasSeries(t *testing.T, series string) bool {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	telemetry.MetricsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	return strings.Contains(rec.Body.String(), series)
+}
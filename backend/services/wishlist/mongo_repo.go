@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -10,26 +11,75 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
 )
 
+// ErrVersionMismatch is returned when a conditional write targets a wishlist
+// whose version no longer matches the one supplied by the client.
+var ErrVersionMismatch = errors.New("wishlist version mismatch")
+
 type MongoRepo struct {
-	client    *mongo.Client
-	db        *mongo.Database
-	wishlists *mongo.Collection
+	client     *mongo.Client
+	db         *mongo.Database
+	wishlists  *mongo.Collection
+	history    *mongo.Collection
+	activity   *mongo.Collection
+	webhooks   *mongo.Collection
+	deliveries *mongo.Collection
+	outbox     *mongo.Collection
+	logger     *Logger
+
+	emailPreferences *mongo.Collection
+	emailQueue       *mongo.Collection
+	emailsSent       *mongo.Collection
+	telegramSent     *mongo.Collection
+
+	notifications    *mongo.Collection
+	inboxPreferences *mongo.Collection
+	reminders        *mongo.Collection
+
+	// contacts holds booker emails for receipts, kept for contactRetention
+	contacts         *mongo.Collection
+	contactRetention time.Duration
+
+	// transactions is set when the deployment is a replica set or sharded
+	// cluster; standalone servers write the outbox right after each mutation.
+	transactions bool
+
+	events       *eventBus
+	changeStream bool
 }
 
 type mongoWishlist struct {
-	ID          primitive.ObjectID  `bson:"_id,omitempty"`
-	UUID        string              `bson:"uuid"` // Store the actual UUID
-	UserID      string              `bson:"userId"`
-	Title       string              `bson:"title"`
-	Description *string             `bson:"description"`
-	Items       []mongoWishlistItem `bson:"items"`
-	CreatedAt   time.Time           `bson:"createdAt"`
-	UpdatedAt   time.Time           `bson:"updatedAt"`
+	ID          primitive.ObjectID   `bson:"_id,omitempty"`
+	UUID        string               `bson:"uuid"` // Store the actual UUID
+	UserID      string               `bson:"userId"`
+	Title       string               `bson:"title"`
+	Description *string              `bson:"description"`
+	Items       []mongoWishlistItem  `bson:"items"`
+	Version     int64                `bson:"version"`
+	Privacy     mongoWishlistPrivacy `bson:"privacy,omitempty"`
+	EventDate   *time.Time           `bson:"eventDate,omitempty"`
+	ShareViews  int64                `bson:"shareViews,omitempty"` // views by anyone but the owner, never returned
+	CreatedAt   time.Time            `bson:"createdAt"`
+	UpdatedAt   time.Time            `bson:"updatedAt"`
+	DeletedAt   *time.Time           `bson:"deletedAt,omitempty"`
+}
+
+// mongoWishlistPrivacy is empty for wishlists that never changed the defaults
+type mongoWishlistPrivacy struct {
+	BookerVisibility string `bson:"bookerVisibility,omitempty"`
+}
+
+// bookerVisibility returns the configured visibility, defaulting to visible
+func (p mongoWishlistPrivacy) bookerVisibility() wishlistgen.WishlistPrivacyBookerVisibility {
+	if p.BookerVisibility == "" {
+		return wishlistgen.Visible
+	}
+	return wishlistgen.WishlistPrivacyBookerVisibility(p.BookerVisibility)
 }
 
 type mongoWishlistItem struct {
@@ -39,6 +89,7 @@ type mongoWishlistItem struct {
 	Booking   *mongoItemBooking      `bson:"booking,omitempty"`
 	CreatedAt time.Time              `bson:"createdAt"`
 	UpdatedAt time.Time              `bson:"updatedAt"`
+	DeletedAt *time.Time             `bson:"deletedAt,omitempty"`
 }
 
 type mongoItemBooking struct {
@@ -47,13 +98,17 @@ type mongoItemBooking struct {
 	BookerName        *string   `bson:"bookerName,omitempty"`
 	Message           *string   `bson:"message,omitempty"`
 	BookedAt          time.Time `bson:"bookedAt"`
+
+	// Booker-only state, never shown to the owner
+	BookerUserID *string    `bson:"bookerUserId,omitempty"`
+	PurchasedAt  *time.Time `bson:"purchasedAt,omitempty"`
 }
 
 func NewMongoRepo(uri, dbName string) (*MongoRepo, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri))
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(uri).SetPoolMonitor(mongoPoolMonitor()).SetMonitor(mongoCommandMonitor()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
 	}
@@ -80,54 +135,368 @@ func NewMongoRepo(uri, dbName string) (*MongoRepo, error) {
 		return nil, fmt.Errorf("failed to create uuid index: %w", err)
 	}
 
+	_, err = wishlists.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "eventDate", Value: 1}},
+		Options: options.Index().SetSparse(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create eventDate index: %w", err)
+	}
+
+	// Booking tokens are looked up by item, which keeps them valid when items move between wishlists
+	_, err = wishlists.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "items.id", Value: 1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create items.id index: %w", err)
+	}
+
+	_, err = wishlists.UpdateMany(ctx,
+		bson.M{"version": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{"version": int64(1)}},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to backfill wishlist versions: %w", err)
+	}
+
+	history := db.Collection("history")
+	_, err = history.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "wishlistId", Value: 1}, {Key: "version", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create history index: %w", err)
+	}
+
+	activity := db.Collection("activity")
+	_, err = activity.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "wishlistId", Value: 1}, {Key: "_id", Value: -1}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create activity index: %w", err)
+	}
+
+	webhooks := db.Collection("webhooks")
+	_, err = webhooks.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "userId", Value: 1}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook indexes: %w", err)
+	}
+
+	deliveries := db.Collection("webhook_deliveries")
+	_, err = deliveries.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "id", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "webhookId", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery indexes: %w", err)
+	}
+
+	outbox := db.Collection("outbox")
+	_, err = outbox.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "idempotencyKey", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}}},
+		{Keys: bson.D{{Key: "dispatchedAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(outboxRetention.Seconds()))},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create outbox indexes: %w", err)
+	}
+
+	emailPreferences := db.Collection("email_preferences")
+	_, err = emailPreferences.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "userId", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email preferences index: %w", err)
+	}
+
+	emailQueue := db.Collection("email_digest")
+	_, err = emailQueue.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "idempotencyKey", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "userId", Value: 1}, {Key: "_id", Value: 1}}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create email digest indexes: %w", err)
+	}
+
+	emailsSent := db.Collection("emails_sent")
+	_, err = emailsSent.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "idempotencyKey", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "sentAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(emailSentRetention.Seconds()))},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sent emails indexes: %w", err)
+	}
+
+	telegramSent := db.Collection("telegram_sent")
+	_, err = telegramSent.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "idempotencyKey", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "sentAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(telegramSentRetention.Seconds()))},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sent telegram notification indexes: %w", err)
+	}
+
+	notifications := db.Collection("notifications")
+	_, err = notifications.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "idempotencyKey", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "userId", Value: 1}, {Key: "_id", Value: -1}}},
+		{Keys: bson.D{{Key: "userId", Value: 1}, {Key: "readAt", Value: 1}}},
+		{Keys: bson.D{{Key: "createdAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(notificationRetention.Seconds()))},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create notification indexes: %w", err)
+	}
+
+	inboxPreferences := db.Collection("inbox_preferences")
+	_, err = inboxPreferences.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "userId", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inbox preferences index: %w", err)
+	}
+
+	reminders := db.Collection("reminders_sent")
+	_, err = reminders.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "key", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "sentAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(int32(reminderClaimRetention.Seconds()))},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create reminder indexes: %w", err)
+	}
+
+	contacts := db.Collection("booker_contacts")
+	_, err = contacts.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{Keys: bson.D{{Key: "bookingId", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "itemId", Value: 1}}},
+		{Keys: bson.D{{Key: "expiresAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create booker contact indexes: %w", err)
+	}
+
+	transactions, err := supportsTransactions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
 	return &MongoRepo{
-		client:    client,
-		db:        db,
-		wishlists: wishlists,
+		client:     client,
+		db:         db,
+		wishlists:  wishlists,
+		history:    history,
+		activity:   activity,
+		webhooks:   webhooks,
+		deliveries: deliveries,
+		outbox:     outbox,
+		logger:     NewLogger(),
+		events:     newEventBus(),
+
+		emailPreferences: emailPreferences,
+		emailQueue:       emailQueue,
+		emailsSent:       emailsSent,
+		telegramSent:     telegramSent,
+		notifications:    notifications,
+		inboxPreferences: inboxPreferences,
+		reminders:        reminders,
+		contacts:         contacts,
+
+		transactions: transactions,
 	}, nil
 }
 
+// supportsTransactions tells replica set members and mongos routers apart
+// from standalone servers, which reject multi-document transactions.
+func supportsTransactions(ctx context.Context, client *mongo.Client) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return false, fmt.Errorf("failed to inspect MongoDB deployment: %w", err)
+	}
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
+
+// liveItem matches a wishlist containing the given item that is not in the trash;
+// positional updates ("items.$") then target that item.
+func liveItem(itemID openapi_types.UUID) bson.M {
+	return bson.M{"$elemMatch": bson.M{"id": itemID.String(), "deletedAt": nil}}
+}
+
 func (r *MongoRepo) findByUUID(ctx context.Context, wishlistUUID openapi_types.UUID) (*mongoWishlist, error) {
 	var mw mongoWishlist
-	err := r.wishlists.FindOne(ctx, bson.M{"uuid": wishlistUUID.String()}).Decode(&mw)
+	err := r.wishlists.FindOne(ctx, bson.M{"uuid": wishlistUUID.String(), "deletedAt": nil}).Decode(&mw)
+	if err != nil {
+		return nil, err
+	}
+	return &mw, nil
+}
+
+// findOwned loads a live wishlist of the given owner
+func (r *MongoRepo) findOwned(ctx context.Context, wishlistID, userID openapi_types.UUID) (*mongoWishlist, error) {
+	var mw mongoWishlist
+	err := r.wishlists.FindOne(ctx, bson.M{
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+	}).Decode(&mw)
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find wishlist: %w", err)
+	}
+	return &mw, nil
+}
+
+// applyUpdate applies update to the wishlist matched by filter, bumps its
+// version and returns the document as it is after the update. When ifMatch is
+// non-nil the update only applies if the current version is one of ifMatch.
+func (r *MongoRepo) applyUpdate(ctx context.Context, filter, update bson.M, ifMatch []int64) (*mongoWishlist, error) {
+	conditional := bson.M{}
+	for k, v := range filter {
+		conditional[k] = v
+	}
+	if ifMatch != nil {
+		conditional["version"] = bson.M{"$in": ifMatch}
+	}
+
+	inc, _ := update["$inc"].(bson.M)
+	if inc == nil {
+		inc = bson.M{}
+	}
+	inc["version"] = int64(1)
+	update["$inc"] = inc
+
+	var mw mongoWishlist
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	err := r.wishlists.FindOneAndUpdate(ctx, conditional, update, opts).Decode(&mw)
+	if err == mongo.ErrNoDocuments {
+		if conflictErr := r.versionConflict(ctx, filter, ifMatch); conflictErr != nil {
+			return nil, conflictErr
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
 	return &mw, nil
 }
 
-func (r *MongoRepo) CreateWishlist(ctx context.Context, userID openapi_types.UUID, req wishlistgen.CreateWishlistRequest) (*wishlistgen.Wishlist, error) {
+// versionConflict reports ErrVersionMismatch when a conditional write matched
+// nothing only because of its version precondition.
+func (r *MongoRepo) versionConflict(ctx context.Context, filter bson.M, ifMatch []int64) error {
+	if ifMatch == nil {
+		return nil
+	}
+	count, err := r.wishlists.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		return fmt.Errorf("failed to check wishlist version: %w", err)
+	}
+	if count > 0 {
+		return ErrVersionMismatch
+	}
+	return nil
+}
+
+func findItem(mw *mongoWishlist, itemID string) *mongoWishlistItem {
+	for i := range mw.Items {
+		if mw.Items[i].ID == itemID {
+			return &mw.Items[i]
+		}
+	}
+	return nil
+}
+
+// CreateWishlist creates a wishlist starting with the given items (e.g. from a template)
+func (r *MongoRepo) CreateWishlist(ctx context.Context, userID openapi_types.UUID, req wishlistgen.CreateWishlistRequest, items []wishlistgen.CreateWishlistItemRequest) (*wishlistgen.Wishlist, error) {
+	now := time.Now()
+
+	docItems := make([]mongoWishlistItem, 0, len(items))
+	for _, item := range items {
+		docItems = append(docItems, mongoWishlistItem{
+			ID:        uuid.New().String(),
+			Type:      item.Type,
+			Data:      convertWishlistItemDataToMap(item.Data),
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	var eventDate *time.Time
+	if req.Event != nil {
+		eventDate = eventDateOf(*req.Event)
+	}
+	return r.insertWishlist(ctx, userID, req.Title, req.Description, eventDate, docItems, "create_wishlist")
+}
+
+// CopyWishlist creates a new wishlist for the owner with the items of an
+// existing one. Bookings are never copied; with onlyUnbooked booked items are skipped.
+func (r *MongoRepo) CopyWishlist(ctx context.Context, wishlistID, userID openapi_types.UUID, req wishlistgen.CopyWishlistRequest) (*wishlistgen.Wishlist, error) {
+	source, err := r.findOwned(ctx, wishlistID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	title := source.Title
+	if req.Title != nil {
+		title = *req.Title
+	}
+	description := source.Description
+	if req.Description != nil {
+		description = req.Description
+	}
+
+	now := time.Now()
+	onlyUnbooked := req.OnlyUnbooked != nil && *req.OnlyUnbooked
+	items := make([]mongoWishlistItem, 0, len(source.Items))
+	for _, item := range source.Items {
+		if item.DeletedAt != nil || (onlyUnbooked && item.Booking != nil) {
+			continue
+		}
+		items = append(items, mongoWishlistItem{
+			ID:        uuid.New().String(),
+			Type:      item.Type,
+			Data:      item.Data,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+
+	return r.insertWishlist(ctx, userID, title, description, nil, items, "copy_wishlist")
+}
+
+func (r *MongoRepo) insertWishlist(ctx context.Context, userID openapi_types.UUID, title string, description *string, eventDate *time.Time, items []mongoWishlistItem, action string) (*wishlistgen.Wishlist, error) {
 	now := time.Now()
 	wishlistUUID := uuid.New() // Generate a proper UUID
 
 	doc := mongoWishlist{
 		UUID:        wishlistUUID.String(),
 		UserID:      userID.String(),
-		Title:       req.Title,
-		Description: req.Description,
-		Items:       []mongoWishlistItem{},
+		Title:       title,
+		Description: description,
+		Items:       items,
+		Version:     1,
+		EventDate:   eventDate,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
 
-	_, err := r.wishlists.InsertOne(ctx, doc)
-	if err != nil {
+	if err := r.commitInsert(ctx, &doc, &userID, action); err != nil {
 		return nil, fmt.Errorf("failed to insert wishlist: %w", err)
 	}
 
-	return &wishlistgen.Wishlist{
-		Id:          openapi_types.UUID(wishlistUUID),
-		UserId:      userID,
-		Title:       req.Title,
-		Description: req.Description,
-		Items:       []wishlistgen.WishlistItem{},
-		CreatedAt:   now,
-		UpdatedAt:   now,
-	}, nil
+	wishlist := r.convertToAPIWishlist(doc)
+	return &wishlist, nil
 }
 
 func (r *MongoRepo) GetWishlistsByUser(ctx context.Context, userID openapi_types.UUID) ([]wishlistgen.Wishlist, error) {
-	filter := bson.M{"userId": userID.String()}
+	filter := bson.M{"userId": userID.String(), "deletedAt": nil}
 	cursor, err := r.wishlists.Find(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find wishlists: %w", err)
@@ -160,25 +529,57 @@ func (r *MongoRepo) GetWishlistByID(ctx context.Context, wishlistID openapi_type
 	return &wishlist, nil
 }
 
-func (r *MongoRepo) DeleteWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID) error {
+// DeleteWishlist moves the wishlist to the trash
+func (r *MongoRepo) DeleteWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID, ifMatch []int64) error {
+	now := time.Now()
+
 	filter := bson.M{
-		"uuid":   wishlistID.String(),
-		"userId": userID.String(),
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
 	}
 
-	result, err := r.wishlists.DeleteOne(ctx, filter)
-	if err != nil {
-		return fmt.Errorf("failed to delete wishlist: %w", err)
+	update := bson.M{
+		"$set": bson.M{"deletedAt": now, "updatedAt": now},
 	}
 
-	if result.DeletedCount == 0 {
+	_, err := r.commitUpdate(ctx, filter, update, ifMatch, &userID, "delete_wishlist")
+	if err == mongo.ErrNoDocuments {
 		return fmt.Errorf("wishlist not found or not owned by user")
 	}
+	if err != nil {
+		return fmt.Errorf("failed to delete wishlist: %w", err)
+	}
 
 	return nil
 }
 
-func (r *MongoRepo) AddItemToWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID, req wishlistgen.CreateWishlistItemRequest) (*wishlistgen.WishlistItem, error) {
+// RestoreWishlist takes a wishlist out of the trash
+func (r *MongoRepo) RestoreWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID) (*wishlistgen.Wishlist, error) {
+	filter := bson.M{
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": bson.M{"$ne": nil},
+	}
+
+	update := bson.M{
+		"$unset": bson.M{"deletedAt": ""},
+		"$set":   bson.M{"updatedAt": time.Now()},
+	}
+
+	mw, err := r.commitUpdate(ctx, filter, update, nil, &userID, "restore_wishlist")
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("wishlist not found in trash")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore wishlist: %w", err)
+	}
+
+	wishlist := r.convertToAPIWishlist(*mw)
+	return &wishlist, nil
+}
+
+func (r *MongoRepo) AddItemToWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID, req wishlistgen.CreateWishlistItemRequest, ifMatch []int64) (*wishlistgen.WishlistItem, int64, error) {
 	now := time.Now()
 	itemID := uuid.New()
 
@@ -191,8 +592,9 @@ func (r *MongoRepo) AddItemToWishlist(ctx context.Context, wishlistID openapi_ty
 	}
 
 	filter := bson.M{
-		"uuid":   wishlistID.String(),
-		"userId": userID.String(),
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
 	}
 
 	update := bson.M{
@@ -200,13 +602,12 @@ func (r *MongoRepo) AddItemToWishlist(ctx context.Context, wishlistID openapi_ty
 		"$set":  bson.M{"updatedAt": now},
 	}
 
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return nil, fmt.Errorf("failed to add item to wishlist: %w", err)
+	mw, err := r.commitUpdate(ctx, filter, update, ifMatch, &userID, "add_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return nil, 0, fmt.Errorf("wishlist not found or not owned by user")
 	}
-
-	if result.ModifiedCount == 0 {
-		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to add item to wishlist: %w", err)
 	}
 
 	return &wishlistgen.WishlistItem{
@@ -215,16 +616,17 @@ func (r *MongoRepo) AddItemToWishlist(ctx context.Context, wishlistID openapi_ty
 		Data:      req.Data,
 		CreatedAt: &now,
 		UpdatedAt: &now,
-	}, nil
+	}, mw.Version, nil
 }
 
-func (r *MongoRepo) UpdateWishlistItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, userID openapi_types.UUID, req wishlistgen.UpdateWishlistItemRequest) (*wishlistgen.WishlistItem, error) {
+func (r *MongoRepo) UpdateWishlistItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, userID openapi_types.UUID, req wishlistgen.UpdateWishlistItemRequest, ifMatch []int64) (*wishlistgen.WishlistItem, int64, error) {
 	now := time.Now()
 
 	filter := bson.M{
-		"uuid":     wishlistID.String(),
-		"userId":   userID.String(),
-		"items.id": itemID.String(),
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+		"items":     liveItem(itemID),
 	}
 
 	update := bson.M{
@@ -238,56 +640,215 @@ func (r *MongoRepo) UpdateWishlistItem(ctx context.Context, wishlistID, itemID o
 		update["$set"].(bson.M)["items.$.type"] = *req.Type
 	}
 	if req.Data != nil {
-		update["$set"].(bson.M)["items.$.data"] = *req.Data
+		update["$set"].(bson.M)["items.$.data"] = convertWishlistItemDataToMap(*req.Data)
 	}
 
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
+	mw, err := r.commitUpdate(ctx, filter, update, ifMatch, &userID, "update_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return nil, 0, fmt.Errorf("wishlist or item not found, or not owned by user")
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to update wishlist item: %w", err)
+		return nil, 0, fmt.Errorf("failed to update wishlist item: %w", err)
 	}
 
-	if result.ModifiedCount == 0 {
-		return nil, fmt.Errorf("wishlist or item not found, or not owned by user")
+	item := findItem(mw, itemID.String())
+	if item == nil {
+		return nil, 0, fmt.Errorf("wishlist or item not found, or not owned by user")
 	}
 
-	// Return updated item (simplified)
-	return &wishlistgen.WishlistItem{
-		Id:        itemID,
-		Type:      *req.Type,
-		Data:      *req.Data,
-		UpdatedAt: &now,
-	}, nil
+	updated := convertToAPIItem(*item)
+	return &updated, mw.Version, nil
 }
 
-func (r *MongoRepo) DeleteWishlistItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, userID openapi_types.UUID) error {
+// DeleteWishlistItem moves the item to the trash, keeping its booking
+func (r *MongoRepo) DeleteWishlistItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, userID openapi_types.UUID, ifMatch []int64) (int64, error) {
 	now := time.Now()
 
 	filter := bson.M{
-		"uuid":   wishlistID.String(),
-		"userId": userID.String(),
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+		"items":     liveItem(itemID),
 	}
 
 	update := bson.M{
-		"$pull": bson.M{"items": bson.M{"id": itemID.String()}},
-		"$set":  bson.M{"updatedAt": now},
+		"$set": bson.M{
+			"items.$.deletedAt": now,
+			"updatedAt":         now,
+		},
 	}
 
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
+	mw, err := r.commitUpdate(ctx, filter, update, ifMatch, &userID, "delete_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return 0, fmt.Errorf("wishlist or item not found, or not owned by user")
+	}
 	if err != nil {
-		return fmt.Errorf("failed to delete wishlist item: %w", err)
+		return 0, fmt.Errorf("failed to delete wishlist item: %w", err)
+	}
+
+	return mw.Version, nil
+}
+
+// RestoreWishlistItem takes an item out of the trash
+func (r *MongoRepo) RestoreWishlistItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, userID openapi_types.UUID) (*wishlistgen.WishlistItem, int64, error) {
+	filter := bson.M{
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+		"items": bson.M{"$elemMatch": bson.M{
+			"id":        itemID.String(),
+			"deletedAt": bson.M{"$ne": nil},
+		}},
 	}
 
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("wishlist or item not found, or not owned by user")
+	now := time.Now()
+	update := bson.M{
+		"$unset": bson.M{"items.$.deletedAt": ""},
+		"$set": bson.M{
+			"items.$.updatedAt": now,
+			"updatedAt":         now,
+		},
 	}
 
-	return nil
+	mw, err := r.commitUpdate(ctx, filter, update, nil, &userID, "restore_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return nil, 0, fmt.Errorf("item not found in trash")
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to restore wishlist item: %w", err)
+	}
+
+	item := findItem(mw, itemID.String())
+	if item == nil {
+		return nil, 0, fmt.Errorf("item not found in trash")
+	}
+
+	restored := convertToAPIItem(*item)
+	return &restored, mw.Version, nil
 }
 
-func (r *MongoRepo) UpdateWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID, title string, description *string) error {
+// GetTrash lists the trashed wishlists of a user and the trashed items of their
+// live wishlists; retention determines when each entry will be purged
+func (r *MongoRepo) GetTrash(ctx context.Context, userID openapi_types.UUID, retention time.Duration) (*wishlistgen.TrashResponse, error) {
+	var trashed []mongoWishlist
+	cursor, err := r.wishlists.Find(ctx, bson.M{
+		"userId":    userID.String(),
+		"deletedAt": bson.M{"$ne": nil},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trashed wishlists: %w", err)
+	}
+	if err = cursor.All(ctx, &trashed); err != nil {
+		return nil, fmt.Errorf("failed to decode trashed wishlists: %w", err)
+	}
+
+	var withTrashedItems []mongoWishlist
+	cursor, err = r.wishlists.Find(ctx, bson.M{
+		"userId":          userID.String(),
+		"deletedAt":       nil,
+		"items.deletedAt": bson.M{"$ne": nil},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find trashed items: %w", err)
+	}
+	if err = cursor.All(ctx, &withTrashedItems); err != nil {
+		return nil, fmt.Errorf("failed to decode trashed items: %w", err)
+	}
+
+	trash := &wishlistgen.TrashResponse{
+		Wishlists: make([]wishlistgen.TrashedWishlist, 0, len(trashed)),
+		Items:     []wishlistgen.TrashedItem{},
+	}
+	for _, mw := range trashed {
+		trash.Wishlists = append(trash.Wishlists, wishlistgen.TrashedWishlist{
+			Wishlist:  r.convertToAPIWishlist(mw),
+			DeletedAt: *mw.DeletedAt,
+			PurgeAt:   mw.DeletedAt.Add(retention),
+		})
+	}
+	for _, mw := range withTrashedItems {
+		for _, item := range mw.Items {
+			if item.DeletedAt == nil {
+				continue
+			}
+			trash.Items = append(trash.Items, wishlistgen.TrashedItem{
+				WishlistId:    uuid.MustParse(mw.UUID),
+				WishlistTitle: mw.Title,
+				Item:          convertToAPIItem(item),
+				DeletedAt:     *item.DeletedAt,
+				PurgeAt:       item.DeletedAt.Add(retention),
+			})
+		}
+	}
+
+	return trash, nil
+}
+
+// PurgeTrash permanently deletes wishlists and items that were trashed before the cutoff
+func (r *MongoRepo) PurgeTrash(ctx context.Context, cutoff time.Time) (int64, int64, error) {
+	deleted, err := r.wishlists.DeleteMany(ctx, bson.M{"deletedAt": bson.M{"$lt": cutoff}})
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to purge trashed wishlists: %w", err)
+	}
+
+	pulled, err := r.wishlists.UpdateMany(ctx,
+		bson.M{"items.deletedAt": bson.M{"$lt": cutoff}},
+		bson.M{"$pull": bson.M{"items": bson.M{"deletedAt": bson.M{"$lt": cutoff}}}},
+	)
+	if err != nil {
+		return deleted.DeletedCount, 0, fmt.Errorf("failed to purge trashed items: %w", err)
+	}
+
+	return deleted.DeletedCount, pulled.ModifiedCount, nil
+}
+
+// GetBookingStatus looks a booking up by its cancellation token, including bookings
+// of trashed items and wishlists
+func (r *MongoRepo) GetBookingStatus(ctx context.Context, itemID openapi_types.UUID, cancellationToken string) (*wishlistgen.BookingStatus, error) {
+	var mw mongoWishlist
+	err := r.wishlists.FindOne(ctx, bson.M{
+		"items": bson.M{"$elemMatch": bson.M{
+			"id":                        itemID.String(),
+			"booking.cancellationToken": cancellationToken,
+		}},
+	}).Decode(&mw)
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("booking not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booking: %w", err)
+	}
+
+	item := findItem(&mw, itemID.String())
+	if item == nil || item.Booking == nil {
+		return nil, fmt.Errorf("booking not found")
+	}
+
+	status := &wishlistgen.BookingStatus{
+		BookingId: uuid.MustParse(item.Booking.BookingID),
+		BookedAt:  item.Booking.BookedAt,
+		State:     wishlistgen.BookingStatusStateActive,
+		Purchased: item.Booking.PurchasedAt != nil,
+	}
+	if name, ok := item.Data["name"].(string); ok {
+		status.ItemName = name
+	}
+	switch {
+	case mw.DeletedAt != nil:
+		status.State = wishlistgen.BookingStatusStateWishlistRemoved
+		status.RemovedAt = mw.DeletedAt
+	case item.DeletedAt != nil:
+		status.State = wishlistgen.BookingStatusStateItemRemoved
+		status.RemovedAt = item.DeletedAt
+	}
+	return status, nil
+}
+
+func (r *MongoRepo) UpdateWishlist(ctx context.Context, wishlistID openapi_types.UUID, userID openapi_types.UUID, title string, description *string, privacy *wishlistgen.WishlistPrivacy, event *wishlistgen.WishlistEvent, ifMatch []int64) (*wishlistgen.Wishlist, error) {
 	filter := bson.M{
-		"uuid":   wishlistID.String(),
-		"userId": userID.String(),
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
 	}
 
 	update := bson.M{
@@ -300,46 +861,39 @@ func (r *MongoRepo) UpdateWishlist(ctx context.Context, wishlistID openapi_types
 	if description != nil {
 		update["$set"].(bson.M)["description"] = *description
 	}
-
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return fmt.Errorf("failed to update wishlist: %w", err)
+	if privacy != nil {
+		update["$set"].(bson.M)["privacy.bookerVisibility"] = string(privacy.BookerVisibility)
+	}
+	if event != nil {
+		if date := eventDateOf(*event); date != nil {
+			update["$set"].(bson.M)["eventDate"] = *date
+		} else {
+			update["$unset"] = bson.M{"eventDate": ""}
+		}
 	}
 
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("wishlist not found or not owned by user")
+	mw, err := r.commitUpdate(ctx, filter, update, ifMatch, &userID, "update_wishlist")
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("wishlist not found or not owned by user")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update wishlist: %w", err)
 	}
 
-	return nil
+	wishlist := r.convertToAPIWishlist(*mw)
+	return &wishlist, nil
 }
 
 func (r *MongoRepo) convertToAPIWishlist(mw mongoWishlist) wishlistgen.Wishlist {
 	wishlistID := openapi_types.UUID(uuid.MustParse(mw.UUID))
 	userID := uuid.MustParse(mw.UserID)
 
-	items := make([]wishlistgen.WishlistItem, len(mw.Items))
-	for i, item := range mw.Items {
-		itemID := uuid.MustParse(item.ID)
-
-		var booking *wishlistgen.ItemBooking
-		if item.Booking != nil {
-			bookingID := uuid.MustParse(item.Booking.BookingID)
-			booking = &wishlistgen.ItemBooking{
-				BookingId:  bookingID,
-				BookerName: item.Booking.BookerName,
-				Message:    item.Booking.Message,
-				BookedAt:   item.Booking.BookedAt,
-			}
-		}
-
-		items[i] = wishlistgen.WishlistItem{
-			Id:        itemID,
-			Type:      item.Type,
-			Data:      convertMapToWishlistItemData(item.Data),
-			Booking:   booking,
-			CreatedAt: &item.CreatedAt,
-			UpdatedAt: &item.UpdatedAt,
+	items := make([]wishlistgen.WishlistItem, 0, len(mw.Items))
+	for _, item := range mw.Items {
+		if item.DeletedAt != nil {
+			continue
 		}
+		items = append(items, convertToAPIItem(item))
 	}
 
 	return wishlistgen.Wishlist{
@@ -348,12 +902,39 @@ func (r *MongoRepo) convertToAPIWishlist(mw mongoWishlist) wishlistgen.Wishlist
 		Title:       mw.Title,
 		Description: mw.Description,
 		Items:       items,
+		Version:     mw.Version,
+		Privacy:     wishlistgen.WishlistPrivacy{BookerVisibility: mw.Privacy.bookerVisibility()},
+		Event:       apiEventOf(mw.EventDate),
 		CreatedAt:   mw.CreatedAt,
 		UpdatedAt:   mw.UpdatedAt,
 	}
 }
 
-func (r *MongoRepo) BookItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, req wishlistgen.BookItemRequest) (*wishlistgen.BookItemResponse, error) {
+func convertToAPIItem(item mongoWishlistItem) wishlistgen.WishlistItem {
+	var booking *wishlistgen.ItemBooking
+	if item.Booking != nil {
+		bookingID := uuid.MustParse(item.Booking.BookingID)
+		booking = &wishlistgen.ItemBooking{
+			BookingId:  bookingID,
+			BookerName: item.Booking.BookerName,
+			Message:    item.Booking.Message,
+			BookedAt:   item.Booking.BookedAt,
+		}
+	}
+
+	return wishlistgen.WishlistItem{
+		Id:        uuid.MustParse(item.ID),
+		Type:      item.Type,
+		Data:      convertMapToWishlistItemData(item.Data),
+		Booking:   booking,
+		CreatedAt: &item.CreatedAt,
+		UpdatedAt: &item.UpdatedAt,
+	}
+}
+
+// BookItem books an item; bookerID is set when the booker is logged in, so
+// reminders can reach them in Telegram and their inbox
+func (r *MongoRepo) BookItem(ctx context.Context, wishlistID, itemID openapi_types.UUID, req wishlistgen.BookItemRequest, bookerID *openapi_types.UUID, ifMatch []int64) (*wishlistgen.BookItemResponse, int64, error) {
 	now := time.Now()
 	bookingID := uuid.New()
 	cancellationToken := uuid.New()
@@ -365,28 +946,40 @@ func (r *MongoRepo) BookItem(ctx context.Context, wishlistID, itemID openapi_typ
 		Message:           req.Message,
 		BookedAt:          now,
 	}
+	if bookerID != nil {
+		id := bookerID.String()
+		booking.BookerUserID = &id
+	}
 
 	filter := bson.M{
-		"uuid":     wishlistID.String(),
-		"items.id": itemID.String(),
+		"uuid":      wishlistID.String(),
+		"deletedAt": nil,
+		"items":     liveItem(itemID),
 	}
 
 	var existing mongoWishlist
 	err := r.wishlists.FindOne(ctx, filter).Decode(&existing)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("wishlist or item not found")
+			return nil, 0, fmt.Errorf("wishlist or item not found")
 		}
-		return nil, fmt.Errorf("failed to find wishlist: %w", err)
+		return nil, 0, fmt.Errorf("failed to find wishlist: %w", err)
 	}
 
-	for _, item := range existing.Items {
-		if item.ID == itemID.String() {
-			if item.Booking != nil {
-				return nil, fmt.Errorf("item is already booked")
-			}
-			break
-		}
+	if item := findItem(&existing, itemID.String()); item != nil && item.Booking != nil {
+		return nil, 0, fmt.Errorf("item is already booked")
+	}
+
+	unbooked := bson.M{
+		"uuid":      wishlistID.String(),
+		"deletedAt": nil,
+		"items":     bson.M{"$elemMatch": bson.M{"id": itemID.String(), "booking": nil, "deletedAt": nil}},
+	}
+
+	// The contact goes first so the receipt finds it as soon as the booking is published
+	savedContact, err := r.saveBookerContact(ctx, wishlistID.String(), itemID.String(), bookingID.String(), req, existing.EventDate, now)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	update := bson.M{
@@ -397,13 +990,15 @@ func (r *MongoRepo) BookItem(ctx context.Context, wishlistID, itemID openapi_typ
 		},
 	}
 
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return nil, fmt.Errorf("failed to book item: %w", err)
+	mw, err := r.commitUpdate(ctx, unbooked, update, ifMatch, nil, "book_item", itemID.String())
+	if err != nil && savedContact {
+		r.contacts.DeleteOne(ctx, bson.M{"bookingId": bookingID.String()})
 	}
-
-	if result.ModifiedCount == 0 {
-		return nil, fmt.Errorf("wishlist or item not found")
+	if err == mongo.ErrNoDocuments {
+		return nil, 0, fmt.Errorf("item is already booked")
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to book item: %w", err)
 	}
 
 	return &wishlistgen.BookItemResponse{
@@ -412,16 +1007,21 @@ func (r *MongoRepo) BookItem(ctx context.Context, wishlistID, itemID openapi_typ
 		BookerName:        req.BookerName,
 		Message:           req.Message,
 		BookedAt:          now,
-	}, nil
+	}, mw.Version, nil
 }
 
-func (r *MongoRepo) UnbookItem(ctx context.Context, wishlistID, itemID, bookingID openapi_types.UUID) error {
+func (r *MongoRepo) UnbookItem(ctx context.Context, wishlistID, itemID, bookingID openapi_types.UUID, userID openapi_types.UUID, ifMatch []int64) (int64, error) {
 	now := time.Now()
 
 	filter := bson.M{
-		"uuid":                    wishlistID.String(),
-		"items.id":                itemID.String(),
-		"items.booking.bookingId": bookingID.String(),
+		"uuid":      wishlistID.String(),
+		"userId":    userID.String(),
+		"deletedAt": nil,
+		"items": bson.M{"$elemMatch": bson.M{
+			"id":                itemID.String(),
+			"booking.bookingId": bookingID.String(),
+			"deletedAt":         nil,
+		}},
 	}
 
 	update := bson.M{
@@ -434,25 +1034,27 @@ func (r *MongoRepo) UnbookItem(ctx context.Context, wishlistID, itemID, bookingI
 		},
 	}
 
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return fmt.Errorf("failed to unbook item: %w", err)
+	mw, err := r.commitUpdate(ctx, filter, update, ifMatch, &userID, "unbook_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return 0, fmt.Errorf("wishlist, item, or booking not found")
 	}
-
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("wishlist, item, or booking not found")
+	if err != nil {
+		return 0, fmt.Errorf("failed to unbook item: %w", err)
 	}
+	r.forgetBookerContacts(ctx, itemID.String())
 
-	return nil
+	return mw.Version, nil
 }
 
-func (r *MongoRepo) UnbookItemByToken(ctx context.Context, wishlistID, itemID openapi_types.UUID, cancellationToken string) error {
+func (r *MongoRepo) UnbookItemByToken(ctx context.Context, itemID openapi_types.UUID, cancellationToken string, ifMatch []int64) (int64, error) {
 	now := time.Now()
 
+	// Bookers may still release bookings of trashed items, and of items moved to another wishlist
 	filter := bson.M{
-		"uuid":                            wishlistID.String(),
-		"items.id":                        itemID.String(),
-		"items.booking.cancellationToken": cancellationToken,
+		"items": bson.M{"$elemMatch": bson.M{
+			"id":                        itemID.String(),
+			"booking.cancellationToken": cancellationToken,
+		}},
 	}
 
 	update := bson.M{
@@ -465,16 +1067,16 @@ func (r *MongoRepo) UnbookItemByToken(ctx context.Context, wishlistID, itemID op
 		},
 	}
 
-	result, err := r.wishlists.UpdateOne(ctx, filter, update)
-	if err != nil {
-		return fmt.Errorf("failed to unbook item: %w", err)
+	mw, err := r.commitUpdate(ctx, filter, update, ifMatch, nil, "unbook_item", itemID.String())
+	if err == mongo.ErrNoDocuments {
+		return 0, fmt.Errorf("wishlist, item, or booking not found (invalid token)")
 	}
-
-	if result.ModifiedCount == 0 {
-		return fmt.Errorf("wishlist, item, or booking not found (invalid token)")
+	if err != nil {
+		return 0, fmt.Errorf("failed to unbook item: %w", err)
 	}
+	r.forgetBookerContacts(ctx, itemID.String())
 
-	return nil
+	return mw.Version, nil
 }
 
 func convertWishlistItemDataToMap(data wishlistgen.WishlistItemData) map[string]interface{} {
@@ -517,6 +1119,11 @@ func convertMapToWishlistItemData(data map[string]interface{}) wishlistgen.Wishl
 	return result
 }
 
+// Ping checks that the primary can be reached
+func (r *MongoRepo) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx, readpref.Primary())
+}
+
 func (r *MongoRepo) Close(ctx context.Context) error {
 	return r.client.Disconnect(ctx)
 }
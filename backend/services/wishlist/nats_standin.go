@@ -0,0 +1,252 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const natsStandinMaxSeen = 10000
+
+// natsStandin is a tiny NATS-compatible server for local development and
+// tests (OUTBOX_NATS_URL=local). It supports CONNECT, PING, SUB, UNSUB, PUB
+// and HPUB, and like a JetStream stream it drops messages whose Nats-Msg-Id
+// was seen before.
+type natsStandin struct {
+	listener net.Listener
+
+	mu     sync.Mutex
+	subs   map[*natsStandinConn]map[string]string // conn -> sid -> subject
+	seen   map[string]bool
+	closed bool
+}
+
+type natsStandinConn struct {
+	conn net.Conn
+	mu   sync.Mutex
+}
+
+func (c *natsStandinConn) write(s string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, err := io.WriteString(c.conn, s)
+	return err
+}
+
+func startNATSStandin(addr string) (*natsStandin, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start NATS stand-in: %w", err)
+	}
+
+	s := &natsStandin{
+		listener: listener,
+		subs:     make(map[*natsStandinConn]map[string]string),
+		seen:     make(map[string]bool),
+	}
+	go s.serve()
+	return s, nil
+}
+
+func (s *natsStandin) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *natsStandin) Close() error {
+	s.mu.Lock()
+	s.closed = true
+	for c := range s.subs {
+		c.conn.Close()
+	}
+	s.mu.Unlock()
+	return s.listener.Close()
+}
+
+func (s *natsStandin) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		c := &natsStandinConn{conn: conn}
+
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			conn.Close()
+			return
+		}
+		s.subs[c] = make(map[string]string)
+		s.mu.Unlock()
+
+		go s.handle(c)
+	}
+}
+
+func (s *natsStandin) handle(c *natsStandinConn) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.subs, c)
+		s.mu.Unlock()
+		c.conn.Close()
+	}()
+
+	if err := c.write(`INFO {"server_id":"wili-standin","version":"2.10.0","headers":true,"max_payload":1048576}` + "\r\n"); err != nil {
+		return
+	}
+
+	reader := bufio.NewReader(c.conn)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(fields[0]) {
+		case "CONNECT", "PONG":
+		case "PING":
+			err = c.write("PONG\r\n")
+		case "SUB":
+			if len(fields) < 3 {
+				err = c.write("-ERR 'Invalid Subscription'\r\n")
+				break
+			}
+			s.mu.Lock()
+			s.subs[c][fields[len(fields)-1]] = fields[1]
+			s.mu.Unlock()
+		case "UNSUB":
+			if len(fields) >= 2 {
+				s.mu.Lock()
+				delete(s.subs[c], fields[1])
+				s.mu.Unlock()
+			}
+		case "PUB", "HPUB":
+			err = s.readPublish(c, reader, fields)
+		default:
+			err = c.write("-ERR 'Unknown Protocol Operation'\r\n")
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// readPublish reads the payload of PUB subject [reply] size or
+// HPUB subject [reply] hdrSize totalSize and routes it to subscribers
+func (s *natsStandin) readPublish(c *natsStandinConn, reader *bufio.Reader, fields []string) error {
+	headers := fields[0] == "HPUB" || fields[0] == "hpub"
+	minFields := 3
+	if headers {
+		minFields = 4
+	}
+	if len(fields) < minFields {
+		return c.write("-ERR 'Invalid Publish'\r\n")
+	}
+
+	subject := fields[1]
+	total, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil || total < 0 {
+		return c.write("-ERR 'Invalid Publish'\r\n")
+	}
+	hdrLen := 0
+	if headers {
+		if hdrLen, err = strconv.Atoi(fields[len(fields)-2]); err != nil || hdrLen > total {
+			return c.write("-ERR 'Invalid Publish'\r\n")
+		}
+	}
+
+	data := make([]byte, total+2)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		return err
+	}
+	data = data[:total]
+
+	if headers {
+		if id := natsMsgID(data[:hdrLen]); id != "" && s.markSeen(id) {
+			return nil
+		}
+	}
+
+	slog.Info("NATS stand-in received a message", "subject", subject, "bytes", total-hdrLen)
+	s.route(subject, data, hdrLen, headers)
+	return nil
+}
+
+// markSeen remembers a message id and reports whether it was already known
+func (s *natsStandin) markSeen(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seen[id] {
+		return true
+	}
+	if len(s.seen) >= natsStandinMaxSeen {
+		s.seen = make(map[string]bool)
+	}
+	s.seen[id] = true
+	return false
+}
+
+func (s *natsStandin) route(subject string, data []byte, hdrLen int, headers bool) {
+	type delivery struct {
+		conn *natsStandinConn
+		sid  string
+	}
+
+	s.mu.Lock()
+	var deliveries []delivery
+	for c, subs := range s.subs {
+		for sid, pattern := range subs {
+			if natsSubjectMatches(pattern, subject) {
+				deliveries = append(deliveries, delivery{c, sid})
+			}
+		}
+	}
+	s.mu.Unlock()
+
+	for _, d := range deliveries {
+		var frame string
+		if headers {
+			frame = fmt.Sprintf("HMSG %s %s %d %d\r\n%s\r\n", subject, d.sid, hdrLen, len(data), data)
+		} else {
+			frame = fmt.Sprintf("MSG %s %s %d\r\n%s\r\n", subject, d.sid, len(data), data)
+		}
+		d.conn.write(frame)
+	}
+}
+
+func natsMsgID(headers []byte) string {
+	reader := textproto.NewReader(bufio.NewReader(strings.NewReader(string(headers))))
+	if _, err := reader.ReadLine(); err != nil { // NATS/1.0
+		return ""
+	}
+	mime, err := reader.ReadMIMEHeader()
+	if err != nil && len(mime) == 0 {
+		return ""
+	}
+	return mime.Get(natsIdempotencyHdr)
+}
+
+// natsSubjectMatches supports the * (one token) and > (the rest) wildcards
+func natsSubjectMatches(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+		if i >= len(subjectTokens) || (token != "*" && token != subjectTokens[i]) {
+			return false
+		}
+	}
+	return len(patternTokens) == len(subjectTokens)
+}
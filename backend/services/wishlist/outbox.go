@@ -0,0 +1,321 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	outboxStatusPending    = "pending"
+	outboxStatusDispatched = "dispatched"
+
+	outboxClaimLease    = time.Minute
+	outboxDispatchBatch = 100
+	outboxBaseBackoff   = 5 * time.Second
+	outboxMaxBackoff    = 10 * time.Minute
+	outboxRetention     = 7 * 24 * time.Hour
+)
+
+// domainEvent is what the outbox publishes for every committed write. ID is
+// the idempotency key: it is the same on every attempt to publish the event.
+type domainEvent struct {
+	ID         string                `json:"id"`
+	Type       string                `json:"type"`
+	WishlistID string                `json:"wishlistId"`
+	OwnerID    string                `json:"ownerId"`
+	Version    int64                 `json:"version"`
+	ActorID    *string               `json:"actorId,omitempty"`
+	ItemIDs    []string              `json:"itemIds,omitempty"`
+	Wishlist   *wishlistgen.Wishlist `json:"wishlist,omitempty"`
+	OccurredAt time.Time             `json:"occurredAt"`
+}
+
+// mongoOutboxEntry is a domain event waiting to reach every sink. Sinks
+// that already accepted it are remembered so that a retry only goes to the
+// others.
+type mongoOutboxEntry struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty"`
+	IdempotencyKey string             `bson:"idempotencyKey"`
+	Type           string             `bson:"type"`
+	WishlistID     string             `bson:"wishlistId"`
+	Payload        string             `bson:"payload"`
+	Status         string             `bson:"status"`
+	DispatchedTo   []string           `bson:"dispatchedTo"`
+	Attempts       int                `bson:"attempts"`
+	NextAttemptAt  time.Time          `bson:"nextAttemptAt"`
+	LockedUntil    *time.Time         `bson:"lockedUntil,omitempty"`
+	LastError      *string            `bson:"lastError,omitempty"`
+	CreatedAt      time.Time          `bson:"createdAt"`
+	DispatchedAt   *time.Time         `bson:"dispatchedAt,omitempty"`
+}
+
+// outboxKey identifies the write that produced version of a wishlist
+func outboxKey(wishlistID string, version int64) string {
+	return fmt.Sprintf("%s:%d", wishlistID, version)
+}
+
+func (r *MongoRepo) newDomainEvent(mw *mongoWishlist, actorID *openapi_types.UUID, action string, itemIDs []string, now time.Time) domainEvent {
+	event := domainEvent{
+		ID:         outboxKey(mw.UUID, mw.Version),
+		Type:       action,
+		WishlistID: mw.UUID,
+		OwnerID:    mw.UserID,
+		Version:    mw.Version,
+		ItemIDs:    itemIDs,
+		OccurredAt: now,
+	}
+	if actorID != nil {
+		actor := actorID.String()
+		event.ActorID = &actor
+	}
+	if mw.DeletedAt == nil {
+		wishlist := r.convertToAPIWishlist(*mw)
+		event.Wishlist = &wishlist
+	}
+	return event
+}
+
+// appendOutbox records the domain event of a write; ctx carries the
+// transaction of the write when there is one.
+func (r *MongoRepo) appendOutbox(ctx context.Context, mw *mongoWishlist, actorID *openapi_types.UUID, action string, itemIDs ...string) error {
+	now := time.Now()
+	event := r.newDomainEvent(mw, actorID, action, itemIDs, now)
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode domain event: %w", err)
+	}
+
+	_, err = r.outbox.InsertOne(ctx, mongoOutboxEntry{
+		IdempotencyKey: event.ID,
+		Type:           action,
+		WishlistID:     mw.UUID,
+		Payload:        string(payload),
+		Status:         outboxStatusPending,
+		DispatchedTo:   []string{},
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to append to outbox: %w", err)
+	}
+	return nil
+}
+
+// transact runs fn in a transaction when the deployment supports them and
+// directly otherwise.
+func (r *MongoRepo) transact(ctx context.Context, fn func(ctx context.Context) error) error {
+	if !r.transactions {
+		return fn(ctx)
+	}
+
+	session, err := r.client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sc)
+	})
+	return err
+}
+
+// recordWrite appends the revision and the outbox entry of a write; ctx
+// carries the transaction of the write when there is one.
+func (r *MongoRepo) recordWrite(ctx context.Context, mw *mongoWishlist, actorID *openapi_types.UUID, action string, itemIDs ...string) (revisionRecord, error) {
+	rec, err := r.appendRevision(ctx, mw, actorID, action)
+	if err != nil {
+		return revisionRecord{}, err
+	}
+	return rec, r.appendOutbox(ctx, mw, actorID, action, itemIDs...)
+}
+
+// commitUpdate is applyUpdate together with the revision and outbox entry of
+// the write, followed by the best-effort side effects of afterWrite.
+func (r *MongoRepo) commitUpdate(ctx context.Context, filter, update bson.M, ifMatch []int64, actorID *openapi_types.UUID, action string, itemIDs ...string) (*mongoWishlist, error) {
+	var (
+		mw  *mongoWishlist
+		rec revisionRecord
+	)
+	err := r.transact(ctx, func(ctx context.Context) error {
+		var err error
+		if mw, err = r.applyUpdate(ctx, filter, update, ifMatch); err != nil {
+			return err
+		}
+		rec, err = r.recordWrite(ctx, mw, actorID, action, itemIDs...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.afterWrite(ctx, mw, rec, actorID, action)
+	return mw, nil
+}
+
+// commitInsert inserts a new wishlist together with its revision and outbox entry
+func (r *MongoRepo) commitInsert(ctx context.Context, doc *mongoWishlist, actorID *openapi_types.UUID, action string) error {
+	var rec revisionRecord
+	err := r.transact(ctx, func(ctx context.Context) error {
+		if _, err := r.wishlists.InsertOne(ctx, doc); err != nil {
+			return err
+		}
+		var err error
+		rec, err = r.recordWrite(ctx, doc, actorID, action)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	r.afterWrite(ctx, doc, rec, actorID, action)
+	return nil
+}
+
+func outboxBackoff(attempts int) time.Duration {
+	delay := outboxBaseBackoff
+	for i := 1; i < attempts && delay < outboxMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > outboxMaxBackoff {
+		delay = outboxMaxBackoff
+	}
+	return delay
+}
+
+// dispatchEntry publishes entry to the sinks that have not accepted it yet and
+// returns it updated with the outcome. It is dispatched once all sinks did.
+func dispatchEntry(ctx context.Context, entry mongoOutboxEntry, sinks []outboxSink, now time.Time) mongoOutboxEntry {
+	done := make(map[string]bool, len(entry.DispatchedTo))
+	for _, name := range entry.DispatchedTo {
+		done[name] = true
+	}
+
+	msg := outboxMessage{
+		IdempotencyKey: entry.IdempotencyKey,
+		Type:           entry.Type,
+		WishlistID:     entry.WishlistID,
+		Body:           []byte(entry.Payload),
+	}
+
+	var failures []string
+	for _, sink := range sinks {
+		if done[sink.Name()] {
+			continue
+		}
+		if err := sink.Publish(ctx, msg); err != nil {
+			failures = append(failures, fmt.Sprintf("%s: %v", sink.Name(), err))
+			continue
+		}
+		entry.DispatchedTo = append(entry.DispatchedTo, sink.Name())
+	}
+
+	entry.LockedUntil = nil
+	if len(failures) == 0 {
+		entry.Status = outboxStatusDispatched
+		entry.DispatchedAt = &now
+		entry.LastError = nil
+		return entry
+	}
+
+	entry.Attempts++
+	lastError := strings.Join(failures, "; ")
+	entry.LastError = &lastError
+	entry.NextAttemptAt = now.Add(outboxBackoff(entry.Attempts))
+	return entry
+}
+
+func (r *MongoRepo) claimOutboxEntry(ctx context.Context, now time.Time) (*mongoOutboxEntry, error) {
+	filter := bson.M{
+		"status":        outboxStatusPending,
+		"nextAttemptAt": bson.M{"$lte": now},
+		"$or":           []bson.M{{"lockedUntil": nil}, {"lockedUntil": bson.M{"$lt": now}}},
+	}
+	update := bson.M{"$set": bson.M{"lockedUntil": now.Add(outboxClaimLease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var entry mongoOutboxEntry
+	err := r.outbox.FindOneAndUpdate(ctx, filter, update, opts).Decode(&entry)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// DispatchOutbox publishes up to one batch of due outbox entries and returns
+// how many were attempted. Entries are only marked dispatched after every sink
+// accepted them, so sinks may see an event more than once.
+func (r *MongoRepo) DispatchOutbox(ctx context.Context, sinks []outboxSink) (int, error) {
+	attempted := 0
+	for attempted < outboxDispatchBatch {
+		entry, err := r.claimOutboxEntry(ctx, time.Now())
+		if err != nil {
+			return attempted, fmt.Errorf("failed to claim outbox entry: %w", err)
+		}
+		if entry == nil {
+			break
+		}
+		attempted++
+
+		dispatched := dispatchEntry(ctx, *entry, sinks, time.Now())
+		// Only save while the lease is still ours: once it expired, another
+		// instance may have claimed the entry and saved its own outcome
+		res, err := r.outbox.ReplaceOne(ctx, bson.M{"_id": entry.ID, "lockedUntil": entry.LockedUntil}, dispatched)
+		if err != nil {
+			return attempted, fmt.Errorf("failed to save outbox entry %s: %w", entry.IdempotencyKey, err)
+		}
+		if res.MatchedCount == 0 {
+			continue
+		}
+
+		observeOutboxDispatch(dispatched)
+	}
+
+	return attempted, r.updateOutboxBacklog(ctx)
+}
+
+// updateOutboxBacklog refreshes the age of the oldest pending entry
+func (r *MongoRepo) updateOutboxBacklog(ctx context.Context) error {
+	var oldest mongoOutboxEntry
+	opts := options.FindOne().SetSort(bson.D{{Key: "_id", Value: 1}}).SetProjection(bson.M{"createdAt": 1})
+	err := r.outbox.FindOne(ctx, bson.M{"status": outboxStatusPending}, opts).Decode(&oldest)
+	if err == mongo.ErrNoDocuments {
+		outboxOldestPending.Set(0)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to find oldest outbox entry: %w", err)
+	}
+	outboxOldestPending.Set(time.Since(oldest.CreatedAt).Seconds())
+	return nil
+}
+
+func dispatchOutboxJob(repo *MongoRepo, sinks []outboxSink, logger *Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		attempted, err := repo.DispatchOutbox(ctx, sinks)
+		if err != nil {
+			return fmt.Errorf("failed to dispatch outbox: %w", err)
+		}
+		if attempted > 0 {
+			logger.LogDatabaseOperation(ctx, "dispatch_outbox", time.Since(start), true, fmt.Sprintf("attempted %d outbox entries", attempted))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	outboxSinkTimeout    = 10 * time.Second
+	defaultNATSSubject   = "wili.wishlist"
+	natsIdempotencyHdr   = "Nats-Msg-Id"
+	outboxIdempotencyHdr = "Idempotency-Key"
+)
+
+// outboxMessage is an outbox entry as handed to a sink. Body is the JSON
+// encoded domainEvent.
+type outboxMessage struct {
+	IdempotencyKey string
+	Type           string
+	WishlistID     string
+	Body           []byte
+}
+
+// outboxSink publishes outbox messages somewhere. Publish must only return nil
+// once the message is accepted; the same message may be published again after
+// a failure or a crash, so consumers deduplicate by IdempotencyKey.
+type outboxSink interface {
+	Name() string
+	Publish(ctx context.Context, msg outboxMessage) error
+}
+
+// domainBus delivers domain events to handlers inside this process
+type domainBus struct {
+	mu       sync.RWMutex
+	handlers []func(ctx context.Context, event domainEvent) error
+}
+
+func newDomainBus() *domainBus {
+	return &domainBus{}
+}
+
+func (b *domainBus) Name() string { return "bus" }
+
+// Subscribe registers handler for every domain event. A handler error makes
+// the dispatcher retry the event for all handlers.
+func (b *domainBus) Subscribe(handler func(ctx context.Context, event domainEvent) error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, handler)
+}
+
+func (b *domainBus) Publish(ctx context.Context, msg outboxMessage) error {
+	var event domainEvent
+	if err := json.Unmarshal(msg.Body, &event); err != nil {
+		return fmt.Errorf("failed to decode domain event: %w", err)
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers
+	b.mu.RUnlock()
+
+	var errs []error
+	for _, handler := range handlers {
+		if err := handler(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// httpSink POSTs every domain event to a URL
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPSink(url string, client *http.Client) *httpSink {
+	return &httpSink{url: url, client: client}
+}
+
+func (s *httpSink) Name() string { return "http" }
+
+func (s *httpSink) Publish(ctx context.Context, msg outboxMessage) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(msg.Body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(outboxIdempotencyHdr, msg.IdempotencyKey)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// natsSink publishes domain events to a NATS server on "<subject>.<type>",
+// with the idempotency key as Nats-Msg-Id so that JetStream streams drop
+// duplicates. It speaks the client protocol directly and confirms every
+// publish with a PING round trip.
+type natsSink struct {
+	addr    string
+	subject string
+
+	mu     sync.Mutex
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// newNATSSink accepts nats://host:port or host:port
+func newNATSSink(rawURL, subject string) (*natsSink, error) {
+	addr := rawURL
+	if strings.Contains(rawURL, "://") {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid NATS URL: %w", err)
+		}
+		if u.Scheme != "nats" {
+			return nil, fmt.Errorf("unsupported NATS URL scheme %q", u.Scheme)
+		}
+		addr = u.Host
+	}
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return nil, fmt.Errorf("invalid NATS address %q: %w", addr, err)
+	}
+	return &natsSink{addr: addr, subject: subject}, nil
+}
+
+func (s *natsSink) Name() string { return "nats" }
+
+func (s *natsSink) Publish(ctx context.Context, msg outboxMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.publish(ctx, msg); err != nil {
+		// The connection state is unknown after a failure, start over next time
+		s.close()
+		return err
+	}
+	return nil
+}
+
+func (s *natsSink) publish(ctx context.Context, msg outboxMessage) error {
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(outboxSinkTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := s.conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	headers := fmt.Sprintf("NATS/1.0\r\n%s: %s\r\n\r\n", natsIdempotencyHdr, msg.IdempotencyKey)
+	subject := s.subject + "." + msg.Type
+	frame := fmt.Sprintf("HPUB %s %d %d\r\n%s%s\r\nPING\r\n", subject, len(headers), len(headers)+len(msg.Body), headers, msg.Body)
+	if _, err := s.conn.Write([]byte(frame)); err != nil {
+		return fmt.Errorf("failed to write to NATS: %w", err)
+	}
+	return s.awaitPong()
+}
+
+func (s *natsSink) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: outboxSinkTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+
+	if err := conn.SetDeadline(time.Now().Add(outboxSinkTimeout)); err != nil {
+		return err
+	}
+	line, err := s.reader.ReadString('\n')
+	if err != nil {
+		return fmt.Errorf("failed to read NATS INFO: %w", err)
+	}
+	if !strings.HasPrefix(line, "INFO ") {
+		return fmt.Errorf("unexpected NATS greeting %q", strings.TrimSpace(line))
+	}
+
+	connect := `CONNECT {"verbose":false,"pedantic":false,"headers":true,"name":"wili-wishlist-outbox"}` + "\r\nPING\r\n"
+	if _, err := conn.Write([]byte(connect)); err != nil {
+		return fmt.Errorf("failed to write NATS CONNECT: %w", err)
+	}
+	return s.awaitPong()
+}
+
+// awaitPong reads until the PONG that answers our PING, failing on -ERR
+func (s *natsSink) awaitPong() error {
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return fmt.Errorf("failed to read from NATS: %w", err)
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := s.conn.Write([]byte("PONG\r\n")); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("NATS error: %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (s *natsSink) close() {
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+		s.reader = nil
+	}
+}
+
+// parseOutboxSinks builds the sinks named in a comma separated list
+func parseOutboxSinks(names string, bus *domainBus, httpURL, natsURL, natsSubject string) ([]outboxSink, error) {
+	var sinks []outboxSink
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		switch name {
+		case "bus":
+			sinks = append(sinks, bus)
+		case "http":
+			if httpURL == "" {
+				return nil, fmt.Errorf("OUTBOX_HTTP_URL is required for the http sink")
+			}
+			sinks = append(sinks, newHTTPSink(httpURL, &http.Client{Timeout: outboxSinkTimeout}))
+		case "nats":
+			if natsURL == "" {
+				return nil, fmt.Errorf("OUTBOX_NATS_URL is required for the nats sink")
+			}
+			sink, err := newNATSSink(natsURL, natsSubject)
+			if err != nil {
+				return nil, err
+			}
+			sinks = append(sinks, sink)
+		default:
+			return nil, fmt.Errorf("unknown outbox sink %q (expected bus, http or nats)", name)
+		}
+	}
+	return sinks, nil
+}
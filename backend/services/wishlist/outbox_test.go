@@ -0,0 +1,256 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type fakeSink struct {
+	name      string
+	err       error
+	published []outboxMessage
+}
+
+func (s *fakeSink) Name() string { return s.name }
+
+func (s *fakeSink) Publish(ctx context.Context, msg outboxMessage) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.published = append(s.published, msg)
+	return nil
+}
+
+func testOutboxEntry() mongoOutboxEntry {
+	wishlistID := uuid.New().String()
+	return mongoOutboxEntry{
+		IdempotencyKey: outboxKey(wishlistID, 3),
+		Type:           "add_item",
+		WishlistID:     wishlistID,
+		Payload:        `{"id":"` + outboxKey(wishlistID, 3) + `","type":"add_item","wishlistId":"` + wishlistID + `","version":3}`,
+		Status:         outboxStatusPending,
+		DispatchedTo:   []string{},
+	}
+}
+
+func TestDispatchEntry(t *testing.T) {
+	now := time.Now()
+
+	t.Run("all_sinks_succeed", func(t *testing.T) {
+		a, b := &fakeSink{name: "a"}, &fakeSink{name: "b"}
+		entry := dispatchEntry(context.Background(), testOutboxEntry(), []outboxSink{a, b}, now)
+
+		if entry.Status != outboxStatusDispatched || entry.DispatchedAt == nil {
+			t.Errorf("Expected dispatched entry, got %+v", entry)
+		}
+		if len(a.published) != 1 || len(b.published) != 1 {
+			t.Errorf("Expected one message per sink, got %d and %d", len(a.published), len(b.published))
+		}
+		if a.published[0].IdempotencyKey != entry.IdempotencyKey {
+			t.Errorf("Expected idempotency key %s, got %s", entry.IdempotencyKey, a.published[0].IdempotencyKey)
+		}
+	})
+
+	t.Run("failed_sink_is_retried_alone", func(t *testing.T) {
+		ok, failing := &fakeSink{name: "ok"}, &fakeSink{name: "failing", err: errors.New("unavailable")}
+		entry := dispatchEntry(context.Background(), testOutboxEntry(), []outboxSink{ok, failing}, now)
+
+		if entry.Status != outboxStatusPending || entry.Attempts != 1 || entry.LastError == nil {
+			t.Errorf("Expected pending entry with one failed attempt, got %+v", entry)
+		}
+		if !entry.NextAttemptAt.Equal(now.Add(outboxBaseBackoff)) {
+			t.Errorf("Expected next attempt after %v, got %v", outboxBaseBackoff, entry.NextAttemptAt.Sub(now))
+		}
+
+		failing.err = nil
+		entry = dispatchEntry(context.Background(), entry, []outboxSink{ok, failing}, now)
+		if entry.Status != outboxStatusDispatched {
+			t.Errorf("Expected dispatched entry, got %+v", entry)
+		}
+		if len(ok.published) != 1 || len(failing.published) != 1 {
+			t.Errorf("Expected each sink to receive the message once, got %d and %d", len(ok.published), len(failing.published))
+		}
+	})
+}
+
+func TestOutboxBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{5, 80 * time.Second},
+		{30, outboxMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.attempts), func(t *testing.T) {
+			if got := outboxBackoff(tt.attempts); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDomainBusPublish(t *testing.T) {
+	bus := newDomainBus()
+	var received []domainEvent
+	bus.Subscribe(func(ctx context.Context, event domainEvent) error {
+		received = append(received, event)
+		return nil
+	})
+
+	entry := testOutboxEntry()
+	msg := outboxMessage{IdempotencyKey: entry.IdempotencyKey, Type: entry.Type, Body: []byte(entry.Payload)}
+	if err := bus.Publish(context.Background(), msg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(received) != 1 || received[0].ID != entry.IdempotencyKey || received[0].Version != 3 {
+		t.Errorf("Expected decoded event, got %+v", received)
+	}
+
+	bus.Subscribe(func(ctx context.Context, event domainEvent) error { return errors.New("handler failed") })
+	if err := bus.Publish(context.Background(), msg); err == nil {
+		t.Error("Expected handler error to be returned")
+	}
+}
+
+func TestHTTPSinkPublish(t *testing.T) {
+	var key string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.Header.Get(outboxIdempotencyHdr)
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	entry := testOutboxEntry()
+	msg := outboxMessage{IdempotencyKey: entry.IdempotencyKey, Body: []byte(entry.Payload)}
+	if err := newHTTPSink(server.URL, server.Client()).Publish(context.Background(), msg); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if key != entry.IdempotencyKey || string(body) != entry.Payload {
+		t.Errorf("Expected key %s and payload, got %s and %s", entry.IdempotencyKey, key, body)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+	if err := newHTTPSink(failing.URL, failing.Client()).Publish(context.Background(), msg); err == nil {
+		t.Error("Expected error for status 503")
+	}
+}
+
+func TestNATSSinkPublishesToStandin(t *testing.T) {
+	standin, err := startNATSStandin("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer standin.Close()
+
+	subscriber, err := net.Dial("tcp", standin.Addr())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defer subscriber.Close()
+	subscriber.SetDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(subscriber)
+	if _, err := reader.ReadString('\n'); err != nil { // INFO
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	io.WriteString(subscriber, "SUB wili.wishlist.> 1\r\nPING\r\n")
+	if line, _ := reader.ReadString('\n'); strings.TrimSpace(line) != "PONG" {
+		t.Fatalf("Expected PONG, got %q", line)
+	}
+
+	sink, err := newNATSSink("nats://"+standin.Addr(), defaultNATSSubject)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	entry := testOutboxEntry()
+	msg := outboxMessage{IdempotencyKey: entry.IdempotencyKey, Type: entry.Type, Body: []byte(entry.Payload)}
+
+	// The second publish has the same Nats-Msg-Id and must be dropped
+	for i := 0; i < 2; i++ {
+		if err := sink.Publish(context.Background(), msg); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	fields := strings.Fields(line)
+	if len(fields) != 5 || fields[0] != "HMSG" || fields[1] != "wili.wishlist.add_item" {
+		t.Fatalf("Expected HMSG on wili.wishlist.add_item, got %q", line)
+	}
+	total, _ := strconv.Atoi(fields[4])
+	data := make([]byte, total+2)
+	if _, err := io.ReadFull(reader, data); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), "Nats-Msg-Id: "+entry.IdempotencyKey) || !strings.HasSuffix(string(data[:total]), entry.Payload) {
+		t.Errorf("Expected idempotency header and payload, got %q", data)
+	}
+
+	io.WriteString(subscriber, "PING\r\n")
+	if line, _ := reader.ReadString('\n'); strings.TrimSpace(line) != "PONG" {
+		t.Errorf("Expected duplicate to be dropped, got %q", line)
+	}
+}
+
+func TestNATSSubjectMatches(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		subject  string
+		expected bool
+	}{
+		{"wili.wishlist.add_item", "wili.wishlist.add_item", true},
+		{"wili.wishlist.*", "wili.wishlist.add_item", true},
+		{"wili.>", "wili.wishlist.add_item", true},
+		{"wili.*", "wili.wishlist.add_item", false},
+		{"wili.wishlist.>", "wili.wishlist", false},
+		{"other.>", "wili.wishlist.add_item", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+"_"+tt.subject, func(t *testing.T) {
+			if got := natsSubjectMatches(tt.pattern, tt.subject); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseOutboxSinks(t *testing.T) {
+	bus := newDomainBus()
+
+	sinks, err := parseOutboxSinks("bus, http,bus", bus, "http://localhost:9000/events", "", defaultNATSSubject)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sinks) != 2 || sinks[0].Name() != "bus" || sinks[1].Name() != "http" {
+		t.Errorf("Expected bus and http sinks, got %d", len(sinks))
+	}
+
+	for _, names := range []string{"http", "nats", "kafka"} {
+		if _, err := parseOutboxSinks(names, bus, "", "", defaultNATSSubject); err == nil {
+			t.Errorf("Expected error for %q without configuration", names)
+		}
+	}
+}
@@ -0,0 +1,370 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// reminderClaimRetention outlives every lead time, so a claim is never
+// forgotten while its reminder could still be due
+const reminderClaimRetention = 90 * 24 * time.Hour
+
+func eventDateOf(event wishlistgen.WishlistEvent) *time.Time {
+	if event.Date == nil {
+		return nil
+	}
+	date := time.Date(event.Date.Year(), event.Date.Month(), event.Date.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+func apiEventOf(date *time.Time) wishlistgen.WishlistEvent {
+	if date == nil {
+		return wishlistgen.WishlistEvent{}
+	}
+	return wishlistgen.WishlistEvent{Date: &openapi_types.Date{Time: *date}}
+}
+
+// parseLeadDays parses REMINDER_LEAD_DAYS, a comma separated list of days
+// before the event, e.g. "7,1"
+func parseLeadDays(value string) ([]int, error) {
+	var leads []int
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		days, err := strconv.Atoi(part)
+		if err != nil || days < 1 {
+			return nil, fmt.Errorf("invalid lead time %q", part)
+		}
+		leads = append(leads, days)
+	}
+	if len(leads) == 0 {
+		return nil, fmt.Errorf("no lead times")
+	}
+	sort.Ints(leads)
+	return leads, nil
+}
+
+// reminderLead returns the lead time a reminder daysLeft before the event
+// belongs to: the shortest one not shorter than daysLeft. Late runs still send
+// the reminder of the current lead, and an event close by gets one reminder,
+// not one per lead.
+func reminderLead(leads []int, daysLeft int) (int, bool) {
+	if daysLeft < 1 {
+		return 0, false
+	}
+	for _, lead := range leads {
+		if lead >= daysLeft {
+			return lead, true
+		}
+	}
+	return 0, false
+}
+
+// RecordShareView counts a view of a wishlist by someone other than its owner
+func (r *MongoRepo) RecordShareView(ctx context.Context, wishlistID openapi_types.UUID) error {
+	_, err := r.wishlists.UpdateOne(ctx, bson.M{"uuid": wishlistID.String()}, bson.M{"$inc": bson.M{"shareViews": 1}})
+	if err != nil {
+		return fmt.Errorf("failed to record view: %w", err)
+	}
+	return nil
+}
+
+func (s *WishlistServer) recordShareView(r *http.Request, wishlist *wishlistgen.Wishlist) {
+	if r.Header.Get("Authorization") != "" {
+		if userID, err := s.extractUserID(r); err == nil && userID == wishlist.UserId {
+			return
+		}
+	}
+	if err := s.repo.RecordShareView(r.Context(), wishlist.Id); err != nil {
+		s.logger.LogError(r.Context(), nil, "record_share_view", err, fmt.Sprintf("failed to count view of wishlist %s", wishlist.Id.String()))
+	}
+}
+
+// SetBookingPurchased marks a booking as bought or not. It is booker-only
+// state, so the wishlist version is left alone.
+func (r *MongoRepo) SetBookingPurchased(ctx context.Context, itemID openapi_types.UUID, cancellationToken string, purchased bool) error {
+	filter := bson.M{
+		"items": bson.M{"$elemMatch": bson.M{
+			"id":                        itemID.String(),
+			"booking.cancellationToken": cancellationToken,
+		}},
+	}
+	update := bson.M{"$unset": bson.M{"items.$.booking.purchasedAt": ""}}
+	if purchased {
+		update = bson.M{"$set": bson.M{"items.$.booking.purchasedAt": time.Now()}}
+	}
+
+	res, err := r.wishlists.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update booking: %w", err)
+	}
+	if res.MatchedCount == 0 {
+		return fmt.Errorf("booking not found")
+	}
+	return nil
+}
+
+// claimReminder records that a reminder is being sent and returns false when
+// it already was. Claiming before sending means a crash loses a reminder
+// rather than sending it twice.
+func (r *MongoRepo) claimReminder(ctx context.Context, key string) (bool, error) {
+	_, err := r.reminders.InsertOne(ctx, bson.M{"key": key, "sentAt": time.Now()})
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to claim reminder: %w", err)
+	}
+	return true, nil
+}
+
+func (r *MongoRepo) releaseReminder(ctx context.Context, key string) {
+	if _, err := r.reminders.DeleteOne(ctx, bson.M{"key": key}); err != nil {
+		r.logger.LogError(ctx, nil, "release_reminder", err, fmt.Sprintf("failed to release reminder %s", key))
+	}
+}
+
+// upcomingWishlists returns live wishlists whose event is between from and to
+func (r *MongoRepo) upcomingWishlists(ctx context.Context, from, to time.Time) ([]mongoWishlist, error) {
+	cursor, err := r.wishlists.Find(ctx, bson.M{
+		"deletedAt": nil,
+		"eventDate": bson.M{"$gte": from, "$lte": to},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find upcoming wishlists: %w", err)
+	}
+	var wishlists []mongoWishlist
+	if err := cursor.All(ctx, &wishlists); err != nil {
+		return nil, fmt.Errorf("failed to decode upcoming wishlists: %w", err)
+	}
+	return wishlists, nil
+}
+
+// reminderScheduler reminds owners to share wishlists few people have seen and
+// bookers to buy the gifts they booked, at each lead time before the event
+type reminderScheduler struct {
+	repo     *MongoRepo
+	telegram *telegramNotifier // optional
+	email    *emailNotifier    // optional
+	leads    []int
+	minViews int64
+	logger   *Logger
+}
+
+// reminder is one reminder due to a recipient
+type reminder struct {
+	key    string
+	userID string // owner or logged-in booker; empty for anonymous bookers
+	data   emailData
+
+	wishlistID string
+	// bookingID is set for booker reminders
+	bookingID string
+}
+
+// dueReminders lists the reminders of a wishlist at lead days before its event
+func (s *reminderScheduler) dueReminders(mw *mongoWishlist, daysLeft, lead int) []reminder {
+	prefix := fmt.Sprintf("reminder:%s:%s:%d", mw.UUID, mw.EventDate.Format("2006-01-02"), lead)
+	wishlistURL := ""
+	if s.email != nil {
+		wishlistURL = s.email.wishlistURL(mw.UUID)
+	}
+
+	var due []reminder
+	if mw.ShareViews < s.minViews {
+		due = append(due, reminder{
+			key:        prefix + ":owner",
+			userID:     mw.UserID,
+			wishlistID: mw.UUID,
+			data: emailData{
+				Kind:          emailKindEventSoon,
+				WishlistTitle: mw.Title,
+				WishlistURL:   wishlistURL,
+				DaysLeft:      daysLeft,
+				Views:         mw.ShareViews,
+			},
+		})
+	}
+
+	for _, item := range mw.Items {
+		if item.DeletedAt != nil || item.Booking == nil || item.Booking.PurchasedAt != nil {
+			continue
+		}
+		rm := reminder{
+			key:        prefix + ":booking:" + item.Booking.BookingID,
+			wishlistID: mw.UUID,
+			bookingID:  item.Booking.BookingID,
+			data: emailData{
+				Kind:          emailKindGiftReminder,
+				WishlistTitle: mw.Title,
+				WishlistURL:   wishlistURL,
+				DaysLeft:      daysLeft,
+			},
+		}
+		if name, ok := item.Data["name"].(string); ok {
+			rm.data.ItemName = name
+		}
+		if item.Booking.BookerUserID != nil {
+			rm.userID = *item.Booking.BookerUserID
+		}
+		if s.email != nil {
+			rm.data.CancelURL = s.email.cancelURL(mw.UUID, item.ID, item.Booking.CancellationToken)
+		}
+		due = append(due, rm)
+	}
+	return due
+}
+
+// Run sends every reminder due at now and returns how many were sent
+func (s *reminderScheduler) Run(ctx context.Context, now time.Time) (int, error) {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	wishlists, err := s.repo.upcomingWishlists(ctx, today.AddDate(0, 0, 1), today.AddDate(0, 0, s.leads[len(s.leads)-1]))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for i := range wishlists {
+		mw := &wishlists[i]
+		daysLeft := int(mw.EventDate.Sub(today).Hours() / 24)
+		lead, ok := reminderLead(s.leads, daysLeft)
+		if !ok {
+			continue
+		}
+
+		for _, rm := range s.dueReminders(mw, daysLeft, lead) {
+			claimed, err := s.repo.claimReminder(ctx, rm.key)
+			if err != nil {
+				return sent, err
+			}
+			if !claimed {
+				continue
+			}
+			if err := s.send(ctx, rm); err != nil {
+				s.repo.releaseReminder(ctx, rm.key)
+				s.logger.LogError(ctx, nil, "send_reminder", err, fmt.Sprintf("failed to send reminder %s", rm.key))
+				continue
+			}
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// send delivers a reminder. Users get it in their inbox; owners also in
+// Telegram and by email, bookers in Telegram when linked and otherwise by
+// email, to the address left when booking or to their account.
+func (s *reminderScheduler) send(ctx context.Context, rm reminder) error {
+	delivered := false
+	if rm.userID != "" {
+		if err := s.repo.AddNotification(ctx, rm.key, rm.userID, rm.wishlistID, rm.data); err != nil {
+			return err
+		}
+		if s.telegram != nil {
+			n := &userNotification{
+				UserID:        rm.userID,
+				Type:          rm.data.Kind,
+				WishlistID:    rm.wishlistID,
+				WishlistTitle: rm.data.WishlistTitle,
+				DaysLeft:      &rm.data.DaysLeft,
+			}
+			if rm.data.ItemName != "" {
+				n.ItemName = &rm.data.ItemName
+			}
+			if rm.bookingID == "" {
+				n.Views = &rm.data.Views
+			}
+			ok, err := s.telegram.Send(ctx, rm.key, n)
+			if err != nil {
+				return err
+			}
+			delivered = ok
+		}
+	}
+
+	if s.email == nil {
+		return nil
+	}
+	if rm.bookingID == "" {
+		return s.email.Deliver(ctx, rm.key, rm.userID, rm.data)
+	}
+	if delivered {
+		return nil
+	}
+
+	contact, err := s.repo.findBookerContact(ctx, rm.bookingID)
+	if err != nil {
+		return err
+	}
+	if contact == nil {
+		if rm.userID == "" {
+			return nil
+		}
+		return s.email.Deliver(ctx, rm.key, rm.userID, rm.data)
+	}
+	msg, err := renderEmail(wishlistgen.TemplateLanguage(contact.Language), contact.Email, []emailData{rm.data}, "")
+	if err != nil {
+		return fmt.Errorf("failed to render reminder: %w", err)
+	}
+	return s.email.mailer.Send(ctx, msg)
+}
+
+func sendRemindersJob(scheduler *reminderScheduler, logger *Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		sent, err := scheduler.Run(ctx, start)
+		if err != nil {
+			return fmt.Errorf("failed to send reminders: %w", err)
+		}
+		if sent > 0 {
+			logger.LogDatabaseOperation(ctx, "send_reminders", time.Since(start), true, fmt.Sprintf("sent %d reminders", sent))
+		}
+		return nil
+	}
+}
+
+func (s *WishlistServer) setBookingPurchased(w http.ResponseWriter, r *http.Request, itemId openapi_types.UUID, token string, purchased bool) {
+	operation := "unmark_booking_purchased"
+	if purchased {
+		operation = "mark_booking_purchased"
+	}
+	s.logger.LogRequest(r, nil, operation)
+
+	if err := s.repo.SetBookingPurchased(r.Context(), itemId, token, purchased); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), nil, "booking", fmt.Sprintf("for item %s", itemId.String()))
+			s.writeError(w, http.StatusNotFound, "Booking not found")
+			return
+		}
+		s.logger.LogError(r.Context(), nil, operation, err, fmt.Sprintf("failed to update booking of item %s", itemId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to update booking")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), nil, operation, fmt.Sprintf("updated booking of item %s", itemId.String()))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Mark a booked gift as bought (booker only)
+func (s *WishlistServer) PutWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.PutWishlistsWishlistIdItemsItemIdBookingPurchasedParams) {
+	s.setBookingPurchased(w, r, itemId, params.CancellationToken.String(), true)
+}
+
+// Mark a booked gift as not bought yet (booker only)
+func (s *WishlistServer) DeleteWishlistsWishlistIdItemsItemIdBookingPurchased(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.DeleteWishlistsWishlistIdItemsItemIdBookingPurchasedParams) {
+	s.setBookingPurchased(w, r, itemId, params.CancellationToken.String(), false)
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestParseLeadDays(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []int
+		wantErr  bool
+	}{
+		{"7,1", []int{1, 7}, false},
+		{" 14, 3 ,1 ", []int{1, 3, 14}, false},
+		{"1", []int{1}, false},
+		{"", nil, true},
+		{"0", nil, true},
+		{"7,soon", nil, true},
+	}
+
+	for _, tt := range tests {
+		leads, err := parseLeadDays(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: expected error %v, got %v", tt.value, tt.wantErr, err)
+			continue
+		}
+		if len(leads) != len(tt.expected) {
+			t.Errorf("%q: expected %v, got %v", tt.value, tt.expected, leads)
+			continue
+		}
+		for i := range leads {
+			if leads[i] != tt.expected[i] {
+				t.Errorf("%q: expected %v, got %v", tt.value, tt.expected, leads)
+				break
+			}
+		}
+	}
+}
+
+func TestReminderLead(t *testing.T) {
+	leads := []int{1, 7}
+	tests := []struct {
+		daysLeft int
+		lead     int
+		ok       bool
+	}{
+		{0, 0, false},
+		{1, 1, true},
+		{2, 7, true},
+		{7, 7, true},
+		{8, 0, false},
+	}
+
+	for _, tt := range tests {
+		lead, ok := reminderLead(leads, tt.daysLeft)
+		if lead != tt.lead || ok != tt.ok {
+			t.Errorf("%d days left: expected %d %v, got %d %v", tt.daysLeft, tt.lead, tt.ok, lead, ok)
+		}
+	}
+}
+
+func TestEventDateRoundTrip(t *testing.T) {
+	if eventDateOf(wishlistgen.WishlistEvent{}) != nil {
+		t.Error("Expected no date for an empty event")
+	}
+	if apiEventOf(nil).Date != nil {
+		t.Error("Expected an empty event without a date")
+	}
+
+	date := eventDateOf(wishlistgen.WishlistEvent{Date: &openapi_types.Date{Time: time.Date(2026, 12, 31, 15, 4, 5, 0, time.Local)}})
+	if date == nil || !date.Equal(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("Expected midnight UTC of the event day, got %v", date)
+	}
+	if event := apiEventOf(date); event.Date == nil || event.Date.String() != "2026-12-31" {
+		t.Errorf("Expected 2026-12-31, got %+v", event)
+	}
+}
+
+func TestDueReminders(t *testing.T) {
+	eventDate := time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC)
+	booker := "booker-1"
+	purchasedAt := time.Now()
+	mw := &mongoWishlist{
+		UUID:       "wishlist-1",
+		UserID:     "owner-1",
+		Title:      "Birthday",
+		EventDate:  &eventDate,
+		ShareViews: 2,
+		Items: []mongoWishlistItem{
+			{ID: "item-1", Data: map[string]interface{}{"name": "Kettle"}, Booking: &mongoItemBooking{BookingID: "booking-1", BookerUserID: &booker}},
+			{ID: "item-2", Booking: &mongoItemBooking{BookingID: "booking-2", PurchasedAt: &purchasedAt}},
+			{ID: "item-3"},
+		},
+	}
+
+	scheduler := &reminderScheduler{minViews: 5}
+	due := scheduler.dueReminders(mw, 7, 7)
+	if len(due) != 2 {
+		t.Fatalf("Expected an owner and a booker reminder, got %+v", due)
+	}
+	if due[0].userID != "owner-1" || due[0].data.Kind != emailKindEventSoon || due[0].data.Views != 2 || due[0].bookingID != "" {
+		t.Errorf("Unexpected owner reminder: %+v", due[0])
+	}
+	if due[0].key != "reminder:wishlist-1:2026-12-31:7:owner" {
+		t.Errorf("Unexpected owner reminder key: %q", due[0].key)
+	}
+	if due[1].userID != booker || due[1].bookingID != "booking-1" || due[1].data.Kind != emailKindGiftReminder || due[1].data.ItemName != "Kettle" {
+		t.Errorf("Unexpected booker reminder: %+v", due[1])
+	}
+
+	mw.ShareViews = 5
+	if due := scheduler.dueReminders(mw, 7, 7); len(due) != 1 || due[0].bookingID != "booking-1" {
+		t.Errorf("Expected only the booker reminder once the wishlist was seen, got %+v", due)
+	}
+}
+
+func TestRenderReminders(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     emailData
+		subject  string
+		contains string
+	}{
+		{
+			name:     "event soon",
+			data:     emailData{Kind: emailKindEventSoon, WishlistTitle: "Birthday", DaysLeft: 7, Views: 1},
+			subject:  "«Birthday» is in 7 days",
+			contains: "opened once",
+		},
+		{
+			name:     "gift reminder",
+			data:     emailData{Kind: emailKindGiftReminder, WishlistTitle: "Birthday", ItemName: "Kettle", DaysLeft: 1, CancelURL: "https://api.wili.me/cancel"},
+			subject:  "«Birthday» is in 1 day: don't forget «Kettle»",
+			contains: "https://api.wili.me/cancel",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := renderEmail(wishlistgen.En, "anna@example.com", []emailData{tt.data}, "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if msg.Subject != tt.subject {
+				t.Errorf("Expected subject %q, got %q", tt.subject, msg.Subject)
+			}
+			if !strings.Contains(msg.Text, tt.contains) {
+				t.Errorf("Expected %q in text, got %q", tt.contains, msg.Text)
+			}
+		})
+	}
+}
+
+func TestTelegramNotifierSendNotLinked(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	notifier := newTelegramNotifier(server.URL, "", server.Client(), &memTelegramSentLog{})
+	delivered, err := notifier.Send(context.Background(), "key", &userNotification{UserID: "booker-1", Type: emailKindGiftReminder})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if delivered {
+		t.Error("Expected the notification not to be delivered")
+	}
+}
+
+func TestPurchasedRoutesRequireToken(t *testing.T) {
+	handler := wishlistgen.Handler(NewWishlistServer(nil, nil, time.Hour))
+	path := "/wishlists/" + openapi_types.UUID{}.String() + "/items/" + openapi_types.UUID{}.String() + "/booking/purchased"
+
+	for _, method := range []string{http.MethodPut, http.MethodDelete} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", method, http.StatusBadRequest, rec.Code)
+		}
+	}
+}
@@ -2,29 +2,50 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
+	"sync"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/theseems/wili/backend/telemetry"
 
 	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
 )
 
 type WishlistServer struct {
-	repo       *MongoRepo
-	userClient *UserClient
-	logger     *Logger
+	repo           *MongoRepo
+	userClient     *UserClient
+	logger         *Logger
+	trashRetention time.Duration
+
+	// unsubscribeSigner checks the tokens of email unsubscribe links
+	unsubscribeSigner unsubscribeSigner
+
+	// streamsClosed ends event streams on shutdown, which would otherwise
+	// keep their connections busy until the shutdown timeout
+	streamsClosed chan struct{}
+	closeStreams  sync.Once
 }
 
-func NewWishlistServer(repo *MongoRepo, userClient *UserClient) *WishlistServer {
+func NewWishlistServer(repo *MongoRepo, userClient *UserClient, trashRetention time.Duration) *WishlistServer {
 	return &WishlistServer{
-		repo:       repo,
-		userClient: userClient,
-		logger:     NewLogger("WISHLIST"),
+		repo:           repo,
+		userClient:     userClient,
+		logger:         NewLogger(),
+		trashRetention: trashRetention,
+		streamsClosed:  make(chan struct{}),
 	}
 }
 
+// CloseStreams ends every event stream; clients reconnect to another replica
+func (s *WishlistServer) CloseStreams() {
+	s.closeStreams.Do(func() { close(s.streamsClosed) })
+}
+
 func (s *WishlistServer) extractUserID(r *http.Request) (openapi_types.UUID, error) {
 	authHeader := r.Header.Get("Authorization")
 	if authHeader == "" {
@@ -38,9 +59,22 @@ func (s *WishlistServer) extractUserID(r *http.Request) (openapi_types.UUID, err
 		return openapi_types.UUID{}, fmt.Errorf("token validation failed: %w", err)
 	}
 
+	telemetry.SetUserID(r.Context(), userInfo.Id.String())
 	return userInfo.Id, nil
 }
 
+// writeAuthError answers a request whose user could not be established: 503
+// while the keys to check its token are unavailable, so that clients keep the
+// token and retry, and 401 otherwise
+func (s *WishlistServer) writeAuthError(w http.ResponseWriter, err error) {
+	if errors.Is(err, errKeysUnavailable) {
+		w.Header().Set("Retry-After", "5")
+		s.writeError(w, http.StatusServiceUnavailable, "Authentication is temporarily unavailable, try again later")
+		return
+	}
+	s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+}
+
 func (s *WishlistServer) writeJSON(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -55,6 +89,13 @@ func (s *WishlistServer) writeError(w http.ResponseWriter, status int, message s
 	json.NewEncoder(w).Encode(map[string]string{"error": message})
 }
 
+func (s *WishlistServer) writePreconditionFailed(w http.ResponseWriter) {
+	s.writeJSON(w, http.StatusPreconditionFailed, wishlistgen.ConflictErrorResponse{
+		Error:   "precondition_failed",
+		Message: "Wishlist was modified since it was loaded, reload it and try again",
+	})
+}
+
 func (s *WishlistServer) writeValidationErrors(w http.ResponseWriter, errors ValidationErrors) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusBadRequest)
@@ -73,18 +114,18 @@ func (s *WishlistServer) GetWishlists(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
 	wishlists, err := s.repo.GetWishlistsByUser(r.Context(), userID)
 	if err != nil {
-		s.logger.LogError(&userID, "get_wishlists", err, "failed to retrieve wishlists from database")
+		s.logger.LogError(r.Context(), &userID, "get_wishlists", err, "failed to retrieve wishlists from database")
 		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve wishlists")
 		return
 	}
 
-	s.logger.LogSuccess(&userID, "get_wishlists", fmt.Sprintf("retrieved %d wishlists", len(wishlists)))
+	s.logger.LogSuccess(r.Context(), &userID, "get_wishlists", fmt.Sprintf("retrieved %d wishlists", len(wishlists)))
 	response := map[string]interface{}{
 		"wishlists": wishlists,
 	}
@@ -97,218 +138,297 @@ func (s *WishlistServer) PostWishlists(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
 	var req wishlistgen.CreateWishlistRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.logger.LogBadRequest(&userID, "create_wishlist", fmt.Sprintf("malformed JSON: %v", err))
+		s.logger.LogBadRequest(r.Context(), &userID, "create_wishlist", fmt.Sprintf("malformed JSON: %v", err))
 		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
 		return
 	}
 
 	if validationErrors := ValidateCreateWishlistRequest(req); len(validationErrors) > 0 {
-		s.logger.LogValidationError(&userID, "create_wishlist", validationErrors)
+		s.logger.LogValidationError(r.Context(), &userID, "create_wishlist", validationErrors)
 		s.writeValidationErrors(w, validationErrors)
 		return
 	}
 
-	wishlist, err := s.repo.CreateWishlist(r.Context(), userID, req)
+	var items []wishlistgen.CreateWishlistItemRequest
+	if req.TemplateId != nil {
+		items = findTemplate(*req.TemplateId).items(templateLanguage(req.Language))
+	}
+
+	wishlist, err := s.repo.CreateWishlist(r.Context(), userID, req, items)
 	if err != nil {
-		s.logger.LogError(&userID, "create_wishlist", err, "failed to create wishlist in database")
+		s.logger.LogError(r.Context(), &userID, "create_wishlist", err, "failed to create wishlist in database")
 		s.writeError(w, http.StatusInternalServerError, "Failed to create wishlist")
 		return
 	}
 
-	s.logger.LogSuccess(&userID, "create_wishlist", fmt.Sprintf("created wishlist '%s'", req.Title))
+	wishlistsCreatedTotal.WithLabelValues("create").Inc()
+	s.logger.LogSuccess(r.Context(), &userID, "create_wishlist", fmt.Sprintf("created wishlist '%s'", req.Title))
+	s.writeJSON(w, http.StatusCreated, wishlist)
+}
+
+// Copy a wishlist into a new one (owner only)
+func (s *WishlistServer) PostWishlistsWishlistIdCopy(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+	s.logger.LogRequest(r, nil, "copy_wishlist")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	var req wishlistgen.CopyWishlistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && err != io.EOF {
+		s.logger.LogBadRequest(r.Context(), &userID, "copy_wishlist", fmt.Sprintf("malformed JSON: %v", err))
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return
+	}
+
+	if validationErrors := ValidateCopyWishlistRequest(req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, "copy_wishlist", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	wishlist, err := s.repo.CopyWishlist(r.Context(), wishlistId, userID, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "copy_wishlist", err, fmt.Sprintf("failed to copy wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to copy wishlist")
+		return
+	}
+
+	wishlistsCreatedTotal.WithLabelValues("copy").Inc()
+	s.logger.LogSuccess(r.Context(), &userID, "copy_wishlist", fmt.Sprintf("copied wishlist %s to %s with %d items", wishlistId.String(), wishlist.Id.String(), len(wishlist.Items)))
+	setETag(w, wishlist.Version)
 	s.writeJSON(w, http.StatusCreated, wishlist)
 }
 
 // Get a wishlist by ID (public endpoint)
-func (s *WishlistServer) GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (s *WishlistServer) GetWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdParams) {
 	s.logger.LogRequest(r, nil, "get_wishlist")
 
 	wishlist, err := s.repo.GetWishlistByID(r.Context(), wishlistId)
 	if err != nil {
-		s.logger.LogError(nil, "get_wishlist", err, fmt.Sprintf("failed to retrieve wishlist %s from database", wishlistId.String()))
+		s.logger.LogError(r.Context(), nil, "get_wishlist", err, fmt.Sprintf("failed to retrieve wishlist %s from database", wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve wishlist")
 		return
 	}
 
 	if wishlist == nil {
-		s.logger.LogNotFound(nil, "wishlist", wishlistId.String())
+		s.logger.LogNotFound(r.Context(), nil, "wishlist", wishlistId.String())
 		s.writeError(w, http.StatusNotFound, "Wishlist not found")
 		return
 	}
 
-	s.logger.LogSuccess(nil, "get_wishlist", fmt.Sprintf("retrieved wishlist '%s' (%s)", wishlist.Title, wishlistId.String()))
+	setETag(w, wishlist.Version)
+	if ifNoneMatchHit(params.IfNoneMatch, wishlist.Version) {
+		s.logger.LogSuccess(r.Context(), nil, "get_wishlist", fmt.Sprintf("wishlist %s not modified (version %d)", wishlistId.String(), wishlist.Version))
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	s.recordShareView(r, wishlist)
+
+	s.logger.LogSuccess(r.Context(), nil, "get_wishlist", fmt.Sprintf("retrieved wishlist '%s' (%s)", wishlist.Title, wishlistId.String()))
 	s.writeJSON(w, http.StatusOK, wishlist)
 }
 
 // Delete a wishlist (owner only)
-func (s *WishlistServer) DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (s *WishlistServer) DeleteWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.DeleteWishlistsWishlistIdParams) {
 	s.logger.LogRequest(r, nil, "delete_wishlist")
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
-	err = s.repo.DeleteWishlist(r.Context(), wishlistId, userID)
+	err = s.repo.DeleteWishlist(r.Context(), wishlistId, userID, ifMatchVersions(params.IfMatch))
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "delete_wishlist", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
 		if strings.Contains(err.Error(), "not found") {
-			s.logger.LogNotFound(&userID, "wishlist", wishlistId.String())
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
 			s.writeError(w, http.StatusNotFound, "Wishlist not found or not owned by user")
 			return
 		}
-		s.logger.LogError(&userID, "delete_wishlist", err, fmt.Sprintf("failed to delete wishlist %s", wishlistId.String()))
+		s.logger.LogError(r.Context(), &userID, "delete_wishlist", err, fmt.Sprintf("failed to delete wishlist %s", wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to delete wishlist")
 		return
 	}
 
-	s.logger.LogSuccess(&userID, "delete_wishlist", fmt.Sprintf("deleted wishlist %s", wishlistId.String()))
+	s.logger.LogSuccess(r.Context(), &userID, "delete_wishlist", fmt.Sprintf("deleted wishlist %s", wishlistId.String()))
 	w.WriteHeader(http.StatusNoContent)
 }
 
 // Add an item to a wishlist (owner only)
-func (s *WishlistServer) PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (s *WishlistServer) PostWishlistsWishlistIdItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsParams) {
 	s.logger.LogRequest(r, nil, "add_item")
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
 	var req wishlistgen.CreateWishlistItemRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.logger.LogBadRequest(&userID, "add_item", fmt.Sprintf("malformed JSON for wishlist %s: %v", wishlistId.String(), err))
+		s.logger.LogBadRequest(r.Context(), &userID, "add_item", fmt.Sprintf("malformed JSON for wishlist %s: %v", wishlistId.String(), err))
 		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
 		return
 	}
 
 	if validationErrors := ValidateCreateWishlistItemRequest(req); len(validationErrors) > 0 {
-		s.logger.LogValidationError(&userID, "add_item", validationErrors)
+		s.logger.LogValidationError(r.Context(), &userID, "add_item", validationErrors)
 		s.writeValidationErrors(w, validationErrors)
 		return
 	}
 
-	item, err := s.repo.AddItemToWishlist(r.Context(), wishlistId, userID, req)
+	item, version, err := s.repo.AddItemToWishlist(r.Context(), wishlistId, userID, req, ifMatchVersions(params.IfMatch))
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "add_item", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
 		if strings.Contains(err.Error(), "not found") {
-			s.logger.LogNotFound(&userID, "wishlist", wishlistId.String())
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
 			s.writeError(w, http.StatusNotFound, "Wishlist not found or not owned by user")
 			return
 		}
-		s.logger.LogError(&userID, "add_item", err, fmt.Sprintf("failed to add item to wishlist %s", wishlistId.String()))
+		s.logger.LogError(r.Context(), &userID, "add_item", err, fmt.Sprintf("failed to add item to wishlist %s", wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to add item to wishlist")
 		return
 	}
 
 	itemName := req.Data.Name
-	s.logger.LogSuccess(&userID, "add_item", fmt.Sprintf("added item '%s' (type: %s) to wishlist %s", itemName, req.Type, wishlistId.String()))
+	s.logger.LogSuccess(r.Context(), &userID, "add_item", fmt.Sprintf("added item '%s' (type: %s) to wishlist %s", itemName, req.Type, wishlistId.String()))
+	setETag(w, version)
 	s.writeJSON(w, http.StatusCreated, item)
 }
 
 // Update a wishlist item (owner only)
-func (s *WishlistServer) PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID) {
+func (s *WishlistServer) PutWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params wishlistgen.PutWishlistsWishlistIdItemsItemIdParams) {
 	s.logger.LogRequest(r, nil, "update_item")
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
 	var req wishlistgen.UpdateWishlistItemRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.logger.LogBadRequest(&userID, "update_item", fmt.Sprintf("malformed JSON for item %s in wishlist %s: %v", itemId.String(), wishlistId.String(), err))
+		s.logger.LogBadRequest(r.Context(), &userID, "update_item", fmt.Sprintf("malformed JSON for item %s in wishlist %s: %v", itemId.String(), wishlistId.String(), err))
 		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
 		return
 	}
 
 	if validationErrors := ValidateUpdateWishlistItemRequest(req); len(validationErrors) > 0 {
-		s.logger.LogValidationError(&userID, "update_item", validationErrors)
+		s.logger.LogValidationError(r.Context(), &userID, "update_item", validationErrors)
 		s.writeValidationErrors(w, validationErrors)
 		return
 	}
 
-	item, err := s.repo.UpdateWishlistItem(r.Context(), wishlistId, itemId, userID, req)
+	item, version, err := s.repo.UpdateWishlistItem(r.Context(), wishlistId, itemId, userID, req, ifMatchVersions(params.IfMatch))
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "update_item", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
 		if strings.Contains(err.Error(), "not found") {
-			s.logger.LogNotFound(&userID, "item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
+			s.logger.LogNotFound(r.Context(), &userID, "item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
 			s.writeError(w, http.StatusNotFound, "Wishlist or item not found, or not owned by user")
 			return
 		}
-		s.logger.LogError(&userID, "update_item", err, fmt.Sprintf("failed to update item %s in wishlist %s", itemId.String(), wishlistId.String()))
+		s.logger.LogError(r.Context(), &userID, "update_item", err, fmt.Sprintf("failed to update item %s in wishlist %s", itemId.String(), wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to update wishlist item")
 		return
 	}
 
-	s.logger.LogSuccess(&userID, "update_item", fmt.Sprintf("updated item %s in wishlist %s", itemId.String(), wishlistId.String()))
+	s.logger.LogSuccess(r.Context(), &userID, "update_item", fmt.Sprintf("updated item %s in wishlist %s", itemId.String(), wishlistId.String()))
+	setETag(w, version)
 	s.writeJSON(w, http.StatusOK, item)
 }
 
 // Remove an item from a wishlist (owner only)
-func (s *WishlistServer) DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID) {
+func (s *WishlistServer) DeleteWishlistsWishlistIdItemsItemId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, itemId openapi_types.UUID, params wishlistgen.DeleteWishlistsWishlistIdItemsItemIdParams) {
 	s.logger.LogRequest(r, nil, "delete_item")
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
-	err = s.repo.DeleteWishlistItem(r.Context(), wishlistId, itemId, userID)
+	version, err := s.repo.DeleteWishlistItem(r.Context(), wishlistId, itemId, userID, ifMatchVersions(params.IfMatch))
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "delete_item", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
 		if strings.Contains(err.Error(), "not found") {
-			s.logger.LogNotFound(&userID, "item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
+			s.logger.LogNotFound(r.Context(), &userID, "item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
 			s.writeError(w, http.StatusNotFound, "Wishlist or item not found, or not owned by user")
 			return
 		}
-		s.logger.LogError(&userID, "delete_item", err, fmt.Sprintf("failed to delete item %s from wishlist %s", itemId.String(), wishlistId.String()))
+		s.logger.LogError(r.Context(), &userID, "delete_item", err, fmt.Sprintf("failed to delete item %s from wishlist %s", itemId.String(), wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to delete wishlist item")
 		return
 	}
 
-	s.logger.LogSuccess(&userID, "delete_item", fmt.Sprintf("deleted item %s from wishlist %s", itemId.String(), wishlistId.String()))
+	s.logger.LogSuccess(r.Context(), &userID, "delete_item", fmt.Sprintf("deleted item %s from wishlist %s", itemId.String(), wishlistId.String()))
+	setETag(w, version)
 	w.WriteHeader(http.StatusNoContent)
 }
 
 // Update a wishlist (owner only)
-func (s *WishlistServer) PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+func (s *WishlistServer) PutWishlistsWishlistId(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.PutWishlistsWishlistIdParams) {
 	s.logger.LogRequest(r, nil, "update_wishlist")
 
 	userID, err := s.extractUserID(r)
 	if err != nil {
-		s.writeError(w, http.StatusUnauthorized, "Unauthorized: "+err.Error())
+		s.writeAuthError(w, err)
 		return
 	}
 
 	var req wishlistgen.UpdateWishlistRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.logger.LogBadRequest(&userID, "update_wishlist", fmt.Sprintf("malformed JSON for wishlist %s: %v", wishlistId.String(), err))
+		s.logger.LogBadRequest(r.Context(), &userID, "update_wishlist", fmt.Sprintf("malformed JSON for wishlist %s: %v", wishlistId.String(), err))
 		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
 		return
 	}
 
 	if validationErrors := ValidateUpdateWishlistRequest(req); len(validationErrors) > 0 {
-		s.logger.LogValidationError(&userID, "update_wishlist", validationErrors)
+		s.logger.LogValidationError(r.Context(), &userID, "update_wishlist", validationErrors)
 		s.writeValidationErrors(w, validationErrors)
 		return
 	}
 
 	existing, err := s.repo.GetWishlistByID(r.Context(), wishlistId)
 	if err != nil {
-		s.logger.LogError(&userID, "update_wishlist", err, fmt.Sprintf("failed to retrieve wishlist %s for update", wishlistId.String()))
+		s.logger.LogError(r.Context(), &userID, "update_wishlist", err, fmt.Sprintf("failed to retrieve wishlist %s for update", wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve wishlist")
 		return
 	}
 
 	if existing == nil {
-		s.logger.LogNotFound(&userID, "wishlist", wishlistId.String())
+		s.logger.LogNotFound(r.Context(), &userID, "wishlist", wishlistId.String())
 		s.writeError(w, http.StatusNotFound, "Wishlist not found")
 		return
 	}
@@ -324,57 +444,77 @@ func (s *WishlistServer) PutWishlistsWishlistId(w http.ResponseWriter, r *http.R
 		title = *req.Title
 	}
 
-	err = s.repo.UpdateWishlist(r.Context(), wishlistId, userID, title, req.Description)
+	updated, err := s.repo.UpdateWishlist(r.Context(), wishlistId, userID, title, req.Description, req.Privacy, req.Event, ifMatchVersions(params.IfMatch))
 	if err != nil {
-		s.logger.LogError(&userID, "update_wishlist", err, fmt.Sprintf("failed to update wishlist %s in database", wishlistId.String()))
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, "update_wishlist", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "update_wishlist", err, fmt.Sprintf("failed to update wishlist %s in database", wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to update wishlist")
 		return
 	}
 
-	updated, err := s.repo.GetWishlistByID(r.Context(), wishlistId)
-	if err != nil {
-		s.logger.LogError(&userID, "update_wishlist", err, fmt.Sprintf("failed to retrieve updated wishlist %s", wishlistId.String()))
-		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve updated wishlist")
-		return
-	}
-
-	s.logger.LogSuccess(&userID, "update_wishlist", fmt.Sprintf("successfully updated wishlist %s", wishlistId.String()))
+	s.logger.LogSuccess(r.Context(), &userID, "update_wishlist", fmt.Sprintf("successfully updated wishlist %s", wishlistId.String()))
+	setETag(w, updated.Version)
 	s.writeJSON(w, http.StatusOK, updated)
 }
 
 // Book a wishlist item (public endpoint)
-func (s *WishlistServer) PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID) {
+func (s *WishlistServer) PostWishlistsWishlistIdItemsItemIdBook(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsItemIdBookParams) {
 	s.logger.LogRequest(r, nil, "book_item")
 
 	var req wishlistgen.BookItemRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		s.logger.LogBadRequest(nil, "book_item", fmt.Sprintf("malformed JSON for item %s in wishlist %s: %v", itemId.String(), wishlistId.String(), err))
+		s.logger.LogBadRequest(r.Context(), nil, "book_item", fmt.Sprintf("malformed JSON for item %s in wishlist %s: %v", itemId.String(), wishlistId.String(), err))
 		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
 		return
 	}
 
-	booking, err := s.repo.BookItem(r.Context(), wishlistId, itemId, req)
+	if validationErrors := ValidateBookItemRequest(req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), nil, "book_item", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	// Booking is public; a logged-in booker is remembered for reminders
+	var bookerID *openapi_types.UUID
+	if r.Header.Get("Authorization") != "" {
+		if id, err := s.extractUserID(r); err == nil {
+			bookerID = &id
+		}
+	}
+
+	booking, version, err := s.repo.BookItem(r.Context(), wishlistId, itemId, req, bookerID, ifMatchVersions(params.IfMatch))
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), nil, "book_item", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
 		if strings.Contains(err.Error(), "already booked") {
-			s.logger.LogConflict(nil, "book_item", fmt.Sprintf("item %s in wishlist %s is already booked", itemId.String(), wishlistId.String()))
+			s.logger.LogConflict(r.Context(), nil, "book_item", fmt.Sprintf("item %s in wishlist %s is already booked", itemId.String(), wishlistId.String()))
 			s.writeError(w, http.StatusConflict, "Item is already booked")
 			return
 		}
 		if strings.Contains(err.Error(), "not found") {
-			s.logger.LogNotFound(nil, "item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
+			s.logger.LogNotFound(r.Context(), nil, "item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
 			s.writeError(w, http.StatusNotFound, "Wishlist or item not found")
 			return
 		}
-		s.logger.LogError(nil, "book_item", err, fmt.Sprintf("failed to book item %s in wishlist %s", itemId.String(), wishlistId.String()))
+		s.logger.LogError(r.Context(), nil, "book_item", err, fmt.Sprintf("failed to book item %s in wishlist %s", itemId.String(), wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to book item")
 		return
 	}
 
+	itemsBookedTotal.Inc()
 	bookerName := "anonymous"
 	if booking.BookerName != nil {
 		bookerName = *booking.BookerName
 	}
-	s.logger.LogSuccess(nil, "book_item", fmt.Sprintf("booked item %s in wishlist %s by %s", itemId.String(), wishlistId.String(), bookerName))
+	s.logger.LogSuccess(r.Context(), nil, "book_item", fmt.Sprintf("booked item %s in wishlist %s by %s", itemId.String(), wishlistId.String(), bookerName))
+	setETag(w, version)
 	s.writeJSON(w, http.StatusOK, booking)
 }
 
@@ -382,54 +522,70 @@ func (s *WishlistServer) DeleteWishlistsWishlistIdItemsItemIdUnbook(w http.Respo
 	s.logger.LogRequest(r, nil, "unbook_item")
 
 	if params.BookingId == nil && params.CancellationToken == nil {
-		s.logger.LogBadRequest(nil, "unbook_item", "must provide either bookingId or cancellationToken")
+		s.logger.LogBadRequest(r.Context(), nil, "unbook_item", "must provide either bookingId or cancellationToken")
 		s.writeError(w, http.StatusBadRequest, "Must provide either bookingId or cancellationToken")
 		return
 	}
 
-	var err error
+	var (
+		version int64
+		err     error
+	)
+	ifMatch := ifMatchVersions(params.IfMatch)
 
 	if params.CancellationToken != nil {
-		err = s.repo.UnbookItemByToken(r.Context(), wishlistId, itemId, params.CancellationToken.String())
+		version, err = s.repo.UnbookItemByToken(r.Context(), itemId, params.CancellationToken.String(), ifMatch)
 	} else {
 		userId, userErr := s.extractUserID(r)
 		if userErr != nil {
-			s.writeError(w, http.StatusUnauthorized, "Authentication required")
+			s.writeAuthError(w, userErr)
 			return
 		}
 
 		wishlist, wishlistErr := s.repo.GetWishlistByID(r.Context(), wishlistId)
 		if wishlistErr != nil {
 			if strings.Contains(wishlistErr.Error(), "not found") {
-				s.logger.LogNotFound(&userId, "wishlist", wishlistId.String())
+				s.logger.LogNotFound(r.Context(), &userId, "wishlist", wishlistId.String())
 				s.writeError(w, http.StatusNotFound, "Wishlist not found")
 				return
 			}
-			s.logger.LogError(&userId, "get_wishlist", wishlistErr, wishlistId.String())
+			s.logger.LogError(r.Context(), &userId, "get_wishlist", wishlistErr, wishlistId.String())
 			s.writeError(w, http.StatusInternalServerError, "Failed to verify wishlist ownership")
 			return
 		}
 
+		if wishlist == nil {
+			s.logger.LogNotFound(r.Context(), &userId, "wishlist", wishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found")
+			return
+		}
+
 		if wishlist.UserId.String() != userId.String() {
 			s.logger.LogUnauthorized(r, "unbook_item", fmt.Sprintf("user %s tried to unbook item in wishlist %s", userId.String(), wishlistId.String()))
 			s.writeError(w, http.StatusForbidden, "You don't own this wishlist")
 			return
 		}
 
-		err = s.repo.UnbookItem(r.Context(), wishlistId, itemId, *params.BookingId)
+		version, err = s.repo.UnbookItem(r.Context(), wishlistId, itemId, *params.BookingId, userId, ifMatch)
 	}
 
 	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), nil, "unbook_item", fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
 		if strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "invalid token") {
-			s.logger.LogNotFound(nil, "booking", fmt.Sprintf("for item %s in wishlist %s", itemId.String(), wishlistId.String()))
+			s.logger.LogNotFound(r.Context(), nil, "booking", fmt.Sprintf("for item %s in wishlist %s", itemId.String(), wishlistId.String()))
 			s.writeError(w, http.StatusNotFound, "Wishlist, item, or booking not found")
 			return
 		}
-		s.logger.LogError(nil, "unbook_item", err, fmt.Sprintf("failed to unbook item %s in wishlist %s", itemId.String(), wishlistId.String()))
+		s.logger.LogError(r.Context(), nil, "unbook_item", err, fmt.Sprintf("failed to unbook item %s in wishlist %s", itemId.String(), wishlistId.String()))
 		s.writeError(w, http.StatusInternalServerError, "Failed to unbook item")
 		return
 	}
 
-	s.logger.LogSuccess(nil, "unbook_item", fmt.Sprintf("unbooked item %s in wishlist %s", itemId.String(), wishlistId.String()))
+	s.logger.LogSuccess(r.Context(), nil, "unbook_item", fmt.Sprintf("unbooked item %s in wishlist %s", itemId.String(), wishlistId.String()))
+	setETag(w, version)
 	w.WriteHeader(http.StatusNoContent)
 }
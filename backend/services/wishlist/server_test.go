@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
 )
 
@@ -199,22 +200,40 @@ func TestValidateBookItemRequest(t *testing.T) {
 			expectedCount: 0,
 			description:   "Should treat empty strings as nil (anonymous booking)",
 		},
+		{
+			name: "valid_booking_with_receipt_email",
+			req: wishlistgen.BookItemRequest{
+				BookerEmail: emailPtr("anna@example.com"),
+				Language:    languagePtr(wishlistgen.En),
+			},
+			expectErrors:  false,
+			expectedCount: 0,
+			description:   "Should accept booking with an email for the receipt",
+		},
+		{
+			name: "too_long_email_and_unknown_language",
+			req: wishlistgen.BookItemRequest{
+				BookerEmail: emailPtr(strings.Repeat("a", 250) + "@example.com"),
+				Language:    languagePtr("de"),
+			},
+			expectErrors:  true,
+			expectedCount: 2,
+			description:   "Should reject emails over 254 characters and unsupported languages",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// BookItemRequest doesn't have validation yet, but we can test the structure
-			// This test ensures the request structure is valid
-			if tt.req.BookerName != nil && *tt.req.BookerName == "" {
-				tt.req.BookerName = nil
+			errors := ValidateBookItemRequest(tt.req)
+
+			if tt.expectErrors && len(errors) == 0 {
+				t.Errorf("Expected validation errors but got none. %s", tt.description)
 			}
-			if tt.req.Message != nil && *tt.req.Message == "" {
-				tt.req.Message = nil
+			if !tt.expectErrors && len(errors) > 0 {
+				t.Errorf("Expected no validation errors but got %d: %v. %s", len(errors), errors, tt.description)
 			}
-
-			// Basic structure validation
-			if tt.expectErrors {
-				t.Errorf("BookItemRequest validation not implemented yet")
+			if len(errors) != tt.expectedCount {
+				t.Errorf("Expected %d validation errors, got %d. %s", tt.expectedCount, len(errors), tt.description)
 			}
 		})
 	}
@@ -224,3 +243,12 @@ func TestValidateBookItemRequest(t *testing.T) {
 func stringPtr(s string) *string {
 	return &s
 }
+
+func emailPtr(s string) *openapi_types.Email {
+	email := openapi_types.Email(s)
+	return &email
+}
+
+func languagePtr(l wishlistgen.TemplateLanguage) *wishlistgen.TemplateLanguage {
+	return &l
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+)
+
+const smtpStandinMaxMessages = 100
+
+// smtpReceived is a message accepted by the SMTP stand-in
+type smtpReceived struct {
+	From string
+	To   []string
+	Data string
+}
+
+// smtpStandin is a tiny SMTP server for local development and tests
+// (SMTP_HOST=local). It accepts every message, logs it and keeps the last
+// ones in memory; nothing is relayed.
+type smtpStandin struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []smtpReceived
+}
+
+func startSMTPStandin(addr string) (*smtpStandin, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start SMTP stand-in: %w", err)
+	}
+	s := &smtpStandin{listener: listener}
+	go s.serve()
+	return s, nil
+}
+
+func (s *smtpStandin) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *smtpStandin) Close() error {
+	return s.listener.Close()
+}
+
+// Messages returns the messages received so far, oldest first
+func (s *smtpStandin) Messages() []smtpReceived {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]smtpReceived(nil), s.messages...)
+}
+
+func (s *smtpStandin) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStandin) handle(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(time.Minute))
+
+	reader := bufio.NewReader(conn)
+	reply := func(line string) bool {
+		_, err := fmt.Fprintf(conn, "%s\r\n", line)
+		return err == nil
+	}
+
+	if !reply("220 wili-standin ESMTP") {
+		return
+	}
+
+	var current smtpReceived
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(line)
+		if i := strings.IndexByte(verb, ' '); i >= 0 {
+			verb = verb[:i]
+		}
+
+		var ok bool
+		switch verb {
+		case "EHLO":
+			ok = reply("250-wili-standin") && reply("250 8BITMIME")
+		case "HELO":
+			ok = reply("250 wili-standin")
+		case "MAIL":
+			current = smtpReceived{From: smtpPath(line)}
+			ok = reply("250 OK")
+		case "RCPT":
+			current.To = append(current.To, smtpPath(line))
+			ok = reply("250 OK")
+		case "DATA":
+			if len(current.To) == 0 {
+				ok = reply("503 RCPT first")
+				break
+			}
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := readSMTPData(reader)
+			if err != nil {
+				return
+			}
+			current.Data = data
+			s.store(current)
+			current = smtpReceived{}
+			ok = reply("250 OK")
+		case "RSET":
+			current = smtpReceived{}
+			ok = reply("250 OK")
+		case "NOOP":
+			ok = reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			ok = reply("502 Command not implemented")
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+func (s *smtpStandin) store(msg smtpReceived) {
+	subject := ""
+	if parsed, err := mail.ReadMessage(strings.NewReader(msg.Data)); err == nil {
+		subject = parsed.Header.Get("Subject")
+	}
+	slog.Info("SMTP stand-in received a mail", "from", msg.From, "to", strings.Join(msg.To, ", "), "subject", subject)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages = append(s.messages, msg)
+	if len(s.messages) > smtpStandinMaxMessages {
+		s.messages = s.messages[len(s.messages)-smtpStandinMaxMessages:]
+	}
+}
+
+// smtpPath extracts the address of MAIL FROM:<a> and RCPT TO:<a>
+func smtpPath(line string) string {
+	start, end := strings.IndexByte(line, '<'), strings.IndexByte(line, '>')
+	if start < 0 || end < start {
+		return ""
+	}
+	return line[start+1 : end]
+}
+
+// readSMTPData reads up to the terminating "." line, undoing dot-stuffing
+func readSMTPData(reader *bufio.Reader) (string, error) {
+	var b strings.Builder
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if line == ".\r\n" || line == ".\n" {
+			return b.String(), nil
+		}
+		b.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// telegramSentRetention is how long delivered notifications are remembered,
+// well past the last outbox or reminder retry
+const telegramSentRetention = 30 * 24 * time.Hour
+
+// userNotification tells the Telegram bot what to tell userId about a
+// wishlist: bookings to owners, reminders to owners and bookers. Item and
+// booker are left out when the owner asked not to know.
+type userNotification struct {
+	UserID        string  `json:"userId"`
+	Type          string  `json:"type"`
+	WishlistID    string  `json:"wishlistId"`
+	WishlistTitle string  `json:"wishlistTitle"`
+	ItemName      *string `json:"itemName,omitempty"`
+	BookerName    *string `json:"bookerName,omitempty"`
+	Message       *string `json:"message,omitempty"`
+	DaysLeft      *int    `json:"daysLeft,omitempty"`
+	Views         *int64  `json:"views,omitempty"`
+}
+
+// ownerNotificationOf returns the notification for a domain event, or nil when
+// the owner should not be notified: only bookings and cancellations by bookers
+// count, the owner knows about their own changes.
+func ownerNotificationOf(event domainEvent) *userNotification {
+	if event.Wishlist == nil || len(event.ItemIDs) == 0 {
+		return nil
+	}
+
+	n := &userNotification{
+		UserID:        event.OwnerID,
+		WishlistID:    event.WishlistID,
+		WishlistTitle: event.Wishlist.Title,
+	}
+	switch event.Type {
+	case "book_item":
+		n.Type = string(wishlistgen.ActivityEventTypeItemBooked)
+	case "unbook_item":
+		if event.ActorID != nil {
+			return nil
+		}
+		n.Type = string(wishlistgen.ActivityEventTypeItemUnbookedByToken)
+	default:
+		return nil
+	}
+
+	visibility := event.Wishlist.Privacy.BookerVisibility
+	if visibility == wishlistgen.Surprise {
+		return n
+	}
+
+	for _, item := range event.Wishlist.Items {
+		if item.Id.String() != event.ItemIDs[0] {
+			continue
+		}
+		name := item.Data.Name
+		n.ItemName = &name
+		if visibility == wishlistgen.Visible && item.Booking != nil {
+			n.BookerName = item.Booking.BookerName
+			n.Message = item.Booking.Message
+		}
+		break
+	}
+	return n
+}
+
+// telegramSentLog remembers the idempotency keys of notifications the bot
+// delivered, so that retried events and reminders reach users once
+type telegramSentLog interface {
+	telegramNotificationSent(ctx context.Context, key string) (bool, error)
+	markTelegramNotificationSent(ctx context.Context, userID, key string) error
+}
+
+func (r *MongoRepo) telegramNotificationSent(ctx context.Context, key string) (bool, error) {
+	count, err := r.telegramSent.CountDocuments(ctx, bson.M{"idempotencyKey": key}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to check sent telegram notifications: %w", err)
+	}
+	return count > 0, nil
+}
+
+func (r *MongoRepo) markTelegramNotificationSent(ctx context.Context, userID, key string) error {
+	_, err := r.telegramSent.InsertOne(ctx, bson.M{"idempotencyKey": key, "userId": userID, "sentAt": time.Now()})
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("failed to record sent telegram notification: %w", err)
+	}
+	return nil
+}
+
+// telegramNotifier forwards notifications to the Telegram bot
+type telegramNotifier struct {
+	url    string
+	token  string
+	client *http.Client
+	sent   telegramSentLog
+}
+
+func newTelegramNotifier(url, token string, client *http.Client, sent telegramSentLog) *telegramNotifier {
+	return &telegramNotifier{url: url, token: token, client: client, sent: sent}
+}
+
+// Notify is a domainBus handler; the event ID is the idempotency key, so an
+// event the outbox retries is not sent again.
+func (t *telegramNotifier) Notify(ctx context.Context, event domainEvent) error {
+	n := ownerNotificationOf(event)
+	if n == nil {
+		return nil
+	}
+	_, err := t.Send(ctx, event.ID, n)
+	return err
+}
+
+// Send posts a notification to the bot unless the one with key was already
+// delivered, and reports whether it reached the user; it does not when they
+// have no Telegram linked or blocked the bot.
+func (t *telegramNotifier) Send(ctx context.Context, key string, n *userNotification) (bool, error) {
+	sent, err := t.sent.telegramNotificationSent(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if sent {
+		return true, nil
+	}
+
+	body, err := json.Marshal(n)
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Wili-Notify-Token", t.token)
+	req.Header.Set(outboxIdempotencyHdr, key)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to notify telegram bot: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return false, fmt.Errorf("telegram bot notification status %d", resp.StatusCode)
+	}
+	return true, t.sent.markTelegramNotificationSent(ctx, n.UserID, key)
+}
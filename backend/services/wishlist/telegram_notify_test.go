@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// memTelegramSentLog keeps sent notification keys in memory
+type memTelegramSentLog struct {
+	keys map[string]string // key -> user
+}
+
+func (m *memTelegramSentLog) telegramNotificationSent(ctx context.Context, key string) (bool, error) {
+	_, ok := m.keys[key]
+	return ok, nil
+}
+
+func (m *memTelegramSentLog) markTelegramNotificationSent(ctx context.Context, userID, key string) error {
+	if m.keys == nil {
+		m.keys = make(map[string]string)
+	}
+	m.keys[key] = userID
+	return nil
+}
+
+func testBookingEvent(eventType string, visibility wishlistgen.WishlistPrivacyBookerVisibility) domainEvent {
+	itemID := uuid.New()
+	wishlist := &wishlistgen.Wishlist{
+		Id:      uuid.New(),
+		Title:   "Birthday",
+		Privacy: wishlistgen.WishlistPrivacy{BookerVisibility: visibility},
+		Items: []wishlistgen.WishlistItem{{
+			Id:      itemID,
+			Data:    wishlistgen.WishlistItemData{Name: "Kettle"},
+			Booking: &wishlistgen.ItemBooking{BookerName: stringPtr("Anna"), Message: stringPtr("Happy birthday!"), BookedAt: time.Now()},
+		}},
+	}
+	return domainEvent{
+		ID:         outboxKey(wishlist.Id.String(), 2),
+		Type:       eventType,
+		WishlistID: wishlist.Id.String(),
+		OwnerID:    uuid.New().String(),
+		Version:    2,
+		ItemIDs:    []string{itemID.String()},
+		Wishlist:   wishlist,
+	}
+}
+
+func TestOwnerNotificationOf(t *testing.T) {
+	t.Run("visible_booking", func(t *testing.T) {
+		n := ownerNotificationOf(testBookingEvent("book_item", wishlistgen.Visible))
+		if n == nil || n.Type != string(wishlistgen.ActivityEventTypeItemBooked) {
+			t.Fatalf("Expected booked notification, got %+v", n)
+		}
+		if n.ItemName == nil || *n.ItemName != "Kettle" || n.BookerName == nil || *n.BookerName != "Anna" || n.Message == nil {
+			t.Errorf("Expected item, booker and message, got %+v", n)
+		}
+	})
+
+	t.Run("anonymous_hides_booker", func(t *testing.T) {
+		n := ownerNotificationOf(testBookingEvent("book_item", wishlistgen.Anonymous))
+		if n == nil || n.ItemName == nil || n.BookerName != nil || n.Message != nil {
+			t.Errorf("Expected item without booker, got %+v", n)
+		}
+	})
+
+	t.Run("surprise_hides_item", func(t *testing.T) {
+		n := ownerNotificationOf(testBookingEvent("book_item", wishlistgen.Surprise))
+		if n == nil || n.ItemName != nil || n.BookerName != nil {
+			t.Errorf("Expected notification without item and booker, got %+v", n)
+		}
+	})
+
+	t.Run("unbooked_by_booker", func(t *testing.T) {
+		n := ownerNotificationOf(testBookingEvent("unbook_item", wishlistgen.Visible))
+		if n == nil || n.Type != string(wishlistgen.ActivityEventTypeItemUnbookedByToken) {
+			t.Errorf("Expected unbooked notification, got %+v", n)
+		}
+	})
+
+	t.Run("unbooked_by_owner", func(t *testing.T) {
+		event := testBookingEvent("unbook_item", wishlistgen.Visible)
+		owner := event.OwnerID
+		event.ActorID = &owner
+		if n := ownerNotificationOf(event); n != nil {
+			t.Errorf("Expected no notification, got %+v", n)
+		}
+	})
+
+	t.Run("other_actions", func(t *testing.T) {
+		if n := ownerNotificationOf(testBookingEvent("update_item", wishlistgen.Visible)); n != nil {
+			t.Errorf("Expected no notification, got %+v", n)
+		}
+	})
+}
+
+func TestTelegramNotifierNotify(t *testing.T) {
+	var received userNotification
+	var token, key string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token = r.Header.Get("X-Wili-Notify-Token")
+		key = r.Header.Get(outboxIdempotencyHdr)
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	event := testBookingEvent("book_item", wishlistgen.Visible)
+	notifier := newTelegramNotifier(server.URL, "secret", server.Client(), &memTelegramSentLog{})
+	if err := notifier.Notify(context.Background(), event); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if token != "secret" || key != event.ID {
+		t.Errorf("Expected token and idempotency key, got %q and %q", token, key)
+	}
+	if received.UserID != event.OwnerID || received.WishlistTitle != "Birthday" {
+		t.Errorf("Unexpected notification: %+v", received)
+	}
+}
+
+func TestTelegramNotifierSendsOnce(t *testing.T) {
+	posts := 0
+	status := http.StatusBadGateway
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		posts++
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	sent := &memTelegramSentLog{}
+	notifier := newTelegramNotifier(server.URL, "secret", server.Client(), sent)
+	event := testBookingEvent("book_item", wishlistgen.Visible)
+
+	if err := notifier.Notify(context.Background(), event); err == nil {
+		t.Fatal("Expected an error while the bot fails")
+	}
+	status = http.StatusNoContent
+	for i := 0; i < 2; i++ {
+		if err := notifier.Notify(context.Background(), event); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if posts != 2 {
+		t.Errorf("Expected the failed post to be retried and the delivered one not, got %d posts", posts)
+	}
+	if sent.keys[event.ID] != event.OwnerID {
+		t.Errorf("Expected the delivery to be recorded for the owner, got %v", sent.keys)
+	}
+}
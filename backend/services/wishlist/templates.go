@@ -0,0 +1,118 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// localized holds one string per supported template language
+type localized map[wishlistgen.TemplateLanguage]string
+
+type wishlistTemplate struct {
+	ID          string
+	Title       localized
+	Description localized
+	Items       []localized
+}
+
+var wishlistTemplates = []wishlistTemplate{
+	{
+		ID:          "newborn",
+		Title:       localized{wishlistgen.En: "Newborn", wishlistgen.Ru: "Новорождённый"},
+		Description: localized{wishlistgen.En: "Essentials for the first months", wishlistgen.Ru: "Всё необходимое на первые месяцы"},
+		Items: []localized{
+			{wishlistgen.En: "Stroller", wishlistgen.Ru: "Коляска"},
+			{wishlistgen.En: "Car seat", wishlistgen.Ru: "Автокресло"},
+			{wishlistgen.En: "Baby monitor", wishlistgen.Ru: "Радионяня"},
+			{wishlistgen.En: "Baby carrier", wishlistgen.Ru: "Слинг"},
+			{wishlistgen.En: "Bath tub", wishlistgen.Ru: "Ванночка"},
+			{wishlistgen.En: "Diapers", wishlistgen.Ru: "Подгузники"},
+		},
+	},
+	{
+		ID:          "housewarming",
+		Title:       localized{wishlistgen.En: "Housewarming", wishlistgen.Ru: "Новоселье"},
+		Description: localized{wishlistgen.En: "Things for the new home", wishlistgen.Ru: "Вещи для нового дома"},
+		Items: []localized{
+			{wishlistgen.En: "Cookware set", wishlistgen.Ru: "Набор посуды"},
+			{wishlistgen.En: "Bed linen", wishlistgen.Ru: "Постельное бельё"},
+			{wishlistgen.En: "Towels", wishlistgen.Ru: "Полотенца"},
+			{wishlistgen.En: "Houseplant", wishlistgen.Ru: "Комнатное растение"},
+			{wishlistgen.En: "Toolbox", wishlistgen.Ru: "Набор инструментов"},
+		},
+	},
+	{
+		ID:          "birthday",
+		Title:       localized{wishlistgen.En: "Birthday", wishlistgen.Ru: "День рождения"},
+		Description: localized{wishlistgen.En: "Ideas for my birthday", wishlistgen.Ru: "Идеи подарков на день рождения"},
+		Items: []localized{
+			{wishlistgen.En: "Book", wishlistgen.Ru: "Книга"},
+			{wishlistgen.En: "Headphones", wishlistgen.Ru: "Наушники"},
+			{wishlistgen.En: "Gift card", wishlistgen.Ru: "Подарочный сертификат"},
+		},
+	},
+}
+
+func findTemplate(id string) *wishlistTemplate {
+	for i := range wishlistTemplates {
+		if wishlistTemplates[i].ID == id {
+			return &wishlistTemplates[i]
+		}
+	}
+	return nil
+}
+
+func templateLanguage(language *wishlistgen.TemplateLanguage) wishlistgen.TemplateLanguage {
+	if language == nil {
+		return wishlistgen.En
+	}
+	return *language
+}
+
+func isSupportedLanguage(language wishlistgen.TemplateLanguage) bool {
+	return language == wishlistgen.En || language == wishlistgen.Ru
+}
+
+// items returns the template items as create requests in the given language
+func (t *wishlistTemplate) items(language wishlistgen.TemplateLanguage) []wishlistgen.CreateWishlistItemRequest {
+	items := make([]wishlistgen.CreateWishlistItemRequest, 0, len(t.Items))
+	for _, name := range t.Items {
+		items = append(items, wishlistgen.CreateWishlistItemRequest{
+			Type: "general",
+			Data: wishlistgen.WishlistItemData{Name: name[language]},
+		})
+	}
+	return items
+}
+
+func (t *wishlistTemplate) toAPI(language wishlistgen.TemplateLanguage) wishlistgen.WishlistTemplate {
+	description := t.Description[language]
+	return wishlistgen.WishlistTemplate{
+		Id:          t.ID,
+		Title:       t.Title[language],
+		Description: &description,
+		Items:       t.items(language),
+	}
+}
+
+// List wishlist templates (public endpoint)
+func (s *WishlistServer) GetTemplates(w http.ResponseWriter, r *http.Request, params wishlistgen.GetTemplatesParams) {
+	s.logger.LogRequest(r, nil, "get_templates")
+
+	language := templateLanguage(params.Language)
+	if !isSupportedLanguage(language) {
+		s.logger.LogBadRequest(r.Context(), nil, "get_templates", fmt.Sprintf("unsupported language %q", language))
+		s.writeError(w, http.StatusBadRequest, "Unsupported language")
+		return
+	}
+
+	templates := make([]wishlistgen.WishlistTemplate, 0, len(wishlistTemplates))
+	for i := range wishlistTemplates {
+		templates = append(templates, wishlistTemplates[i].toAPI(language))
+	}
+
+	s.logger.LogSuccess(r.Context(), nil, "get_templates", fmt.Sprintf("listed %d templates in %s", len(templates), language))
+	s.writeJSON(w, http.StatusOK, wishlistgen.TemplatesResponse{Templates: templates})
+}
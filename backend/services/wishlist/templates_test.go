@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestTemplatesAreValidInEveryLanguage(t *testing.T) {
+	for _, tmpl := range wishlistTemplates {
+		for _, language := range []wishlistgen.TemplateLanguage{wishlistgen.En, wishlistgen.Ru} {
+			t.Run(tmpl.ID+"_"+string(language), func(t *testing.T) {
+				api := tmpl.toAPI(language)
+				if api.Title == "" || api.Description == nil || *api.Description == "" {
+					t.Errorf("Expected title and description, got %+v", api)
+				}
+				for _, item := range api.Items {
+					if errs := ValidateCreateWishlistItemRequest(item); len(errs) > 0 {
+						t.Errorf("Invalid template item %+v: %v", item, errs)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestValidateCreateWishlistRequestTemplate(t *testing.T) {
+	language := wishlistgen.TemplateLanguage("de")
+
+	tests := []struct {
+		name          string
+		req           wishlistgen.CreateWishlistRequest
+		expectedCount int
+	}{
+		{
+			name:          "known_template",
+			req:           wishlistgen.CreateWishlistRequest{Title: "Baby", TemplateId: stringPtr("newborn")},
+			expectedCount: 0,
+		},
+		{
+			name:          "unknown_template",
+			req:           wishlistgen.CreateWishlistRequest{Title: "Baby", TemplateId: stringPtr("wedding")},
+			expectedCount: 1,
+		},
+		{
+			name:          "unsupported_language",
+			req:           wishlistgen.CreateWishlistRequest{Title: "Baby", TemplateId: stringPtr("newborn"), Language: &language},
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := ValidateCreateWishlistRequest(tt.req); len(errs) != tt.expectedCount {
+				t.Errorf("Expected %d errors, got %d: %v", tt.expectedCount, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestGetTemplates(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	ru := wishlistgen.Ru
+
+	rec := httptest.NewRecorder()
+	s.GetTemplates(rec, httptest.NewRequest(http.MethodGet, "/templates?language=ru", nil), wishlistgen.GetTemplatesParams{Language: &ru})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	var resp wishlistgen.TemplatesResponse
+	if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if len(resp.Templates) != len(wishlistTemplates) {
+		t.Fatalf("Expected %d templates, got %d", len(wishlistTemplates), len(resp.Templates))
+	}
+	if resp.Templates[0].Title != "Новорождённый" {
+		t.Errorf("Expected Russian title, got %q", resp.Templates[0].Title)
+	}
+}
+
+func TestCopyWishlistRequiresAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+
+	rec := httptest.NewRecorder()
+	s.PostWishlistsWishlistIdCopy(rec, httptest.NewRequest(http.MethodPost, "/", nil), uuid.New())
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+	}
+}
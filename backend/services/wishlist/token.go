@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// tokenVerifier checks access tokens issued by the user service without
+// calling it, with either the public keys the user service publishes or the
+// secret it signs with
+type tokenVerifier struct {
+	issuer   string
+	audience string
+	jwks     *jwksKeys
+	secret   []byte
+}
+
+// newTokenVerifier returns a verifier for tokens issued by issuer for
+// audience and signed with a key of jwks, or with secret (HS256) when jwks is
+// nil. It returns nil when neither is set, leaving validation to the user
+// service.
+func newTokenVerifier(jwks *jwksKeys, secret, issuer, audience string) *tokenVerifier {
+	if jwks == nil && secret == "" {
+		return nil
+	}
+	return &tokenVerifier{issuer: issuer, audience: audience, jwks: jwks, secret: []byte(secret)}
+}
+
+// accessClaims are the claims of an access token; sid names the session it
+// belongs to, absent from tokens the Telegram bot uses for its own calls
+type accessClaims struct {
+	jwt.RegisteredClaims
+	SessionID string `json:"sid,omitempty"`
+}
+
+// Verify checks the signature, issuer, audience and expiry of token and
+// returns the user it was issued to and its session, "" when it has none.
+// Tokens that can not be checked because the keys are unavailable fail with
+// errKeysUnavailable rather than errTokenRejected.
+func (v *tokenVerifier) Verify(ctx context.Context, token string) (openapi_types.UUID, string, error) {
+	claims := accessClaims{}
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		if v.jwks == nil {
+			if t.Method != jwt.SigningMethodHS256 {
+				return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+			}
+			return v.secret, nil
+		}
+		kid, _ := t.Header["kid"].(string)
+		key, err := v.jwks.Key(ctx, kid)
+		if err != nil {
+			return nil, err
+		}
+		if t.Method.Alg() != key.alg {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+		return key.public, nil
+	}, jwt.WithIssuer(v.issuer), jwt.WithAudience(v.audience), jwt.WithExpirationRequired())
+	if errors.Is(err, errKeysUnavailable) {
+		return openapi_types.UUID{}, "", err
+	}
+	if err != nil {
+		return openapi_types.UUID{}, "", fmt.Errorf("%w: %v", errTokenRejected, err)
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return openapi_types.UUID{}, "", fmt.Errorf("%w: subject is not a user id", errTokenRejected)
+	}
+	return userID, claims.SessionID, nil
+}
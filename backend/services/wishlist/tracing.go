@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/theseems/wili/backend/services/wishlist"
+
+// mongoCommandMonitor traces every MongoDB command as a span of the request
+// or job that issued it. Spans name the command and collection, never the
+// documents or filters.
+func mongoCommandMonitor() *event.CommandMonitor {
+	tracer := otel.Tracer(tracerName)
+	var spans sync.Map // request ID -> trace.Span
+
+	finish := func(requestID int64, failure string) {
+		value, ok := spans.LoadAndDelete(requestID)
+		if !ok {
+			return
+		}
+		span := value.(trace.Span)
+		if failure != "" {
+			span.SetStatus(codes.Error, failure)
+		}
+		span.End()
+	}
+
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			collection, _ := e.Command.Lookup(e.CommandName).StringValueOK()
+			name := "mongodb " + e.CommandName
+			if collection != "" {
+				name += " " + collection
+			}
+			_, span := tracer.Start(ctx, name,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("db.system.name", "mongodb"),
+					attribute.String("db.namespace", e.DatabaseName),
+					attribute.String("db.operation.name", e.CommandName),
+					attribute.String("db.collection.name", collection),
+				),
+			)
+			spans.Store(e.RequestID, span)
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			finish(e.RequestID, "")
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			finish(e.RequestID, e.Failure)
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/theseems/wili/backend/telemetry"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestMongoCommandMonitor(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := telemetry.InstallTracing("wishlist", sdktrace.NewSimpleSpanProcessor(exporter))
+	defer provider.Shutdown(context.Background())
+
+	ctx, parent := telemetry.StartClientSpan(context.Background(), "request")
+	monitor := mongoCommandMonitor()
+
+	find, _ := bson.Marshal(bson.D{{Key: "find", Value: "wishlists"}, {Key: "filter", Value: bson.D{{Key: "userId", Value: "secret"}}}})
+	monitor.Started(ctx, &event.CommandStartedEvent{Command: find, DatabaseName: "wili", CommandName: "find", RequestID: 1})
+	update, _ := bson.Marshal(bson.D{{Key: "update", Value: "wishlists"}})
+	monitor.Started(ctx, &event.CommandStartedEvent{Command: update, DatabaseName: "wili", CommandName: "update", RequestID: 2})
+	monitor.Failed(ctx, &event.CommandFailedEvent{CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "update", RequestID: 2}, Failure: "duplicate key"})
+	monitor.Succeeded(ctx, &event.CommandSucceededEvent{CommandFinishedEvent: event.CommandFinishedEvent{CommandName: "find", RequestID: 1}})
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+	failed, succeeded := spans[0], spans[1]
+	if failed.Name != "mongodb update wishlists" || failed.Status.Code != codes.Error {
+		t.Errorf("Expected a failed update span, got %q with status %v", failed.Name, failed.Status.Code)
+	}
+	if succeeded.Name != "mongodb find wishlists" || succeeded.Status.Code == codes.Error {
+		t.Errorf("Expected a find span, got %q with status %v", succeeded.Name, succeeded.Status.Code)
+	}
+	if succeeded.Parent.SpanID() != parent.SpanContext().SpanID() {
+		t.Error("Expected command spans to be children of the request span")
+	}
+	for _, attr := range succeeded.Attributes {
+		if attr.Value.Emit() == "secret" {
+			t.Error("Expected command contents to stay out of spans")
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// ErrTransactionsUnavailable is returned for moves on a standalone MongoDB:
+// without a transaction, items pulled from the source could be lost.
+var ErrTransactionsUnavailable = errors.New("moving items needs MongoDB transactions")
+
+// transferResult holds both wishlists as they are after a committed transfer,
+// with the revisions recorded for them
+type transferResult struct {
+	source    *mongoWishlist
+	target    *mongoWishlist
+	sourceRec revisionRecord
+	targetRec revisionRecord
+}
+
+// selectTransferItems picks the live items with the given IDs from a wishlist,
+// in request order and without duplicates.
+func selectTransferItems(mw *mongoWishlist, itemIDs []openapi_types.UUID) ([]mongoWishlistItem, error) {
+	seen := make(map[string]bool, len(itemIDs))
+	items := make([]mongoWishlistItem, 0, len(itemIDs))
+	for _, id := range itemIDs {
+		if seen[id.String()] {
+			continue
+		}
+		seen[id.String()] = true
+
+		item := findItem(mw, id.String())
+		if item == nil || item.DeletedAt != nil {
+			return nil, fmt.Errorf("item %s not found", id.String())
+		}
+		items = append(items, *item)
+	}
+	return items, nil
+}
+
+// movedItems prepares items for the target wishlist of a move according to the booking policy
+func movedItems(items []mongoWishlistItem, policy wishlistgen.TransferItemsRequestBookingPolicy, now time.Time) ([]mongoWishlistItem, error) {
+	moved := make([]mongoWishlistItem, 0, len(items))
+	for _, item := range items {
+		if item.Booking != nil {
+			switch policy {
+			case wishlistgen.Reject:
+				return nil, fmt.Errorf("item %s is booked", item.ID)
+			case wishlistgen.Release:
+				item.Booking = nil
+			}
+		}
+		item.UpdatedAt = now
+		moved = append(moved, item)
+	}
+	return moved, nil
+}
+
+// copiedItems returns fresh copies of items with new IDs and no bookings
+func copiedItems(items []mongoWishlistItem, now time.Time) []mongoWishlistItem {
+	copied := make([]mongoWishlistItem, 0, len(items))
+	for _, item := range items {
+		copied = append(copied, mongoWishlistItem{
+			ID:        uuid.New().String(),
+			Type:      item.Type,
+			Data:      item.Data,
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	return copied
+}
+
+// TransferItems moves or copies items between two wishlists of the same owner
+// in one transaction. Moves are refused when the deployment has no
+// transactions. ifMatch applies to the source wishlist.
+func (r *MongoRepo) TransferItems(ctx context.Context, sourceID, userID openapi_types.UUID, req wishlistgen.TransferItemsRequest, move bool, ifMatch []int64) (*wishlistgen.Wishlist, *wishlistgen.Wishlist, error) {
+	if move && !r.transactions {
+		return nil, nil, ErrTransactionsUnavailable
+	}
+	policy := wishlistgen.Reject
+	if req.BookingPolicy != nil {
+		policy = *req.BookingPolicy
+	}
+	action := "copy_items"
+	if move {
+		action = "move_items"
+	}
+
+	var result transferResult
+	err := r.transact(ctx, func(ctx context.Context) error {
+		source, err := r.findOwned(ctx, sourceID, userID)
+		if err != nil {
+			return err
+		}
+		if ifMatch != nil && !containsVersion(ifMatch, source.Version) {
+			return ErrVersionMismatch
+		}
+		target, err := r.findOwned(ctx, req.TargetWishlistId, userID)
+		if err != nil {
+			return fmt.Errorf("target %w", err)
+		}
+
+		items, err := selectTransferItems(source, req.ItemIds)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		var pushed []mongoWishlistItem
+		if move {
+			if pushed, err = movedItems(items, policy, now); err != nil {
+				return err
+			}
+
+			ids := make([]string, len(items))
+			for i, item := range items {
+				ids[i] = item.ID
+			}
+			update := bson.M{
+				"$pull": bson.M{"items": bson.M{"id": bson.M{"$in": ids}}},
+				"$set":  bson.M{"updatedAt": now},
+			}
+			if source, err = r.applyUpdate(ctx, bson.M{"uuid": source.UUID}, update, []int64{source.Version}); err != nil {
+				return err
+			}
+			if result.sourceRec, err = r.recordWrite(ctx, source, &userID, action, ids...); err != nil {
+				return err
+			}
+		} else {
+			pushed = copiedItems(items, now)
+		}
+
+		update := bson.M{
+			"$push": bson.M{"items": bson.M{"$each": pushed}},
+			"$set":  bson.M{"updatedAt": now},
+		}
+		// Pushing does not depend on the target's other items, so it is not
+		// conditional
+		if target, err = r.applyUpdate(ctx, bson.M{"uuid": target.UUID}, update, nil); err != nil {
+			return err
+		}
+		pushedIDs := make([]string, len(pushed))
+		for i, item := range pushed {
+			pushedIDs[i] = item.ID
+		}
+		if result.targetRec, err = r.recordWrite(ctx, target, &userID, action, pushedIDs...); err != nil {
+			return err
+		}
+
+		result.source, result.target = source, target
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to transfer items: %w", err)
+	}
+
+	if move {
+		r.afterWrite(ctx, result.source, result.sourceRec, &userID, action)
+	}
+	r.afterWrite(ctx, result.target, result.targetRec, &userID, action)
+
+	source := r.convertToAPIWishlist(*result.source)
+	target := r.convertToAPIWishlist(*result.target)
+	return &source, &target, nil
+}
+
+// Move items to another wishlist of the same owner
+func (s *WishlistServer) PostWishlistsWishlistIdItemsMove(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsMoveParams) {
+	s.transferItems(w, r, wishlistId, params.IfMatch, true)
+}
+
+// Copy items to another wishlist of the same owner
+func (s *WishlistServer) PostWishlistsWishlistIdItemsCopy(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, params wishlistgen.PostWishlistsWishlistIdItemsCopyParams) {
+	s.transferItems(w, r, wishlistId, params.IfMatch, false)
+}
+
+func (s *WishlistServer) transferItems(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID, ifMatchHeader *string, move bool) {
+	action := "copy_items"
+	if move {
+		action = "move_items"
+	}
+	s.logger.LogRequest(r, nil, action)
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	var req wishlistgen.TransferItemsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.LogBadRequest(r.Context(), &userID, action, fmt.Sprintf("malformed JSON: %v", err))
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return
+	}
+
+	if validationErrors := ValidateTransferItemsRequest(wishlistId, req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, action, validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	source, target, err := s.repo.TransferItems(r.Context(), wishlistId, userID, req, move, ifMatchVersions(ifMatchHeader))
+	if err != nil {
+		if errors.Is(err, ErrVersionMismatch) {
+			s.logger.LogConflict(r.Context(), &userID, action, fmt.Sprintf("stale If-Match for wishlist %s", wishlistId.String()))
+			s.writePreconditionFailed(w)
+			return
+		}
+		if errors.Is(err, ErrTransactionsUnavailable) {
+			s.logger.LogError(r.Context(), &userID, action, err, "MongoDB runs without transactions")
+			s.writeError(w, http.StatusServiceUnavailable, "Moving items is unavailable on this deployment")
+			return
+		}
+		if strings.Contains(err.Error(), "is booked") {
+			s.logger.LogConflict(r.Context(), &userID, action, err.Error())
+			s.writeError(w, http.StatusConflict, "Some items are booked; choose a booking policy to move them")
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist or item", err.Error())
+			s.writeError(w, http.StatusNotFound, "Wishlist or item not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, action, err, fmt.Sprintf("failed to transfer items from wishlist %s to %s", wishlistId.String(), req.TargetWishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to transfer items")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, action, fmt.Sprintf("transferred %d items from wishlist %s to %s", len(req.ItemIds), wishlistId.String(), req.TargetWishlistId.String()))
+	setETag(w, source.Version)
+	s.writeJSON(w, http.StatusOK, wishlistgen.TransferItemsResponse{Source: *source, Target: *target})
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestSelectTransferItems(t *testing.T) {
+	a, b, trashed := uuid.New(), uuid.New(), uuid.New()
+	deletedAt := time.Now()
+	mw := &mongoWishlist{Items: []mongoWishlistItem{
+		{ID: a.String()},
+		{ID: b.String()},
+		{ID: trashed.String(), DeletedAt: &deletedAt},
+	}}
+
+	tests := []struct {
+		name        string
+		ids         []openapi_types.UUID
+		expected    []string
+		expectError bool
+	}{
+		{name: "request_order", ids: []openapi_types.UUID{b, a}, expected: []string{b.String(), a.String()}},
+		{name: "duplicates_ignored", ids: []openapi_types.UUID{a, a}, expected: []string{a.String()}},
+		{name: "unknown_item", ids: []openapi_types.UUID{a, uuid.New()}, expectError: true},
+		{name: "trashed_item", ids: []openapi_types.UUID{trashed}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := selectTransferItems(mw, tt.ids)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("Expected error, got %v", items)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(items) != len(tt.expected) {
+				t.Fatalf("Expected %d items, got %d", len(tt.expected), len(items))
+			}
+			for i, item := range items {
+				if item.ID != tt.expected[i] {
+					t.Errorf("Expected item %d to be %s, got %s", i, tt.expected[i], item.ID)
+				}
+			}
+		})
+	}
+}
+
+func TestMovedItemsBookingPolicy(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-time.Hour)
+	items := []mongoWishlistItem{
+		{ID: "free", CreatedAt: created},
+		{ID: "booked", CreatedAt: created, Booking: &mongoItemBooking{BookingID: "bk"}},
+	}
+
+	if _, err := movedItems(items, wishlistgen.Reject, now); err == nil {
+		t.Error("Expected reject policy to fail on a booked item")
+	}
+
+	kept, err := movedItems(items, wishlistgen.Keep, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if kept[1].Booking == nil || kept[1].ID != "booked" || !kept[1].CreatedAt.Equal(created) || !kept[1].UpdatedAt.Equal(now) {
+		t.Errorf("Expected keep policy to move item with booking and metadata, got %+v", kept[1])
+	}
+
+	released, err := movedItems(items, wishlistgen.Release, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if released[1].Booking != nil {
+		t.Error("Expected release policy to drop the booking")
+	}
+	if items[1].Booking == nil {
+		t.Error("Expected source items to be left untouched")
+	}
+}
+
+func TestCopiedItems(t *testing.T) {
+	now := time.Now()
+	items := []mongoWishlistItem{
+		{ID: "a", Type: "general", Data: map[string]interface{}{"name": "Book"}, Booking: &mongoItemBooking{BookingID: "bk"}},
+	}
+
+	copied := copiedItems(items, now)
+	if len(copied) != 1 {
+		t.Fatalf("Expected 1 item, got %d", len(copied))
+	}
+	if copied[0].ID == "a" || copied[0].Booking != nil || copied[0].Data["name"] != "Book" || !copied[0].CreatedAt.Equal(now) {
+		t.Errorf("Expected fresh unbooked copy, got %+v", copied[0])
+	}
+}
+
+func TestTransferItems_MoveNeedsTransactions(t *testing.T) {
+	repo := &MongoRepo{}
+	req := wishlistgen.TransferItemsRequest{TargetWishlistId: uuid.New(), ItemIds: []openapi_types.UUID{uuid.New()}}
+
+	if _, _, err := repo.TransferItems(context.Background(), uuid.New(), uuid.New(), req, true, nil); !errors.Is(err, ErrTransactionsUnavailable) {
+		t.Errorf("Expected ErrTransactionsUnavailable, got %v", err)
+	}
+}
+
+func TestValidateTransferItemsRequest(t *testing.T) {
+	source := uuid.New()
+	policy := wishlistgen.TransferItemsRequestBookingPolicy("drop")
+
+	tests := []struct {
+		name          string
+		req           wishlistgen.TransferItemsRequest
+		expectedCount int
+	}{
+		{
+			name:          "valid",
+			req:           wishlistgen.TransferItemsRequest{TargetWishlistId: uuid.New(), ItemIds: []openapi_types.UUID{uuid.New()}},
+			expectedCount: 0,
+		},
+		{
+			name:          "same_wishlist",
+			req:           wishlistgen.TransferItemsRequest{TargetWishlistId: source, ItemIds: []openapi_types.UUID{uuid.New()}},
+			expectedCount: 1,
+		},
+		{
+			name:          "no_items",
+			req:           wishlistgen.TransferItemsRequest{TargetWishlistId: uuid.New()},
+			expectedCount: 1,
+		},
+		{
+			name:          "unknown_policy",
+			req:           wishlistgen.TransferItemsRequest{TargetWishlistId: uuid.New(), ItemIds: []openapi_types.UUID{uuid.New()}, BookingPolicy: &policy},
+			expectedCount: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := ValidateTransferItemsRequest(source, tt.req); len(errs) != tt.expectedCount {
+				t.Errorf("Expected %d errors, got %d: %v", tt.expectedCount, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestTransferEndpointsRequireAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	wishlistID := uuid.New()
+
+	tests := []struct {
+		name    string
+		handler func(w http.ResponseWriter, r *http.Request)
+	}{
+		{
+			name: "move_items",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.PostWishlistsWishlistIdItemsMove(w, r, wishlistID, wishlistgen.PostWishlistsWishlistIdItemsMoveParams{})
+			},
+		},
+		{
+			name: "copy_items",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.PostWishlistsWishlistIdItemsCopy(w, r, wishlistID, wishlistgen.PostWishlistsWishlistIdItemsCopyParams{})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+// List trashed wishlists and items of the authenticated user
+func (s *WishlistServer) GetTrash(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "get_trash")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	trash, err := s.repo.GetTrash(r.Context(), userID, s.trashRetention)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "get_trash", err, "failed to retrieve trash from database")
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve trash")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_trash", fmt.Sprintf("retrieved %d wishlists and %d items from trash", len(trash.Wishlists), len(trash.Items)))
+	s.writeJSON(w, http.StatusOK, trash)
+}
+
+// Restore a wishlist from the trash (owner only)
+func (s *WishlistServer) PostWishlistsWishlistIdRestore(w http.ResponseWriter, r *http.Request, wishlistId openapi_types.UUID) {
+	s.logger.LogRequest(r, nil, "restore_wishlist")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	wishlist, err := s.repo.RestoreWishlist(r.Context(), wishlistId, userID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "trashed wishlist", wishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found in trash")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "restore_wishlist", err, fmt.Sprintf("failed to restore wishlist %s", wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to restore wishlist")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "restore_wishlist", fmt.Sprintf("restored wishlist %s", wishlistId.String()))
+	setETag(w, wishlist.Version)
+	s.writeJSON(w, http.StatusOK, wishlist)
+}
+
+// Restore an item from the trash (owner only)
+func (s *WishlistServer) PostWishlistsWishlistIdItemsItemIdRestore(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID) {
+	s.logger.LogRequest(r, nil, "restore_item")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	item, version, err := s.repo.RestoreWishlistItem(r.Context(), wishlistId, itemId, userID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "trashed item", fmt.Sprintf("%s in wishlist %s", itemId.String(), wishlistId.String()))
+			s.writeError(w, http.StatusNotFound, "Item not found in trash")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "restore_item", err, fmt.Sprintf("failed to restore item %s in wishlist %s", itemId.String(), wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to restore item")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "restore_item", fmt.Sprintf("restored item %s in wishlist %s", itemId.String(), wishlistId.String()))
+	setETag(w, version)
+	s.writeJSON(w, http.StatusOK, item)
+}
+
+// Get the state of a booking by its cancellation token (public endpoint)
+func (s *WishlistServer) GetWishlistsWishlistIdItemsItemIdBooking(w http.ResponseWriter, r *http.Request, wishlistId, itemId openapi_types.UUID, params wishlistgen.GetWishlistsWishlistIdItemsItemIdBookingParams) {
+	s.logger.LogRequest(r, nil, "get_booking_status")
+
+	status, err := s.repo.GetBookingStatus(r.Context(), itemId, params.CancellationToken.String())
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), nil, "booking", fmt.Sprintf("for item %s in wishlist %s", itemId.String(), wishlistId.String()))
+			s.writeError(w, http.StatusNotFound, "Booking not found")
+			return
+		}
+		s.logger.LogError(r.Context(), nil, "get_booking_status", err, fmt.Sprintf("failed to retrieve booking for item %s in wishlist %s", itemId.String(), wishlistId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve booking")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), nil, "get_booking_status", fmt.Sprintf("booking for item %s in wishlist %s is %s", itemId.String(), wishlistId.String(), status.State))
+	s.writeJSON(w, http.StatusOK, status)
+}
+
+// purgeTrashJob permanently deletes trash entries older than retention
+func purgeTrashJob(repo *MongoRepo, retention time.Duration, logger *Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		wishlists, items, err := repo.PurgeTrash(ctx, start.Add(-retention))
+		if err != nil {
+			return fmt.Errorf("failed to purge trash: %w", err)
+		}
+		logger.LogDatabaseOperation(ctx, "purge_trash", time.Since(start), true,
+			fmt.Sprintf("purged %d wishlists and %d items older than %v", wishlists, items, retention))
+		return nil
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTrashEndpointsRequireAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	wishlistID := uuid.New()
+	itemID := uuid.New()
+
+	tests := []struct {
+		name    string
+		handler func(w http.ResponseWriter, r *http.Request)
+	}{
+		{
+			name:    "get_trash",
+			handler: s.GetTrash,
+		},
+		{
+			name: "restore_wishlist",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.PostWishlistsWishlistIdRestore(w, r, wishlistID)
+			},
+		},
+		{
+			name: "restore_item",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				s.PostWishlistsWishlistIdItemsItemIdRestore(w, r, wishlistID, itemID)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"sync"
+	"time"
+)
+
+// userCache remembers users the user service validated tokens for, for ttl
+// and at most size tokens; the least recently used token is evicted first.
+// Tokens are keyed by their hash, so the cache holds no credentials.
+type userCache struct {
+	ttl  time.Duration
+	size int
+	now  func() time.Time
+
+	mu      sync.Mutex
+	order   *list.List // of *userCacheEntry, most recently used first
+	entries map[[sha256.Size]byte]*list.Element
+}
+
+type userCacheEntry struct {
+	key     [sha256.Size]byte
+	user    UserInfo
+	expires time.Time
+}
+
+func newUserCache(ttl time.Duration, size int) *userCache {
+	return &userCache{
+		ttl:     ttl,
+		size:    size,
+		now:     time.Now,
+		order:   list.New(),
+		entries: make(map[[sha256.Size]byte]*list.Element),
+	}
+}
+
+// Get returns the user token was validated for, unless that has expired
+func (c *userCache) Get(token string) (UserInfo, bool) {
+	key := sha256.Sum256([]byte(token))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	element, ok := c.entries[key]
+	if !ok {
+		return UserInfo{}, false
+	}
+	entry := element.Value.(*userCacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.order.Remove(element)
+		delete(c.entries, key)
+		return UserInfo{}, false
+	}
+	c.order.MoveToFront(element)
+	return entry.user, true
+}
+
+// Put remembers that token was validated for user
+func (c *userCache) Put(token string, user UserInfo) {
+	if c.size <= 0 {
+		return
+	}
+	key := sha256.Sum256([]byte(token))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.entries[key]; ok {
+		entry := element.Value.(*userCacheEntry)
+		entry.user, entry.expires = user, c.now().Add(c.ttl)
+		c.order.MoveToFront(element)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&userCacheEntry{key: key, user: user, expires: c.now().Add(c.ttl)})
+	for c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*userCacheEntry).key)
+	}
+}
@@ -4,18 +4,41 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/theseems/wili/backend/telemetry"
 )
 
-// UserClient wraps HTTP calls to the user service
+// errTokenRejected is returned for tokens that are invalid or expired
+var errTokenRejected = errors.New("invalid token")
+
+const (
+	// userServiceAttempts bounds the attempts of one call to the user
+	// service; attempts after the first wait userServiceBackoff, doubling
+	userServiceAttempts = 3
+	userServiceBackoff  = 100 * time.Millisecond
+
+	// after userServiceBreakerThreshold consecutive failed attempts, calls
+	// fail fast for userServiceBreakerCooldown
+	userServiceBreakerThreshold = 5
+	userServiceBreakerCooldown  = 30 * time.Second
+)
+
+// UserClient wraps HTTP calls to the user service. With a token verifier it
+// checks tokens itself and only asks the user service about users.
 type UserClient struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL      string
+	serviceToken string
+	httpClient   *http.Client
+	verifier     *tokenVerifier
+	cache        *userCache
+	breaker      *circuitBreaker
 }
 
 // ValidateTokenRequest represents the request to validate a JWT token
@@ -36,19 +59,65 @@ type UserInfo struct {
 	AvatarUrl   *string            `json:"avatarUrl"`
 }
 
-// NewUserClient creates a new user service client
-func NewUserClient(baseURL string) *UserClient {
+// NewUserClient creates a new user service client; serviceToken authenticates
+// calls to internal endpoints, and every attempt of a call is bounded by
+// timeout. Without a verifier tokens are validated by the user service, and
+// the users they belong to are remembered in cache.
+func NewUserClient(baseURL, serviceToken string, timeout time.Duration, verifier *tokenVerifier, cache *userCache) *UserClient {
 	return &UserClient{
-		baseURL:    strings.TrimRight(baseURL, "/"),
-		httpClient: &http.Client{},
+		baseURL:      strings.TrimRight(baseURL, "/"),
+		serviceToken: serviceToken,
+		httpClient:   &http.Client{Timeout: timeout, Transport: &telemetry.Transport{}},
+		verifier:     verifier,
+		cache:        cache,
+		breaker:      newCircuitBreaker(userServiceBreakerThreshold, userServiceBreakerCooldown),
 	}
 }
 
-// ValidateToken calls the user service to validate a JWT token and extract user info
+// ValidateToken checks a JWT token and returns the user it belongs to. Tokens
+// are checked locally when the client has a verifier; the user then only has
+// an id, since profiles are left to LookupUser. Whether the session of a
+// locally checked token is still active is asked through LookupUser, so that
+// a revoked session stops working within the cache TTL; when the user service
+// can not answer, the local check stands.
 func (c *UserClient) ValidateToken(ctx context.Context, token string) (*UserInfo, error) {
 	token = strings.TrimPrefix(token, "Bearer ")
+	if c.verifier == nil {
+		return c.LookupUser(ctx, token)
+	}
+	userID, sessionID, err := c.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if sessionID != "" {
+		_, err := c.LookupUser(ctx, token)
+		if errors.Is(err, errTokenRejected) {
+			return nil, err
+		}
+		if err != nil {
+			slog.WarnContext(ctx, "could not check session with user service, trusting the token", "user_id", userID.String(), "error", err)
+		}
+	}
+	return &UserInfo{Id: userID}, nil
+}
+
+// LookupUser asks the user service who token belongs to, which also tells
+// whether the user still exists. Answers are cached until they expire.
+func (c *UserClient) LookupUser(ctx context.Context, token string) (*UserInfo, error) {
+	if user, ok := c.cache.Get(token); ok {
+		return &user, nil
+	}
+	user, err := c.validateToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Put(token, *user)
+	return user, nil
+}
 
-	log.Printf("[USER_CLIENT] Validating token with user service at %s", c.baseURL)
+// validateToken calls the user service to validate a JWT token and extract user info
+func (c *UserClient) validateToken(ctx context.Context, token string) (_ *UserInfo, err error) {
+	defer observeUserCall("validate_token", time.Now(), &err)
 
 	reqBody := ValidateTokenRequest{
 		Token: token,
@@ -59,22 +128,22 @@ func (c *UserClient) ValidateToken(ctx context.Context, token string) (*UserInfo
 		return nil, fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/auth/validate", bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/auth/validate", bytes.NewReader(jsonData))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		return req, nil
+	})
 	if err != nil {
-		log.Printf("[USER_CLIENT] Failed to connect to user service: %v", err)
-		return nil, fmt.Errorf("failed to make request: %w", err)
+		slog.ErrorContext(ctx, "failed to validate token with user service", "url", c.baseURL, "error", err)
+		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		log.Printf("[USER_CLIENT] User service returned status %d", resp.StatusCode)
+		slog.WarnContext(ctx, "user service rejected token validation", "status", resp.StatusCode)
 		return nil, fmt.Errorf("user service returned status: %d", resp.StatusCode)
 	}
 
@@ -84,10 +153,119 @@ func (c *UserClient) ValidateToken(ctx context.Context, token string) (*UserInfo
 	}
 
 	if !validateResp.Valid {
-		log.Printf("[USER_CLIENT] Token validation failed")
-		return nil, fmt.Errorf("invalid token")
+		return nil, errTokenRejected
 	}
 
-	log.Printf("[USER_CLIENT] Token validated successfully for user: %s", validateResp.User.DisplayName)
+	slog.DebugContext(ctx, "token validated", "user_id", validateResp.User.Id.String())
 	return &validateResp.User, nil
 }
+
+// GetEmail returns the email address of a user, or "" when the user has none
+func (c *UserClient) GetEmail(ctx context.Context, userID string) (_ string, err error) {
+	defer observeUserCall("get_email", time.Now(), &err)
+	resp, err := c.do(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/users/"+userID+"/email", nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("X-Wili-Service-Token", c.serviceToken)
+		return req, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("user service returned status: %d", resp.StatusCode)
+	}
+
+	var body struct {
+		Email string `json:"email"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("failed to decode response: %w", err)
+	}
+	return body.Email, nil
+}
+
+// do sends the request made by newRequest, retrying with backoff when the
+// user service cannot be reached or answers 429 or 5xx. Attempts go through
+// the circuit breaker, so that an outage fails calls fast instead of piling
+// them up. Other answers are returned as they are.
+func (c *UserClient) do(ctx context.Context, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	var lastErr error
+	for attempt := 0; attempt < userServiceAttempts; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("failed to make request: %w", lastErr)
+			case <-time.After(userServiceBackoff << (attempt - 1)):
+			}
+		}
+
+		req, err := newRequest()
+		if err != nil {
+			return nil, fmt.Errorf("failed to create request: %w", err)
+		}
+		if err := c.breaker.Allow(); err != nil {
+			return nil, err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err == nil && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < 500 {
+			c.breaker.Done(true)
+			return resp, nil
+		}
+		if err == nil {
+			resp.Body.Close()
+			err = fmt.Errorf("user service returned status: %d", resp.StatusCode)
+		}
+		if ctx.Err() != nil {
+			c.breaker.Abandon()
+			return nil, fmt.Errorf("failed to make request: %w", err)
+		}
+		c.breaker.Done(false)
+		lastErr = err
+		slog.WarnContext(ctx, "user service call failed", "attempt", attempt+1, "error", err)
+	}
+	return nil, fmt.Errorf("failed to make request: %w", lastErr)
+}
+
+// observeUserCall records a call to the user service; a rejected token is not
+// counted as an error of the user service
+func observeUserCall(operation string, start time.Time, err *error) {
+	outcome := "ok"
+	switch {
+	case errors.Is(*err, errTokenRejected):
+		outcome = "rejected"
+	case errors.Is(*err, errCircuitOpen):
+		outcome = "open_circuit"
+	case *err != nil:
+		outcome = "error"
+	}
+	telemetry.ObserveClientCall("user", operation, outcome, start)
+}
+
+// Ping checks that the user service is up. It asks for liveness rather than
+// readiness, so that a database outage of the user service does not take
+// this service out of rotation as well. It bypasses retries and the
+// circuit breaker to report the state of the user service as it is.
+func (c *UserClient) Ping(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+"/livez", nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("user service returned status: %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+func signTestToken(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+func TestTokenVerifier(t *testing.T) {
+	secret := []byte("shared-secret")
+	userID := uuid.New()
+	claims := func(sub, aud string, exp time.Duration) jwt.MapClaims {
+		return jwt.MapClaims{"sub": sub, "iss": "wili-user-service", "aud": aud, "exp": time.Now().Add(exp).Unix()}
+	}
+	valid := claims(userID.String(), "wili", time.Hour)
+
+	verifier := newTokenVerifier(nil, string(secret), "wili-user-service", "wili")
+	t.Run("valid", func(t *testing.T) {
+		got, _, err := verifier.Verify(context.Background(), signTestToken(t, jwt.SigningMethodHS256, secret, valid))
+		if err != nil || got != userID {
+			t.Errorf("Expected user %s, got %s (%v)", userID, got, err)
+		}
+	})
+
+	rejected := map[string]string{
+		"expired":        signTestToken(t, jwt.SigningMethodHS256, secret, claims(userID.String(), "wili", -time.Minute)),
+		"without_exp":    signTestToken(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{"sub": userID.String(), "iss": "wili-user-service", "aud": "wili"}),
+		"other_audience": signTestToken(t, jwt.SigningMethodHS256, secret, claims(userID.String(), "other", time.Hour)),
+		"without_issuer": signTestToken(t, jwt.SigningMethodHS256, secret, jwt.MapClaims{"sub": userID.String(), "aud": "wili", "exp": time.Now().Add(time.Hour).Unix()}),
+		"wrong_secret":   signTestToken(t, jwt.SigningMethodHS256, []byte("other"), valid),
+		"wrong_method":   signTestToken(t, jwt.SigningMethodHS512, secret, valid),
+		"unsigned":       signTestToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, valid),
+		"not_a_user_id":  signTestToken(t, jwt.SigningMethodHS256, secret, claims("admin", "wili", time.Hour)),
+		"garbage":        "not-a-token",
+	}
+	for name, token := range rejected {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := verifier.Verify(context.Background(), token); !errors.Is(err, errTokenRejected) {
+				t.Errorf("Expected the token to be rejected, got %v", err)
+			}
+		})
+	}
+
+	t.Run("unconfigured", func(t *testing.T) {
+		if verifier := newTokenVerifier(nil, "", "wili-user-service", "wili"); verifier != nil {
+			t.Errorf("Expected no verifier, got %v", verifier)
+		}
+	})
+}
+
+func TestUserCache(t *testing.T) {
+	now := time.Now()
+	cache := newUserCache(time.Minute, 2)
+	cache.now = func() time.Time { return now }
+
+	cache.Put("a", UserInfo{DisplayName: "A"})
+	cache.Put("b", UserInfo{DisplayName: "B"})
+	cache.Get("a")
+	cache.Put("c", UserInfo{DisplayName: "C"})
+	if _, ok := cache.Get("b"); ok {
+		t.Error("Expected the least recently used token to be evicted")
+	}
+	if user, ok := cache.Get("a"); !ok || user.DisplayName != "A" {
+		t.Errorf("Expected A to stay cached, got %+v", user)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("c"); ok {
+		t.Error("Expected C to expire")
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if err := breaker.Allow(); err != nil {
+			t.Fatalf("Expected call %d to be allowed, got %v", i+1, err)
+		}
+		breaker.Done(false)
+	}
+	if err := breaker.Allow(); !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("Expected the circuit to open, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if err := breaker.Allow(); err != nil {
+		t.Fatalf("Expected a trial call after the cooldown, got %v", err)
+	}
+	if err := breaker.Allow(); !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("Expected a single trial call, got %v", err)
+	}
+	breaker.Done(false)
+	if err := breaker.Allow(); !errors.Is(err, errCircuitOpen) {
+		t.Fatalf("Expected a failed trial to open the circuit again, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	breaker.Allow()
+	breaker.Done(true)
+	if err := breaker.Allow(); err != nil {
+		t.Errorf("Expected a successful trial to close the circuit, got %v", err)
+	}
+}
+
+func TestUserClient(t *testing.T) {
+	userID := uuid.New()
+	var calls, failures atomic.Int32
+	userService := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if failures.Load() > 0 {
+			failures.Add(-1)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var req ValidateTokenRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		json.NewEncoder(w).Encode(ValidateTokenResponse{Valid: req.Token == "good", User: UserInfo{Id: userID, DisplayName: "Anna"}})
+	}))
+	defer userService.Close()
+	newClient := func() *UserClient {
+		return NewUserClient(userService.URL, "", time.Second, nil, newUserCache(time.Minute, 10))
+	}
+
+	t.Run("caches_users", func(t *testing.T) {
+		client := newClient()
+		calls.Store(0)
+		for i := 0; i < 2; i++ {
+			user, err := client.ValidateToken(context.Background(), "Bearer good")
+			if err != nil || user.Id != userID || user.DisplayName != "Anna" {
+				t.Fatalf("Expected Anna, got %+v (%v)", user, err)
+			}
+		}
+		if _, err := client.ValidateToken(context.Background(), "Bearer bad"); !errors.Is(err, errTokenRejected) {
+			t.Errorf("Expected the token to be rejected, got %v", err)
+		}
+		if got := calls.Load(); got != 2 {
+			t.Errorf("Expected 2 calls to the user service, got %d", got)
+		}
+	})
+
+	t.Run("retries_failures", func(t *testing.T) {
+		client := newClient()
+		calls.Store(0)
+		failures.Store(userServiceAttempts - 1)
+		if _, err := client.ValidateToken(context.Background(), "good"); err != nil {
+			t.Fatalf("Expected the last attempt to succeed, got %v", err)
+		}
+		if got := calls.Load(); got != userServiceAttempts {
+			t.Errorf("Expected %d calls, got %d", userServiceAttempts, got)
+		}
+	})
+
+	t.Run("opens_circuit", func(t *testing.T) {
+		client := newClient()
+		calls.Store(0)
+		failures.Store(userServiceBreakerThreshold)
+		for i := 0; i < 2; i++ {
+			if _, err := client.ValidateToken(context.Background(), "good"); err == nil {
+				t.Fatal("Expected the call to fail")
+			}
+		}
+		if _, err := client.ValidateToken(context.Background(), "good"); !errors.Is(err, errCircuitOpen) {
+			t.Errorf("Expected calls to fail fast, got %v", err)
+		}
+		if got := calls.Load(); got != userServiceBreakerThreshold {
+			t.Errorf("Expected %d calls, got %d", userServiceBreakerThreshold, got)
+		}
+	})
+
+	t.Run("verifies_locally", func(t *testing.T) {
+		verifier := newTokenVerifier(nil, "shared-secret", "wili-user-service", "wili")
+		client := NewUserClient(userService.URL, "", time.Second, verifier, newUserCache(time.Minute, 10))
+		calls.Store(0)
+		token := signTestToken(t, jwt.SigningMethodHS256, []byte("shared-secret"), jwt.MapClaims{"sub": userID.String(), "iss": "wili-user-service", "aud": "wili", "exp": time.Now().Add(time.Hour).Unix()})
+		user, err := client.ValidateToken(context.Background(), "Bearer "+token)
+		if err != nil || user.Id != userID {
+			t.Fatalf("Expected user %s, got %+v (%v)", userID, user, err)
+		}
+		if got := calls.Load(); got != 0 {
+			t.Errorf("Expected no calls to the user service, got %d", got)
+		}
+	})
+	t.Run("checks_sessions", func(t *testing.T) {
+		revoked, down := false, false
+		sessions := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if down {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			json.NewEncoder(w).Encode(ValidateTokenResponse{Valid: !revoked, User: UserInfo{Id: userID}})
+		}))
+		defer sessions.Close()
+		verifier := newTokenVerifier(nil, "shared-secret", "wili-user-service", "wili")
+		token := signTestToken(t, jwt.SigningMethodHS256, []byte("shared-secret"), jwt.MapClaims{"sub": userID.String(), "sid": uuid.NewString(), "iss": "wili-user-service", "aud": "wili", "exp": time.Now().Add(time.Hour).Unix()})
+
+		client := NewUserClient(sessions.URL, "", time.Second, verifier, newUserCache(time.Minute, 10))
+		if _, err := client.ValidateToken(context.Background(), token); err != nil {
+			t.Fatalf("Expected an active session to be accepted, got %v", err)
+		}
+
+		revoked = true
+		client = NewUserClient(sessions.URL, "", time.Second, verifier, newUserCache(time.Minute, 10))
+		if _, err := client.ValidateToken(context.Background(), token); !errors.Is(err, errTokenRejected) {
+			t.Errorf("Expected a revoked session to be rejected, got %v", err)
+		}
+
+		down = true
+		client = NewUserClient(sessions.URL, "", time.Second, verifier, newUserCache(time.Minute, 10))
+		if user, err := client.ValidateToken(context.Background(), token); err != nil || user.Id != userID {
+			t.Errorf("Expected the local check to stand while the user service is down, got %+v (%v)", user, err)
+		}
+	})
+}
@@ -2,9 +2,12 @@ package main
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 	"unicode/utf8"
 
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
 	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
 )
 
@@ -15,6 +18,10 @@ const (
 	MaxItemDescriptionLength     = 2000
 	MinItemNameLength            = 1
 	MinWishlistTitleLength       = 1
+	MaxTransferItems             = 100
+	MaxBatchOperations           = 100
+	MaxWebhookURLLength          = 2000
+	MaxBookerEmailLength         = 254
 )
 
 // ValidationError represents a validation error with field-specific details
@@ -56,6 +63,118 @@ func ValidateCreateWishlistRequest(req wishlistgen.CreateWishlistRequest) Valida
 		}
 	}
 
+	if req.TemplateId != nil && findTemplate(*req.TemplateId) == nil {
+		errors = append(errors, ValidationError{
+			Field:   "templateId",
+			Message: "unknown template",
+		})
+	}
+
+	if req.Language != nil && !isSupportedLanguage(*req.Language) {
+		errors = append(errors, ValidationError{
+			Field:   "language",
+			Message: "must be one of: en, ru",
+		})
+	}
+
+	return errors
+}
+
+// ValidateCopyWishlistRequest validates a copy wishlist request
+func ValidateCopyWishlistRequest(req wishlistgen.CopyWishlistRequest) ValidationErrors {
+	var errors ValidationErrors
+
+	if req.Title != nil {
+		if err := validateStringField("title", *req.Title, MinWishlistTitleLength, MaxWishlistTitleLength, true); err != nil {
+			errors = append(errors, *err)
+		}
+	}
+
+	if req.Description != nil {
+		if err := validateStringField("description", *req.Description, 0, MaxWishlistDescriptionLength, false); err != nil {
+			errors = append(errors, *err)
+		}
+	}
+
+	return errors
+}
+
+// ValidateTransferItemsRequest validates a move or copy items request
+func ValidateTransferItemsRequest(sourceID openapi_types.UUID, req wishlistgen.TransferItemsRequest) ValidationErrors {
+	var errors ValidationErrors
+
+	if req.TargetWishlistId == sourceID {
+		errors = append(errors, ValidationError{
+			Field:   "targetWishlistId",
+			Message: "must differ from the source wishlist",
+		})
+	}
+
+	if len(req.ItemIds) == 0 || len(req.ItemIds) > MaxTransferItems {
+		errors = append(errors, ValidationError{
+			Field:   "itemIds",
+			Message: fmt.Sprintf("must contain between 1 and %d items", MaxTransferItems),
+		})
+	}
+
+	if req.BookingPolicy != nil {
+		switch *req.BookingPolicy {
+		case wishlistgen.Reject, wishlistgen.Keep, wishlistgen.Release:
+		default:
+			errors = append(errors, ValidationError{
+				Field:   "bookingPolicy",
+				Message: "must be one of: reject, keep, release",
+			})
+		}
+	}
+
+	return errors
+}
+
+// ValidateBatchItemsRequest validates every operation of a batch, prefixing
+// fields with the operation position (e.g. "operations[2].data.name")
+func ValidateBatchItemsRequest(req wishlistgen.BatchItemsRequest) ValidationErrors {
+	var errors ValidationErrors
+
+	if len(req.Operations) == 0 || len(req.Operations) > MaxBatchOperations {
+		return append(errors, ValidationError{
+			Field:   "operations",
+			Message: fmt.Sprintf("must contain between 1 and %d operations", MaxBatchOperations),
+		})
+	}
+
+	for i, op := range req.Operations {
+		var opErrors ValidationErrors
+
+		switch op.Op {
+		case wishlistgen.BatchItemOperationOpCreate:
+			if op.Type == nil || op.Data == nil {
+				opErrors = append(opErrors, ValidationError{
+					Field:   "type",
+					Message: "type and data are required for create",
+				})
+				break
+			}
+			opErrors = ValidateCreateWishlistItemRequest(wishlistgen.CreateWishlistItemRequest{Type: *op.Type, Data: *op.Data})
+		case wishlistgen.BatchItemOperationOpUpdate:
+			if op.ItemId == nil {
+				opErrors = append(opErrors, ValidationError{Field: "itemId", Message: "itemId is required for update"})
+			}
+			opErrors = append(opErrors, ValidateUpdateWishlistItemRequest(wishlistgen.UpdateWishlistItemRequest{Type: op.Type, Data: op.Data})...)
+		case wishlistgen.BatchItemOperationOpDelete:
+			if op.ItemId == nil {
+				opErrors = append(opErrors, ValidationError{Field: "itemId", Message: "itemId is required for delete"})
+			}
+		default:
+			opErrors = append(opErrors, ValidationError{Field: "op", Message: "must be one of: create, update, delete"})
+		}
+
+		for _, err := range opErrors {
+			err.Field = fmt.Sprintf("operations[%d].%s", i, err.Field)
+			errors = append(errors, err)
+		}
+	}
+
 	return errors
 }
 
@@ -76,6 +195,17 @@ func ValidateUpdateWishlistRequest(req wishlistgen.UpdateWishlistRequest) Valida
 		}
 	}
 
+	if req.Privacy != nil {
+		switch req.Privacy.BookerVisibility {
+		case wishlistgen.Visible, wishlistgen.Anonymous, wishlistgen.Surprise:
+		default:
+			errors = append(errors, ValidationError{
+				Field:   "privacy.bookerVisibility",
+				Message: "must be one of: visible, anonymous, surprise",
+			})
+		}
+	}
+
 	return errors
 }
 
@@ -176,3 +306,97 @@ func isValidURL(urlStr string) bool {
 	urlStr = strings.TrimSpace(urlStr)
 	return strings.HasPrefix(urlStr, "http://") || strings.HasPrefix(urlStr, "https://")
 }
+
+// ValidateCreateWebhookRequest validates a create webhook request
+func ValidateCreateWebhookRequest(req wishlistgen.CreateWebhookRequest) ValidationErrors {
+	var errors ValidationErrors
+
+	if err := validateStringField("url", req.Url, 1, MaxWebhookURLLength, true); err != nil {
+		errors = append(errors, *err)
+	} else if u, err := url.Parse(req.Url); err != nil || !webhookSchemeAllowed(u.Scheme) || u.Host == "" {
+		errors = append(errors, ValidationError{
+			Field:   "url",
+			Message: "must be an absolute https URL",
+		})
+	}
+
+	if req.Events != nil {
+		for i, eventType := range *req.Events {
+			switch eventType {
+			case wishlistgen.ItemAdded, wishlistgen.ItemEdited, wishlistgen.ItemRemoved,
+				wishlistgen.ItemBooked, wishlistgen.ItemUnbookedByToken, wishlistgen.ItemUnbookedByOwner:
+			default:
+				errors = append(errors, ValidationError{
+					Field:   fmt.Sprintf("events[%d]", i),
+					Message: "unknown event type",
+				})
+			}
+		}
+	}
+
+	return errors
+}
+
+// ValidateBookItemRequest validates a book item request
+func ValidateBookItemRequest(req wishlistgen.BookItemRequest) ValidationErrors {
+	var errors ValidationErrors
+
+	if req.BookerEmail != nil && len(*req.BookerEmail) > MaxBookerEmailLength {
+		errors = append(errors, ValidationError{
+			Field:   "bookerEmail",
+			Message: fmt.Sprintf("must be at most %d characters long", MaxBookerEmailLength),
+		})
+	}
+
+	if req.Language != nil && !isSupportedLanguage(*req.Language) {
+		errors = append(errors, ValidationError{
+			Field:   "language",
+			Message: "must be one of: en, ru",
+		})
+	}
+
+	return errors
+}
+
+// ValidateUpdateEmailPreferencesRequest validates an email preferences update
+func ValidateUpdateEmailPreferencesRequest(req wishlistgen.UpdateEmailPreferencesRequest) ValidationErrors {
+	var errors ValidationErrors
+
+	if req.Language != nil && !isSupportedLanguage(*req.Language) {
+		errors = append(errors, ValidationError{
+			Field:   "language",
+			Message: "must be one of: en, ru",
+		})
+	}
+
+	if req.Delivery != nil && *req.Delivery != wishlistgen.Instant && *req.Delivery != wishlistgen.Digest {
+		errors = append(errors, ValidationError{
+			Field:   "delivery",
+			Message: "must be one of: instant, digest",
+		})
+	}
+
+	if req.Unsubscribed != nil {
+		errors = append(errors, validateCategories("unsubscribed", *req.Unsubscribed)...)
+	}
+
+	return errors
+}
+
+// ValidateInboxPreferences validates an inbox preferences update
+func ValidateInboxPreferences(req wishlistgen.InboxPreferences) ValidationErrors {
+	return validateCategories("muted", req.Muted)
+}
+
+func validateCategories(field string, categories []wishlistgen.EmailCategory) ValidationErrors {
+	var errors ValidationErrors
+	for i, category := range categories {
+		if category != wishlistgen.Bookings && category != wishlistgen.Reminders {
+			errors = append(errors, ValidationError{
+				Field:   fmt.Sprintf("%s[%d]", field, i),
+				Message: "must be one of: bookings, reminders",
+			})
+		}
+	}
+	return errors
+}
@@ -0,0 +1,749 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/theseems/wili/backend/devutil"
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+const (
+	MaxWebhooksPerUser = 20
+
+	maxWebhookAttempts    = 8
+	webhookBaseBackoff    = 30 * time.Second
+	webhookMaxBackoff     = time.Hour
+	webhookClaimLease     = time.Minute
+	webhookDispatchBatch  = 50
+	webhookRequestTimeout = 10 * time.Second
+	maxWebhookErrorLength = 500
+)
+
+var errWebhookAddressBlocked = errors.New("webhook address is not public")
+
+// sharedAddressSpace is the carrier-grade NAT range, which clusters use for
+// pod and service networks too
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// webhookSchemeAllowed reports whether webhooks may be sent to URLs with
+// scheme: only https, except plain http in dev builds
+func webhookSchemeAllowed(scheme string) bool {
+	return scheme == "https" || (devutil.Dev && scheme == "http")
+}
+
+// publicAddress reports whether ip is reachable from the internet, rather
+// than the service's own host, network or cluster
+func publicAddress(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsValid() && !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() && !sharedAddressSpace.Contains(ip)
+}
+
+// newWebhookClient returns the client deliveries are sent with. Webhook URLs
+// are chosen by users, so it only connects to public addresses, checked on
+// the address actually dialed so that a name can not resolve elsewhere
+// later, and it does not follow redirects.
+func newWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: webhookRequestTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !publicAddress(addrPort.Addr()) {
+				return errWebhookAddressBlocked
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: webhookRequestTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookRequestTimeout,
+			MaxIdleConnsPerHost: 2,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+type mongoWebhook struct {
+	ID         string    `bson:"id"`
+	UserID     string    `bson:"userId"`
+	WishlistID *string   `bson:"wishlistId"`
+	URL        string    `bson:"url"`
+	Secret     string    `bson:"secret"`
+	Events     []string  `bson:"events"`
+	CreatedAt  time.Time `bson:"createdAt"`
+}
+
+// wants reports whether the webhook subscribed to events of eventType
+func (h mongoWebhook) wants(eventType string) bool {
+	if len(h.Events) == 0 {
+		return true
+	}
+	for _, t := range h.Events {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// mongoDelivery is one event to be sent to one webhook. The payload is kept
+// as sent so that redeliveries are byte-for-byte the same event.
+type mongoDelivery struct {
+	ObjectID       primitive.ObjectID `bson:"_id,omitempty"`
+	ID             string             `bson:"id"`
+	WebhookID      string             `bson:"webhookId"`
+	UserID         string             `bson:"userId"`
+	EventID        string             `bson:"eventId"`
+	EventType      string             `bson:"eventType"`
+	Payload        string             `bson:"payload"`
+	Status         string             `bson:"status"`
+	Attempts       int                `bson:"attempts"`
+	NextAttemptAt  *time.Time         `bson:"nextAttemptAt,omitempty"`
+	LockedUntil    *time.Time         `bson:"lockedUntil,omitempty"`
+	ResponseStatus *int               `bson:"responseStatus,omitempty"`
+	LastError      *string            `bson:"lastError,omitempty"`
+	RedeliveryOf   *string            `bson:"redeliveryOf,omitempty"`
+	CreatedAt      time.Time          `bson:"createdAt"`
+	DeliveredAt    *time.Time         `bson:"deliveredAt,omitempty"`
+}
+
+func newWebhookSecret() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// signWebhookPayload returns the X-Wili-Signature value for body sent at timestamp
+func signWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookBackoff is the delay after the given number of failed attempts
+func webhookBackoff(attempts int) time.Duration {
+	delay := webhookBaseBackoff
+	for i := 1; i < attempts && delay < webhookMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > webhookMaxBackoff {
+		delay = webhookMaxBackoff
+	}
+	return delay
+}
+
+// afterAttempt returns d updated with the outcome of one attempt: status is
+// the HTTP status received, or 0 when err says why none was.
+func afterAttempt(d mongoDelivery, status int, err error, now time.Time) mongoDelivery {
+	d.Attempts++
+	d.LockedUntil = nil
+	d.ResponseStatus = nil
+	d.LastError = nil
+	if status != 0 {
+		d.ResponseStatus = &status
+	}
+
+	if err == nil && status >= 200 && status < 300 {
+		d.Status = string(wishlistgen.WebhookDeliveryStatusSucceeded)
+		d.NextAttemptAt = nil
+		d.DeliveredAt = &now
+		return d
+	}
+
+	message := fmt.Sprintf("unexpected response status %d", status)
+	if err != nil {
+		message = err.Error()
+	}
+	if len(message) > maxWebhookErrorLength {
+		message = message[:maxWebhookErrorLength]
+	}
+	d.LastError = &message
+
+	if d.Attempts >= maxWebhookAttempts {
+		d.Status = string(wishlistgen.WebhookDeliveryStatusFailed)
+		d.NextAttemptAt = nil
+		return d
+	}
+	next := now.Add(webhookBackoff(d.Attempts))
+	d.Status = string(wishlistgen.WebhookDeliveryStatusPending)
+	d.NextAttemptAt = &next
+	return d
+}
+
+// sendWebhook POSTs a delivery to its webhook and returns the response status
+func sendWebhook(ctx context.Context, client *http.Client, hook mongoWebhook, d mongoDelivery, now time.Time) (int, error) {
+	body := []byte(d.Payload)
+	timestamp := now.Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	// webhooks registered before plain http was refused are not sent either
+	if !webhookSchemeAllowed(req.URL.Scheme) {
+		return 0, fmt.Errorf("webhook URL must use https")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Wili-Webhooks/1.0")
+	req.Header.Set("X-Wili-Event", d.EventType)
+	req.Header.Set("X-Wili-Delivery", d.ID)
+	req.Header.Set("X-Wili-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Wili-Signature", signWebhookPayload(hook.Secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	return resp.StatusCode, nil
+}
+
+// webhookEventOf builds the body sent for an activity event, with the booker
+// redacted the same way as in the activity feed.
+func webhookEventOf(event mongoActivity, visibility wishlistgen.WishlistPrivacyBookerVisibility) wishlistgen.WebhookEvent {
+	apiEvent := convertToAPIActivity(event, visibility)
+	return wishlistgen.WebhookEvent{
+		Id:         apiEvent.Id,
+		Type:       wishlistgen.WebhookEventType(apiEvent.Type),
+		WishlistId: uuid.MustParse(event.WishlistID),
+		ItemId:     apiEvent.ItemId,
+		ItemName:   apiEvent.ItemName,
+		Fields:     apiEvent.Fields,
+		Booker:     apiEvent.Booker,
+		OccurredAt: apiEvent.CreatedAt,
+	}
+}
+
+// enqueueWebhookDeliveries schedules a delivery of every event to every
+// webhook of the owner that wants it. Failures are only logged.
+func (r *MongoRepo) enqueueWebhookDeliveries(ctx context.Context, mw *mongoWishlist, events []mongoActivity) {
+	cursor, err := r.webhooks.Find(ctx, bson.M{
+		"userId": mw.UserID,
+		"$or":    []bson.M{{"wishlistId": nil}, {"wishlistId": mw.UUID}},
+	})
+	if err != nil {
+		r.logger.LogError(ctx, nil, "enqueue_webhooks", err, fmt.Sprintf("failed to find webhooks for wishlist %s", mw.UUID))
+		return
+	}
+	var hooks []mongoWebhook
+	if err := cursor.All(ctx, &hooks); err != nil {
+		r.logger.LogError(ctx, nil, "enqueue_webhooks", err, fmt.Sprintf("failed to decode webhooks for wishlist %s", mw.UUID))
+		return
+	}
+	if len(hooks) == 0 {
+		return
+	}
+
+	visibility := mw.Privacy.bookerVisibility()
+	now := time.Now()
+	var deliveries []interface{}
+	for _, event := range events {
+		if visibility == wishlistgen.Surprise && isBookingActivity(event.Type) {
+			continue
+		}
+		payload, err := json.Marshal(webhookEventOf(event, visibility))
+		if err != nil {
+			r.logger.LogError(ctx, nil, "enqueue_webhooks", err, fmt.Sprintf("failed to encode event %s", event.ID.Hex()))
+			continue
+		}
+		for _, hook := range hooks {
+			if !hook.wants(event.Type) {
+				continue
+			}
+			deliveries = append(deliveries, mongoDelivery{
+				ID:            uuid.New().String(),
+				WebhookID:     hook.ID,
+				UserID:        hook.UserID,
+				EventID:       event.ID.Hex(),
+				EventType:     event.Type,
+				Payload:       string(payload),
+				Status:        string(wishlistgen.WebhookDeliveryStatusPending),
+				NextAttemptAt: &now,
+				CreatedAt:     now,
+			})
+		}
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	if _, err := r.deliveries.InsertMany(ctx, deliveries); err != nil {
+		r.logger.LogError(ctx, nil, "enqueue_webhooks", err, fmt.Sprintf("failed to enqueue %d deliveries for wishlist %s", len(deliveries), mw.UUID))
+	}
+}
+
+func (r *MongoRepo) CreateWebhook(ctx context.Context, userID openapi_types.UUID, req wishlistgen.CreateWebhookRequest) (*wishlistgen.Webhook, error) {
+	if req.WishlistId != nil {
+		if _, err := r.findOwned(ctx, *req.WishlistId, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	count, err := r.webhooks.CountDocuments(ctx, bson.M{"userId": userID.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to count webhooks: %w", err)
+	}
+	if count >= MaxWebhooksPerUser {
+		return nil, fmt.Errorf("webhook limit reached")
+	}
+
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	hook := mongoWebhook{
+		ID:        uuid.New().String(),
+		UserID:    userID.String(),
+		URL:       req.Url,
+		Secret:    secret,
+		Events:    []string{},
+		CreatedAt: time.Now(),
+	}
+	if req.WishlistId != nil {
+		wishlistID := req.WishlistId.String()
+		hook.WishlistID = &wishlistID
+	}
+	if req.Events != nil {
+		for _, eventType := range *req.Events {
+			hook.Events = append(hook.Events, string(eventType))
+		}
+	}
+
+	if _, err := r.webhooks.InsertOne(ctx, hook); err != nil {
+		return nil, fmt.Errorf("failed to insert webhook: %w", err)
+	}
+
+	created := convertToAPIWebhook(hook)
+	created.Secret = &secret
+	return &created, nil
+}
+
+func (r *MongoRepo) GetWebhooks(ctx context.Context, userID openapi_types.UUID) ([]wishlistgen.Webhook, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: 1}})
+	cursor, err := r.webhooks.Find(ctx, bson.M{"userId": userID.String()}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhooks: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var hooks []mongoWebhook
+	if err = cursor.All(ctx, &hooks); err != nil {
+		return nil, fmt.Errorf("failed to decode webhooks: %w", err)
+	}
+
+	result := make([]wishlistgen.Webhook, 0, len(hooks))
+	for _, hook := range hooks {
+		result = append(result, convertToAPIWebhook(hook))
+	}
+	return result, nil
+}
+
+func (r *MongoRepo) DeleteWebhook(ctx context.Context, webhookID, userID openapi_types.UUID) error {
+	res, err := r.webhooks.DeleteOne(ctx, bson.M{"id": webhookID.String(), "userId": userID.String()})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if res.DeletedCount == 0 {
+		return fmt.Errorf("webhook not found")
+	}
+
+	if _, err := r.deliveries.DeleteMany(ctx, bson.M{"webhookId": webhookID.String()}); err != nil {
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// GetWebhookDeliveries returns up to limit deliveries older than before (all when zero), newest first
+func (r *MongoRepo) GetWebhookDeliveries(ctx context.Context, webhookID, userID openapi_types.UUID, before primitive.ObjectID, limit int) (*wishlistgen.WebhookDeliveries, error) {
+	count, err := r.webhooks.CountDocuments(ctx, bson.M{"id": webhookID.String(), "userId": userID.String()}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook: %w", err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("webhook not found")
+	}
+
+	filter := bson.M{"webhookId": webhookID.String()}
+	if !before.IsZero() {
+		filter["_id"] = bson.M{"$lt": before}
+	}
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: -1}}).
+		SetLimit(int64(limit + 1))
+
+	cursor, err := r.deliveries.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find deliveries: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var deliveries []mongoDelivery
+	if err = cursor.All(ctx, &deliveries); err != nil {
+		return nil, fmt.Errorf("failed to decode deliveries: %w", err)
+	}
+
+	page := &wishlistgen.WebhookDeliveries{Deliveries: []wishlistgen.WebhookDelivery{}}
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+		next := deliveries[limit-1].ObjectID.Hex()
+		page.NextCursor = &next
+	}
+	for _, d := range deliveries {
+		page.Deliveries = append(page.Deliveries, convertToAPIDelivery(d))
+	}
+	return page, nil
+}
+
+// Redeliver schedules the event of a delivery again, as a new delivery
+func (r *MongoRepo) Redeliver(ctx context.Context, webhookID, deliveryID, userID openapi_types.UUID) (*wishlistgen.WebhookDelivery, error) {
+	var original mongoDelivery
+	err := r.deliveries.FindOne(ctx, bson.M{
+		"id":        deliveryID.String(),
+		"webhookId": webhookID.String(),
+		"userId":    userID.String(),
+	}).Decode(&original)
+	if err == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("delivery not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find delivery: %w", err)
+	}
+
+	now := time.Now()
+	redelivery := mongoDelivery{
+		ID:            uuid.New().String(),
+		WebhookID:     original.WebhookID,
+		UserID:        original.UserID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        string(wishlistgen.WebhookDeliveryStatusPending),
+		NextAttemptAt: &now,
+		RedeliveryOf:  &original.ID,
+		CreatedAt:     now,
+	}
+	if _, err := r.deliveries.InsertOne(ctx, redelivery); err != nil {
+		return nil, fmt.Errorf("failed to insert delivery: %w", err)
+	}
+
+	result := convertToAPIDelivery(redelivery)
+	return &result, nil
+}
+
+// claimDueDelivery leases the next delivery due at now so that no other
+// instance attempts it meanwhile; it returns nil when none is due.
+func (r *MongoRepo) claimDueDelivery(ctx context.Context, now time.Time) (*mongoDelivery, error) {
+	filter := bson.M{
+		"status":        string(wishlistgen.WebhookDeliveryStatusPending),
+		"nextAttemptAt": bson.M{"$lte": now},
+		"$or":           []bson.M{{"lockedUntil": nil}, {"lockedUntil": bson.M{"$lt": now}}},
+	}
+	update := bson.M{"$set": bson.M{"lockedUntil": now.Add(webhookClaimLease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "nextAttemptAt", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var d mongoDelivery
+	err := r.deliveries.FindOneAndUpdate(ctx, filter, update, opts).Decode(&d)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+// saveAttempt stores the outcome of an attempt made under the lease taken by
+// claimDueDelivery. Once the lease expired another instance may have claimed
+// the delivery, so the outcome is then dropped rather than overwrite theirs.
+func (r *MongoRepo) saveAttempt(ctx context.Context, lease *time.Time, d mongoDelivery) error {
+	_, err := r.deliveries.ReplaceOne(ctx, bson.M{"id": d.ID, "lockedUntil": lease}, d)
+	return err
+}
+
+// DispatchWebhooks attempts up to one batch of due deliveries and returns how many were attempted
+func (r *MongoRepo) DispatchWebhooks(ctx context.Context, client *http.Client) (int, error) {
+	attempted := 0
+	for attempted < webhookDispatchBatch {
+		d, err := r.claimDueDelivery(ctx, time.Now())
+		if err != nil {
+			return attempted, fmt.Errorf("failed to claim delivery: %w", err)
+		}
+		if d == nil {
+			break
+		}
+		attempted++
+
+		var hook mongoWebhook
+		err = r.webhooks.FindOne(ctx, bson.M{"id": d.WebhookID}).Decode(&hook)
+		var status int
+		switch {
+		case err == mongo.ErrNoDocuments:
+			// Deleted meanwhile: make this attempt the last one
+			d.Attempts = maxWebhookAttempts - 1
+			err = fmt.Errorf("webhook deleted")
+		case err != nil:
+			return attempted, fmt.Errorf("failed to find webhook %s: %w", d.WebhookID, err)
+		default:
+			attemptCtx, cancel := context.WithTimeout(ctx, webhookRequestTimeout)
+			status, err = sendWebhook(attemptCtx, client, hook, *d, time.Now())
+			cancel()
+		}
+
+		if err := r.saveAttempt(ctx, d.LockedUntil, afterAttempt(*d, status, err, time.Now())); err != nil {
+			return attempted, fmt.Errorf("failed to save delivery %s: %w", d.ID, err)
+		}
+	}
+	return attempted, nil
+}
+
+func dispatchWebhooksJob(repo *MongoRepo, client *http.Client, logger *Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		start := time.Now()
+		attempted, err := repo.DispatchWebhooks(ctx, client)
+		if err != nil {
+			return fmt.Errorf("failed to dispatch webhooks: %w", err)
+		}
+		if attempted > 0 {
+			logger.LogDatabaseOperation(ctx, "dispatch_webhooks", time.Since(start), true, fmt.Sprintf("attempted %d deliveries", attempted))
+		}
+		return nil
+	}
+}
+
+func convertToAPIWebhook(hook mongoWebhook) wishlistgen.Webhook {
+	result := wishlistgen.Webhook{
+		Id:        uuid.MustParse(hook.ID),
+		Url:       hook.URL,
+		Events:    make([]wishlistgen.WebhookEventType, 0, len(hook.Events)),
+		CreatedAt: hook.CreatedAt,
+	}
+	if hook.WishlistID != nil {
+		wishlistID := uuid.MustParse(*hook.WishlistID)
+		result.WishlistId = &wishlistID
+	}
+	for _, eventType := range hook.Events {
+		result.Events = append(result.Events, wishlistgen.WebhookEventType(eventType))
+	}
+	return result
+}
+
+func convertToAPIDelivery(d mongoDelivery) wishlistgen.WebhookDelivery {
+	result := wishlistgen.WebhookDelivery{
+		Id:             uuid.MustParse(d.ID),
+		WebhookId:      uuid.MustParse(d.WebhookID),
+		EventId:        d.EventID,
+		EventType:      wishlistgen.WebhookEventType(d.EventType),
+		Status:         wishlistgen.WebhookDeliveryStatus(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+	json.Unmarshal([]byte(d.Payload), &result.Payload)
+	if d.RedeliveryOf != nil {
+		if id, err := uuid.Parse(*d.RedeliveryOf); err == nil {
+			result.RedeliveryOf = &id
+		}
+	}
+	return result
+}
+
+// List webhooks of the authenticated user
+func (s *WishlistServer) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "get_webhooks")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	hooks, err := s.repo.GetWebhooks(r.Context(), userID)
+	if err != nil {
+		s.logger.LogError(r.Context(), &userID, "get_webhooks", err, "failed to retrieve webhooks from database")
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve webhooks")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_webhooks", fmt.Sprintf("retrieved %d webhooks", len(hooks)))
+	s.writeJSON(w, http.StatusOK, hooks)
+}
+
+// Register a webhook
+func (s *WishlistServer) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	s.logger.LogRequest(r, nil, "create_webhook")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	var req wishlistgen.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.logger.LogBadRequest(r.Context(), &userID, "create_webhook", fmt.Sprintf("malformed JSON: %v", err))
+		s.writeError(w, http.StatusBadRequest, "Invalid request body: malformed JSON")
+		return
+	}
+
+	if validationErrors := ValidateCreateWebhookRequest(req); len(validationErrors) > 0 {
+		s.logger.LogValidationError(r.Context(), &userID, "create_webhook", validationErrors)
+		s.writeValidationErrors(w, validationErrors)
+		return
+	}
+
+	hook, err := s.repo.CreateWebhook(r.Context(), userID, req)
+	if err != nil {
+		if strings.Contains(err.Error(), "limit reached") {
+			s.logger.LogConflict(r.Context(), &userID, "create_webhook", err.Error())
+			s.writeError(w, http.StatusConflict, fmt.Sprintf("At most %d webhooks are allowed", MaxWebhooksPerUser))
+			return
+		}
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "wishlist", req.WishlistId.String())
+			s.writeError(w, http.StatusNotFound, "Wishlist not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "create_webhook", err, "failed to create webhook in database")
+		s.writeError(w, http.StatusInternalServerError, "Failed to create webhook")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "create_webhook", fmt.Sprintf("created webhook %s", hook.Id.String()))
+	s.writeJSON(w, http.StatusCreated, hook)
+}
+
+// Delete a webhook
+func (s *WishlistServer) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	s.logger.LogRequest(r, nil, "delete_webhook")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	if err := s.repo.DeleteWebhook(r.Context(), webhookId, userID); err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "webhook", webhookId.String())
+			s.writeError(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "delete_webhook", err, fmt.Sprintf("failed to delete webhook %s", webhookId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to delete webhook")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "delete_webhook", fmt.Sprintf("deleted webhook %s", webhookId.String()))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List deliveries of a webhook
+func (s *WishlistServer) GetWebhooksWebhookIdDeliveries(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID, params wishlistgen.GetWebhooksWebhookIdDeliveriesParams) {
+	s.logger.LogRequest(r, nil, "get_webhook_deliveries")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	limit := defaultActivityLimit
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > maxActivityLimit {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_webhook_deliveries", fmt.Sprintf("invalid limit %d", *params.Limit))
+			s.writeError(w, http.StatusBadRequest, fmt.Sprintf("limit must be between 1 and %d", maxActivityLimit))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	var before primitive.ObjectID
+	if params.Cursor != nil {
+		before, err = primitive.ObjectIDFromHex(*params.Cursor)
+		if err != nil {
+			s.logger.LogBadRequest(r.Context(), &userID, "get_webhook_deliveries", "invalid cursor")
+			s.writeError(w, http.StatusBadRequest, "Invalid cursor")
+			return
+		}
+	}
+
+	page, err := s.repo.GetWebhookDeliveries(r.Context(), webhookId, userID, before, limit)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "webhook", webhookId.String())
+			s.writeError(w, http.StatusNotFound, "Webhook not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "get_webhook_deliveries", err, fmt.Sprintf("failed to retrieve deliveries of webhook %s", webhookId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to retrieve deliveries")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "get_webhook_deliveries", fmt.Sprintf("retrieved %d deliveries of webhook %s", len(page.Deliveries), webhookId.String()))
+	s.writeJSON(w, http.StatusOK, page)
+}
+
+// Send the event of a delivery again
+func (s *WishlistServer) PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, webhookId, deliveryId openapi_types.UUID) {
+	s.logger.LogRequest(r, nil, "redeliver_webhook")
+
+	userID, err := s.extractUserID(r)
+	if err != nil {
+		s.writeAuthError(w, err)
+		return
+	}
+
+	delivery, err := s.repo.Redeliver(r.Context(), webhookId, deliveryId, userID)
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			s.logger.LogNotFound(r.Context(), &userID, "delivery", deliveryId.String())
+			s.writeError(w, http.StatusNotFound, "Webhook or delivery not found")
+			return
+		}
+		s.logger.LogError(r.Context(), &userID, "redeliver_webhook", err, fmt.Sprintf("failed to redeliver %s of webhook %s", deliveryId.String(), webhookId.String()))
+		s.writeError(w, http.StatusInternalServerError, "Failed to schedule redelivery")
+		return
+	}
+
+	s.logger.LogSuccess(r.Context(), &userID, "redeliver_webhook", fmt.Sprintf("scheduled redelivery %s of %s", delivery.Id.String(), deliveryId.String()))
+	s.writeJSON(w, http.StatusAccepted, delivery)
+}
@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	wishlistgen "github.com/theseems/wili/backend/services/wishlist/gen"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	body := []byte(`{"id":"1"}`)
+	signature := signWebhookPayload("secret", 1700000000, body)
+
+	if signature != signWebhookPayload("secret", 1700000000, body) {
+		t.Error("Expected signature to be deterministic")
+	}
+	if signature == signWebhookPayload("other", 1700000000, body) {
+		t.Error("Expected signature to depend on the secret")
+	}
+	if signature == signWebhookPayload("secret", 1700000001, body) {
+		t.Error("Expected signature to depend on the timestamp")
+	}
+	if len(signature) != len("sha256=")+64 {
+		t.Errorf("Expected sha256= and 64 hex characters, got %q", signature)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{20, webhookMaxBackoff},
+	}
+
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.attempts), func(t *testing.T) {
+			if got := webhookBackoff(tt.attempts); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAfterAttempt(t *testing.T) {
+	now := time.Now()
+	pending := mongoDelivery{Status: string(wishlistgen.WebhookDeliveryStatusPending)}
+
+	t.Run("success", func(t *testing.T) {
+		d := afterAttempt(pending, http.StatusNoContent, nil, now)
+		if d.Status != string(wishlistgen.WebhookDeliveryStatusSucceeded) || d.DeliveredAt == nil || d.NextAttemptAt != nil {
+			t.Errorf("Expected succeeded delivery, got %+v", d)
+		}
+		if d.Attempts != 1 || d.ResponseStatus == nil || *d.ResponseStatus != http.StatusNoContent {
+			t.Errorf("Expected one attempt with status 204, got %+v", d)
+		}
+	})
+
+	t.Run("retry_after_error_status", func(t *testing.T) {
+		d := afterAttempt(pending, http.StatusBadGateway, nil, now)
+		if d.Status != string(wishlistgen.WebhookDeliveryStatusPending) || d.NextAttemptAt == nil || !d.NextAttemptAt.Equal(now.Add(webhookBaseBackoff)) {
+			t.Errorf("Expected retry after %v, got %+v", webhookBaseBackoff, d)
+		}
+		if d.LastError == nil {
+			t.Error("Expected last error to be recorded")
+		}
+	})
+
+	t.Run("fails_after_max_attempts", func(t *testing.T) {
+		exhausted := pending
+		exhausted.Attempts = maxWebhookAttempts - 1
+		d := afterAttempt(exhausted, 0, errors.New("connection refused"), now)
+		if d.Status != string(wishlistgen.WebhookDeliveryStatusFailed) || d.NextAttemptAt != nil {
+			t.Errorf("Expected failed delivery, got %+v", d)
+		}
+		if d.ResponseStatus != nil || d.LastError == nil || *d.LastError != "connection refused" {
+			t.Errorf("Expected transport error without status, got %+v", d)
+		}
+	})
+}
+
+func TestSendWebhook(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	hook := mongoWebhook{Secret: "whsec_test"}
+	d := mongoDelivery{ID: uuid.New().String(), EventType: "item_booked", Payload: `{"id":"abc"}`}
+
+	var received *http.Request
+	var body []byte
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	hook.URL = server.URL
+
+	status, err := sendWebhook(context.Background(), server.Client(), hook, d, now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status != http.StatusAccepted {
+		t.Errorf("Expected status %d, got %d", http.StatusAccepted, status)
+	}
+	if string(body) != d.Payload {
+		t.Errorf("Expected payload %s, got %s", d.Payload, body)
+	}
+	if received.Header.Get("X-Wili-Event") != "item_booked" || received.Header.Get("X-Wili-Delivery") != d.ID {
+		t.Errorf("Unexpected event headers: %v", received.Header)
+	}
+	if received.Header.Get("X-Wili-Signature") != signWebhookPayload(hook.Secret, now.Unix(), body) {
+		t.Errorf("Signature does not match payload: %s", received.Header.Get("X-Wili-Signature"))
+	}
+}
+
+func TestWebhookClientRejectsInternalAddresses(t *testing.T) {
+	tests := []struct {
+		addr   string
+		public bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1::1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.7", false},
+		{"172.16.3.4", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"0.0.0.0", false},
+		{"100.64.0.10", false},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, tt := range tests {
+		if got := publicAddress(netip.MustParseAddr(tt.addr)); got != tt.public {
+			t.Errorf("Expected publicAddress(%s) = %v, got %v", tt.addr, tt.public, got)
+		}
+	}
+
+	var hits atomic.Int32
+	internal := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer internal.Close()
+	hook := mongoWebhook{Secret: "whsec_test"}
+	d := mongoDelivery{ID: uuid.New().String(), EventType: "item_booked", Payload: `{}`}
+
+	// "localhost" resolves to a loopback address, which is checked when dialing
+	localhost := strings.Replace(internal.URL, "127.0.0.1", "localhost", 1)
+	for _, target := range []string{internal.URL, localhost} {
+		hook.URL = target
+		if _, err := sendWebhook(context.Background(), newWebhookClient(), hook, d, time.Now()); !errors.Is(err, errWebhookAddressBlocked) {
+			t.Errorf("Expected %s to be blocked, got %v", target, err)
+		}
+	}
+	if hits.Load() != 0 {
+		t.Errorf("Expected no request to reach the internal server, got %d", hits.Load())
+	}
+
+	hook.URL = "http://93.184.216.34/hook"
+	if _, err := sendWebhook(context.Background(), newWebhookClient(), hook, d, time.Now()); err == nil || !strings.Contains(err.Error(), "https") {
+		t.Errorf("Expected plain http to be refused, got %v", err)
+	}
+}
+
+func TestWebhookClientDoesNotFollowRedirects(t *testing.T) {
+	target := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected the redirect not to be followed")
+	}))
+	defer target.Close()
+	redirecting := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusFound)
+	}))
+	defer redirecting.Close()
+
+	// the test servers listen on loopback, so only the redirect policy is used
+	client := newWebhookClient()
+	client.Transport = redirecting.Client().Transport
+	hook := mongoWebhook{URL: redirecting.URL, Secret: "whsec_test"}
+	d := mongoDelivery{ID: uuid.New().String(), EventType: "item_booked", Payload: `{}`}
+	status, err := sendWebhook(context.Background(), client, hook, d, time.Now())
+	if err != nil || status != http.StatusFound {
+		t.Errorf("Expected the redirect to be reported as status 302, got %d (%v)", status, err)
+	}
+}
+
+func TestWebhookEventOfRedactsBooker(t *testing.T) {
+	event := mongoActivity{
+		ID:         primitive.NewObjectID(),
+		WishlistID: uuid.New().String(),
+		Type:       string(wishlistgen.ActivityEventTypeItemBooked),
+		ItemID:     uuid.New().String(),
+		Booker:     &activityBooker{Name: stringPtr("Anna")},
+	}
+
+	if payload := webhookEventOf(event, wishlistgen.Visible); payload.Booker == nil || payload.Id != event.ID.Hex() {
+		t.Errorf("Expected booker and event id, got %+v", payload)
+	}
+	if payload := webhookEventOf(event, wishlistgen.Anonymous); payload.Booker != nil {
+		t.Errorf("Expected booker to be redacted, got %+v", payload.Booker)
+	}
+}
+
+func TestWebhookWants(t *testing.T) {
+	all := mongoWebhook{}
+	bookings := mongoWebhook{Events: []string{"item_booked"}}
+
+	if !all.wants("item_added") {
+		t.Error("Expected webhook without filter to want every event")
+	}
+	if !bookings.wants("item_booked") || bookings.wants("item_added") {
+		t.Error("Expected webhook to want only its events")
+	}
+}
+
+func TestValidateCreateWebhookRequest(t *testing.T) {
+	unknown := []wishlistgen.WebhookEventType{wishlistgen.ItemBooked, "item_sold"}
+
+	tests := []struct {
+		name          string
+		req           wishlistgen.CreateWebhookRequest
+		expectedCount int
+	}{
+		{"valid", wishlistgen.CreateWebhookRequest{Url: "https://example.com/hook"}, 0},
+		{"missing_url", wishlistgen.CreateWebhookRequest{}, 1},
+		{"relative_url", wishlistgen.CreateWebhookRequest{Url: "/hook"}, 1},
+		{"unsupported_scheme", wishlistgen.CreateWebhookRequest{Url: "ftp://example.com"}, 1},
+		{"plain_http", wishlistgen.CreateWebhookRequest{Url: "http://example.com/hook"}, 1},
+		{"unknown_event", wishlistgen.CreateWebhookRequest{Url: "https://example.com", Events: &unknown}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if errs := ValidateCreateWebhookRequest(tt.req); len(errs) != tt.expectedCount {
+				t.Errorf("Expected %d errors, got %d: %v", tt.expectedCount, len(errs), errs)
+			}
+		})
+	}
+}
+
+func TestWebhookEndpointsRequireAuth(t *testing.T) {
+	s := NewWishlistServer(nil, nil, time.Hour)
+	id := uuid.New()
+
+	tests := []struct {
+		name string
+		call func(w http.ResponseWriter, r *http.Request)
+	}{
+		{"list", s.GetWebhooks},
+		{"create", s.PostWebhooks},
+		{"delete", func(w http.ResponseWriter, r *http.Request) { s.DeleteWebhooksWebhookId(w, r, id) }},
+		{"deliveries", func(w http.ResponseWriter, r *http.Request) {
+			s.GetWebhooksWebhookIdDeliveries(w, r, id, wishlistgen.GetWebhooksWebhookIdDeliveriesParams{})
+		}},
+		{"redeliver", func(w http.ResponseWriter, r *http.Request) {
+			s.PostWebhooksWebhookIdDeliveriesDeliveryIdRedeliver(w, r, id, uuid.New())
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.call(rec, httptest.NewRequest(http.MethodPost, "/", nil))
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("Expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// checkTimeout bounds every readiness check, so that a hanging dependency
+// fails the probe instead of timing it out
+const checkTimeout = 2 * time.Second
+
+// Check reports whether a dependency of a service can be used
+type Check func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Probes serves the liveness and readiness probes of a service. A service is
+// live while its process serves requests at all, and ready while every
+// dependency check passes and it is not shutting down.
+type Probes struct {
+	checks   []namedCheck
+	draining atomic.Bool
+}
+
+// NewProbes returns probes without dependency checks
+func NewProbes() *Probes {
+	return &Probes{}
+}
+
+// AddCheck makes readiness depend on check; add checks before serving
+func (p *Probes) AddCheck(name string, check Check) {
+	p.checks = append(p.checks, namedCheck{name: name, check: check})
+}
+
+// Drain makes readiness fail from now on, so that load balancers stop sending
+// requests while the in-flight ones finish
+func (p *Probes) Drain() {
+	p.draining.Store(true)
+}
+
+type probeResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// Live answers 200 for as long as the service serves requests. It checks no
+// dependencies: restarting a service does not bring its database back.
+func (p *Probes) Live() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeProbe(w, http.StatusOK, probeResponse{Status: "ok"})
+	})
+}
+
+// Ready runs every check concurrently and answers 200 when all pass, or 503
+// with the failures when one fails or the service is draining
+func (p *Probes) Ready() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p.draining.Load() {
+			writeProbe(w, http.StatusServiceUnavailable, probeResponse{Status: "draining"})
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), checkTimeout)
+		defer cancel()
+
+		results := make(map[string]string, len(p.checks))
+		failed := false
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, c := range p.checks {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				err := c.check(ctx)
+				mu.Lock()
+				defer mu.Unlock()
+				results[c.name] = "ok"
+				if err != nil {
+					results[c.name] = err.Error()
+					failed = true
+				}
+			}()
+		}
+		wg.Wait()
+
+		if failed {
+			writeProbe(w, http.StatusServiceUnavailable, probeResponse{Status: "unavailable", Checks: results})
+			return
+		}
+		writeProbe(w, http.StatusOK, probeResponse{Status: "ready", Checks: results})
+	})
+}
+
+func writeProbe(w http.ResponseWriter, status int, body probeResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,118 @@
+// Package telemetry holds what the backend services share to observe and
+// run themselves: JSON logging through log/slog, request IDs that follow a
+// request across services, Prometheus metrics, OpenTelemetry tracing, health
+// probes and graceful HTTP serving.
+package telemetry
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// level is the minimum level of every logger, adjustable at runtime
+var level = new(slog.LevelVar)
+
+// SetupLogging makes the default slog logger, and with it the standard log
+// package, write JSON records tagged with the service name. The minimum level
+// comes from LOG_LEVEL (debug, info, warn or error; default info);
+// LOG_FORMAT=text switches to text for local runs.
+func SetupLogging(service string) *slog.Logger {
+	if value := os.Getenv("LOG_LEVEL"); value != "" {
+		if err := level.UnmarshalText([]byte(value)); err != nil {
+			slog.Error("invalid LOG_LEVEL, using info", "value", value)
+		}
+	}
+
+	opts := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler = slog.NewJSONHandler(os.Stdout, opts)
+	if strings.EqualFold(os.Getenv("LOG_FORMAT"), "text") {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	}
+
+	logger := slog.New(contextHandler{handler}).With("service", service)
+	slog.SetDefault(logger)
+	return logger
+}
+
+// contextHandler adds the request ID, user ID and trace ID of the request a
+// record is logged for, when it is logged with a context
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttrs(slog.String("trace_id", sc.TraceID().String()), slog.String("span_id", sc.SpanID().String()))
+	}
+	if info := requestInfoFrom(ctx); info != nil {
+		r.AddAttrs(slog.String("request_id", info.id))
+		if userID := info.user(); userID != "" && !hasAttr(r, "user_id") {
+			r.AddAttrs(slog.String("user_id", userID))
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
+
+func hasAttr(r slog.Record, key string) bool {
+	found := false
+	r.Attrs(func(a slog.Attr) bool {
+		found = a.Key == key
+		return !found
+	})
+	return found
+}
+
+type levelBody struct {
+	Level string `json:"level"`
+}
+
+// LevelHandler reports the log level on GET and changes it on PUT with a body
+// such as {"level":"debug"}. Callers authenticate with the X-Wili-Service-Token
+// header; without a token the handler refuses everyone.
+func LevelHandler(token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := r.Header.Get("X-Wili-Service-Token")
+		if token == "" || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var body levelBody
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "invalid body", http.StatusBadRequest)
+				return
+			}
+			var l slog.Level
+			if err := l.UnmarshalText([]byte(body.Level)); err != nil {
+				http.Error(w, "invalid level", http.StatusBadRequest)
+				return
+			}
+			level.Set(l)
+			slog.InfoContext(r.Context(), "log level changed", "level", l.String())
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(levelBody{Level: strings.ToLower(level.Level().String())})
+	})
+}
@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "wili_http_requests_total",
+		Help: "HTTP requests served, by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "wili_http_request_duration_seconds",
+		Help:    "Time to serve HTTP requests, by method and route pattern.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route"})
+	httpRequestsInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "wili_http_requests_in_flight",
+		Help: "HTTP requests being served.",
+	})
+
+	clientRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "wili_client_request_duration_seconds",
+		Help:    "Time of calls to other services and APIs, by target, operation and outcome.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"target", "operation", "outcome"})
+)
+
+// Metrics records rate, errors and duration of every request per route
+// pattern, so that /wishlists/{wishlistId} is one series rather than one per
+// wishlist. Requests no route matched share the route "unmatched".
+func Metrics(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		httpRequestsInFlight.Inc()
+		defer httpRequestsInFlight.Dec()
+
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+
+		route := Route(r)
+		httpRequestsTotal.WithLabelValues(r.Method, route, strconv.Itoa(rec.status)).Inc()
+		httpRequestDuration.WithLabelValues(r.Method, route).Observe(time.Since(start).Seconds())
+	})
+}
+
+// ObserveClientCall records a call to another service or API that started at
+// start. Outcome is "ok", "error" for calls that failed, or a reason the
+// caller wants to tell apart from both, such as "rejected".
+func ObserveClientCall(target, operation, outcome string, start time.Time) {
+	clientRequestDuration.WithLabelValues(target, operation, outcome).Observe(time.Since(start).Seconds())
+}
+
+// RegisterDBStats exports the connection pool statistics of db as the go_sql_*
+// metrics labelled with name
+func RegisterDBStats(db *sql.DB, name string) {
+	prometheus.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+// MetricsHandler serves every registered metric, together with Go runtime and
+// process metrics, in the Prometheus text format
+func MetricsHandler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ServeMetrics serves /metrics on a listener of its own, which keeps it off
+// the public API. It returns once the listener fails.
+func ServeMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", MetricsHandler())
+	slog.Info("metrics listening", "addr", addr)
+	if err := http.ListenAndServe(addr, mux); err != nil {
+		slog.Error("metrics listener stopped", "addr", addr, "error", err)
+	}
+}
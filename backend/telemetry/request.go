@@ -0,0 +1,206 @@
+package telemetry
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// RequestIDHeader carries the request ID between clients and services
+const RequestIDHeader = "X-Request-ID"
+
+const maxRequestIDLength = 128
+
+type requestInfoKey struct{}
+
+// requestInfo is what logs of a request have in common. The user ID is only
+// known once a handler authenticated the caller.
+type requestInfo struct {
+	id string
+
+	mu     sync.Mutex
+	userID string
+}
+
+func (i *requestInfo) user() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.userID
+}
+
+func requestInfoFrom(ctx context.Context) *requestInfo {
+	if ctx == nil {
+		return nil
+	}
+	info, _ := ctx.Value(requestInfoKey{}).(*requestInfo)
+	return info
+}
+
+// WithRequestID returns a context logging and propagating the given request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, &requestInfo{id: id})
+}
+
+// RequestIDFrom returns the request ID of ctx, or ""
+func RequestIDFrom(ctx context.Context) string {
+	if info := requestInfoFrom(ctx); info != nil {
+		return info.id
+	}
+	return ""
+}
+
+// SetUserID records the authenticated user of the request ctx belongs to, so
+// that its later logs and its access log carry the user ID
+func SetUserID(ctx context.Context, userID string) {
+	if info := requestInfoFrom(ctx); info != nil {
+		info.mu.Lock()
+		info.userID = userID
+		info.mu.Unlock()
+	}
+}
+
+// NewRequestID returns a random request ID
+func NewRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// validRequestID accepts IDs of other services and proxies, but nothing that
+// could forge log lines or bloat them
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_' || c == '.' || c == ':') {
+			return false
+		}
+	}
+	return true
+}
+
+// RequestID takes the request ID from the X-Request-ID header or makes one,
+// stores it in the request context and echoes it in the response
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = NewRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+		next.ServeHTTP(w, r.WithContext(WithRequestID(r.Context(), id)))
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+// Flush keeps streaming responses such as server-sent events working
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		if r.status == 0 {
+			r.status = http.StatusOK
+		}
+		f.Flush()
+	}
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Route returns the route pattern a request matched, such as
+// /wishlists/{wishlistId}, or "unmatched"
+func Route(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	if r.Pattern != "" {
+		return r.Pattern
+	}
+	return "unmatched"
+}
+
+// AccessLog logs every request once it is served: route, status, latency and,
+// through the request context, request and user IDs. It goes after RequestID.
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+
+		logLevel := slog.LevelInfo
+		if rec.status >= http.StatusInternalServerError {
+			logLevel = slog.LevelError
+		}
+		slog.Log(r.Context(), logLevel, "request",
+			"method", r.Method,
+			"route", Route(r),
+			"path", r.URL.Path,
+			"status", rec.status,
+			"latency_ms", float64(time.Since(start).Microseconds())/1000,
+			"bytes", rec.bytes,
+			"remote", r.RemoteAddr,
+		)
+	})
+}
+
+// Transport traces each outgoing request, passing the trace context on in the
+// traceparent header, and forwards the request ID of the request's context in
+// the X-Request-ID header
+type Transport struct {
+	Base http.RoundTripper
+
+	once   sync.Once
+	traced http.RoundTripper
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.once.Do(func() {
+		base := t.Base
+		if base == nil {
+			base = http.DefaultTransport
+		}
+		t.traced = otelhttp.NewTransport(base)
+	})
+	base := t.traced
+	id := RequestIDFrom(req.Context())
+	if id == "" || req.Header.Get(RequestIDHeader) != "" {
+		return base.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(RequestIDHeader, id)
+	return base.RoundTrip(req)
+}
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+)
+
+// NewServer returns a server for handler with timeouts from the environment:
+// HTTP_READ_HEADER_TIMEOUT (default 10s), HTTP_READ_TIMEOUT (30s),
+// HTTP_WRITE_TIMEOUT (60s) and HTTP_IDLE_TIMEOUT (120s). Handlers that stream
+// lift the write deadline with http.ResponseController.
+func NewServer(addr string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadHeaderTimeout: durationEnv("HTTP_READ_HEADER_TIMEOUT", 10*time.Second),
+		ReadTimeout:       durationEnv("HTTP_READ_TIMEOUT", 30*time.Second),
+		WriteTimeout:      durationEnv("HTTP_WRITE_TIMEOUT", 60*time.Second),
+		IdleTimeout:       durationEnv("HTTP_IDLE_TIMEOUT", 120*time.Second),
+	}
+}
+
+// Serve runs srv until ctx is done, then shuts it down gracefully: probes
+// report draining, and after SHUTDOWN_DELAY (default 0, for load balancers
+// that notice late) the server stops accepting connections and waits up to
+// SHUTDOWN_TIMEOUT (default 20s) for in-flight requests before closing the
+// rest. It returns an error when the server fails to listen or to drain.
+func Serve(ctx context.Context, srv *http.Server, probes *Probes) error {
+	delay := durationEnv("SHUTDOWN_DELAY", 0)
+	timeout := durationEnv("SHUTDOWN_TIMEOUT", 20*time.Second)
+
+	served := make(chan error, 1)
+	go func() {
+		served <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down HTTP server", "delay", delay.String(), "timeout", timeout.String())
+	probes.Drain()
+	time.Sleep(delay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		srv.Close()
+		return err
+	}
+	if err := <-served; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	slog.Info("HTTP server stopped")
+	return nil
+}
+
+// durationEnv reads a duration, falling back to defaultValue when it is unset
+// or invalid
+func durationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		slog.Error("invalid duration, using default", "key", key, "value", value, "default", defaultValue.String())
+		return defaultValue
+	}
+	return d
+}
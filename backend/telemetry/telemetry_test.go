@@ -0,0 +1,374 @@
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(contextHandler{slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: level})}))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	return &buf
+}
+
+func lastRecord(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	t.Helper()
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	var record map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &record); err != nil {
+		t.Fatalf("Expected a JSON record, got %q", buf.String())
+	}
+	return record
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{"propagated", "abc-123", true},
+		{"missing", "", false},
+		{"forged", "bad id\n{\"level\":\"ERROR\"}", false},
+		{"too long", strings.Repeat("a", maxRequestIDLength+1), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen string
+			handler := RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				seen = RequestIDFrom(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incoming != "" {
+				req.Header.Set(RequestIDHeader, tt.incoming)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if seen == "" || rec.Header().Get(RequestIDHeader) != seen {
+				t.Errorf("Expected the request ID %q in the response, got %q", seen, rec.Header().Get(RequestIDHeader))
+			}
+			if (seen == tt.incoming) != tt.keep {
+				t.Errorf("Expected keep=%v for %q, got %q", tt.keep, tt.incoming, seen)
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	buf := captureLogs(t)
+
+	r := chi.NewRouter()
+	r.Use(RequestID, AccessLog)
+	r.Get("/wishlists/{wishlistId}", func(w http.ResponseWriter, r *http.Request) {
+		SetUserID(r.Context(), "user-1")
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/wishlists/42", nil)
+	req.Header.Set(RequestIDHeader, "req-1")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	record := lastRecord(t, buf)
+	expected := map[string]interface{}{
+		"msg":        "request",
+		"route":      "/wishlists/{wishlistId}",
+		"status":     float64(http.StatusNotFound),
+		"request_id": "req-1",
+		"user_id":    "user-1",
+	}
+	for key, value := range expected {
+		if record[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, record[key])
+		}
+	}
+	if _, ok := record["latency_ms"]; !ok {
+		t.Error("Expected latency_ms")
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	r := chi.NewRouter()
+	r.Use(Metrics)
+	r.Get("/wishlists/{wishlistId}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	r.Handle("/metrics", MetricsHandler())
+
+	matched := httpRequestsTotal.WithLabelValues(http.MethodGet, "/wishlists/{wishlistId}", "404")
+	unmatched := httpRequestsTotal.WithLabelValues(http.MethodGet, "unmatched", "404")
+	matchedBefore, unmatchedBefore := testutil.ToFloat64(matched), testutil.ToFloat64(unmatched)
+
+	for _, id := range []string{"1", "2"} {
+		r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/wishlists/"+id, nil))
+	}
+	r.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	if got := testutil.ToFloat64(matched) - matchedBefore; got != 2 {
+		t.Errorf("Expected 2 requests on the route, got %v", got)
+	}
+	if got := testutil.ToFloat64(unmatched) - unmatchedBefore; got != 1 {
+		t.Errorf("Expected 1 unmatched request, got %v", got)
+	}
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body := rec.Body.String()
+	if !strings.Contains(body, `wili_http_request_duration_seconds_count{method="GET",route="/wishlists/{wishlistId}"}`) {
+		t.Error("Expected request durations per route")
+	}
+	if strings.Contains(body, "/wishlists/1") {
+		t.Error("Expected no series per wishlist ID")
+	}
+}
+
+func TestContextHandlerKeepsExplicitUserID(t *testing.T) {
+	buf := captureLogs(t)
+	ctx := WithRequestID(context.Background(), "req-1")
+	SetUserID(ctx, "user-1")
+
+	slog.InfoContext(ctx, "booked", "user_id", "user-2")
+	record := lastRecord(t, buf)
+	if record["user_id"] != "user-2" || record["request_id"] != "req-1" {
+		t.Errorf("Unexpected record: %v", record)
+	}
+	if strings.Count(buf.String(), "user_id") != 1 {
+		t.Errorf("Expected one user_id, got %q", buf.String())
+	}
+}
+
+func TestTransport(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get(RequestIDHeader)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &Transport{}}
+	req, _ := http.NewRequestWithContext(WithRequestID(context.Background(), "req-1"), http.MethodGet, server.URL, nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if got != "req-1" {
+		t.Errorf("Expected the request ID to be forwarded, got %q", got)
+	}
+}
+
+func TestTracing(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := InstallTracing("test", sdktrace.NewSimpleSpanProcessor(exporter))
+	defer provider.Shutdown(context.Background())
+	buf := captureLogs(t)
+
+	var forwarded string
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		forwarded = r.Header.Get("traceparent")
+	}))
+	defer upstream.Close()
+	client := &http.Client{Transport: &Transport{}}
+
+	r := chi.NewRouter()
+	r.Use(RequestID, Tracing)
+	r.Get("/wishlists/{wishlistId}", func(w http.ResponseWriter, r *http.Request) {
+		slog.InfoContext(r.Context(), "handling")
+		req, _ := http.NewRequestWithContext(r.Context(), http.MethodGet, upstream.URL, nil)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	})
+
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req := httptest.NewRequest(http.MethodGet, "/wishlists/42", nil)
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	r.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected a server and a client span, got %d", len(spans))
+	}
+	server, call := spans[1], spans[0]
+	if server.Name != "GET /wishlists/{wishlistId}" {
+		t.Errorf("Expected the server span to be named after the route, got %q", server.Name)
+	}
+	if server.SpanContext.TraceID().String() != traceID {
+		t.Errorf("Expected the incoming trace to continue, got trace %s", server.SpanContext.TraceID())
+	}
+	if call.Parent.SpanID() != server.SpanContext.SpanID() {
+		t.Error("Expected the client span to be a child of the server span")
+	}
+	if !strings.Contains(forwarded, traceID) || !strings.Contains(forwarded, call.SpanContext.SpanID().String()) {
+		t.Errorf("Expected traceparent of the client span to be forwarded, got %q", forwarded)
+	}
+	if record := lastRecord(t, buf); record["trace_id"] != traceID {
+		t.Errorf("Expected logs to carry the trace ID, got %v", record["trace_id"])
+	}
+}
+
+func TestTracingServeMux(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	provider := InstallTracing("test", sdktrace.NewSimpleSpanProcessor(exporter))
+	defer provider.Shutdown(context.Background())
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/internal/notify", func(w http.ResponseWriter, r *http.Request) {})
+	handler := RequestID(Tracing(AccessLog(Metrics(mux))))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/internal/notify", nil))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected one span without the health check, got %d", len(spans))
+	}
+	if spans[0].Name != "POST /internal/notify" {
+		t.Errorf("Expected the span to be named after the pattern, got %q", spans[0].Name)
+	}
+}
+
+func TestLevelHandler(t *testing.T) {
+	defer level.Set(slog.LevelInfo)
+	handler := LevelHandler("secret")
+
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		body   string
+		status int
+	}{
+		{"no token", http.MethodGet, "", "", http.StatusForbidden},
+		{"wrong token", http.MethodPut, "nope", `{"level":"debug"}`, http.StatusForbidden},
+		{"invalid level", http.MethodPut, "secret", `{"level":"loud"}`, http.StatusBadRequest},
+		{"set", http.MethodPut, "secret", `{"level":"debug"}`, http.StatusOK},
+		{"get", http.MethodGet, "secret", "", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, "/debug/log-level", strings.NewReader(tt.body))
+		if tt.token != "" {
+			req.Header.Set("X-Wili-Service-Token", tt.token)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != tt.status {
+			t.Errorf("%s: expected status %d, got %d", tt.name, tt.status, rec.Code)
+		}
+	}
+	if level.Level() != slog.LevelDebug {
+		t.Errorf("Expected debug level, got %v", level.Level())
+	}
+}
+
+func TestProbes(t *testing.T) {
+	probes := NewProbes()
+	var dbErr error
+	probes.AddCheck("mongodb", func(ctx context.Context) error { return dbErr })
+	probes.AddCheck("user-service", func(ctx context.Context) error { return nil })
+
+	probe := func(handler http.Handler) (int, probeResponse) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		var body probeResponse
+		json.NewDecoder(rec.Body).Decode(&body)
+		return rec.Code, body
+	}
+
+	if status, body := probe(probes.Ready()); status != http.StatusOK || body.Checks["mongodb"] != "ok" {
+		t.Errorf("Expected ready, got %d %+v", status, body)
+	}
+
+	dbErr = errors.New("server selection timeout")
+	if status, body := probe(probes.Ready()); status != http.StatusServiceUnavailable || body.Checks["mongodb"] != "server selection timeout" || body.Checks["user-service"] != "ok" {
+		t.Errorf("Expected unavailable with the failing check, got %d %+v", status, body)
+	}
+	if status, _ := probe(probes.Live()); status != http.StatusOK {
+		t.Errorf("Expected liveness to ignore dependencies, got %d", status)
+	}
+
+	dbErr = nil
+	probes.Drain()
+	if status, body := probe(probes.Ready()); status != http.StatusServiceUnavailable || body.Status != "draining" {
+		t.Errorf("Expected draining, got %d %+v", status, body)
+	}
+}
+
+func TestServeDrainsInFlightRequests(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	addr := listener.Addr().String()
+	listener.Close()
+
+	started, release := make(chan struct{}), make(chan struct{})
+	srv := NewServer(addr, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		w.Write([]byte("done"))
+	}))
+
+	ctx, stop := context.WithCancel(context.Background())
+	probes := NewProbes()
+	served := make(chan error, 1)
+	go func() { served <- Serve(ctx, srv, probes) }()
+
+	var resp *http.Response
+	requested := make(chan error, 1)
+	go func() {
+		var err error
+		for i := 0; i < 50; i++ {
+			if resp, err = http.Get("http://" + addr); err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		requested <- err
+	}()
+
+	<-started
+	stop()
+	time.Sleep(50 * time.Millisecond)
+	select {
+	case err := <-served:
+		t.Fatalf("Expected Serve to wait for the request, returned %v", err)
+	default:
+	}
+
+	close(release)
+	if err := <-requested; err != nil {
+		t.Fatalf("Expected the in-flight request to complete, got %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != "done" {
+		t.Errorf("Expected the full response, got %q", body)
+	}
+	if err := <-served; err != nil {
+		t.Errorf("Expected a clean shutdown, got %v", err)
+	}
+	if !probes.draining.Load() {
+		t.Error("Expected readiness to report draining")
+	}
+}
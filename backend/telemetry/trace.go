@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation scope of spans started with StartSpan
+const tracerName = "github.com/theseems/wili/backend/telemetry"
+
+// SetupTracing installs the W3C trace context propagator and a tracer
+// provider for service. Spans are exported over OTLP/HTTP when
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set;
+// the other OTEL_EXPORTER_OTLP_* and OTEL_TRACES_SAMPLER* variables of the
+// OpenTelemetry SDK apply as usual. Without an endpoint nothing is recorded,
+// but trace context of incoming requests is still passed on. The returned
+// function flushes pending spans on shutdown.
+func SetupTracing(ctx context.Context, service string) (func(context.Context) error, error) {
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		setPropagator()
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	provider := InstallTracing(service, sdktrace.NewBatchSpanProcessor(exporter))
+	return provider.Shutdown, nil
+}
+
+// InstallTracing makes a tracer provider handing the spans of service to
+// processor the global one. Tests pass a simple span processor around an
+// in-memory exporter (tracetest.NewInMemoryExporter) to inspect spans.
+func InstallTracing(service string, processor sdktrace.SpanProcessor) *sdktrace.TracerProvider {
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", service)))
+	if err != nil {
+		res = resource.Default()
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	setPropagator()
+	return provider
+}
+
+func setPropagator() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+var untracedPaths = map[string]bool{"/health": true, "/livez": true, "/readyz": true, "/metrics": true}
+
+// Tracing continues the trace of an incoming request, or starts one, and
+// names its span after the route pattern the request matched, such as
+// GET /wishlists/{wishlistId}. Probes and metric scrapes are not traced.
+// Behind an http.ServeMux, handlers between Tracing and the mux must pass the
+// request on as is, since the mux records the matched pattern in it.
+func Tracing(next http.Handler) http.Handler {
+	named := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+		route := Route(r)
+		span := trace.SpanFromContext(r.Context())
+		span.SetName(r.Method + " " + route)
+		span.SetAttributes(attribute.String("http.route", route))
+	})
+	return otelhttp.NewHandler(named, "http.server",
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + Route(r)
+		}),
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return !untracedPaths[r.URL.Path]
+		}),
+	)
+}
+
+// StartClientSpan starts a span for a call that does not go through
+// Transport, such as one to an API whose URLs hold secrets that must stay out
+// of traces
+func StartClientSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attrs...))
+}
+
+// EndSpan marks span as failed when err is not nil and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -1,6 +1,6 @@
 import { browser } from "$app/environment";
 import { env } from "$env/dynamic/public";
-import { logout } from "$lib/auth";
+import { freshToken, hasRefreshToken, logout, refreshAccessToken } from "$lib/auth";
 import type { components } from "$lib/api/generated/users-api";
 
 type User = components["schemas"]["User"];
@@ -24,9 +24,11 @@ export class UserApiClient {
   private async request<T>(
     endpoint: string,
     options: RequestInit = {},
-    token?: string
+    token?: string,
+    retry: boolean = true
   ): Promise<T> {
     const url = `${this.baseUrl}${endpoint}`;
+    token = await freshToken(token);
 
     const headers: Record<string, string> = {
       "Content-Type": "application/json",
@@ -43,7 +45,13 @@ export class UserApiClient {
     });
 
     if (!response.ok) {
-      if (response.status === 401 && browser) {
+      if (response.status === 401 && browser && token && retry) {
+        const refreshed = await refreshAccessToken(token);
+        if (refreshed) {
+          return this.request(endpoint, options, refreshed, false);
+        }
+      }
+      if (response.status === 401 && browser && !hasRefreshToken()) {
         logout();
       }
       throw new Error(`API request failed: ${response.status} ${response.statusText}`);
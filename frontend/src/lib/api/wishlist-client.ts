@@ -1,5 +1,5 @@
 import { env } from "$env/dynamic/public";
-import { logout } from "$lib/auth";
+import { freshToken, hasRefreshToken, logout, refreshAccessToken } from "$lib/auth";
 import type { components } from "$lib/api/generated/wishlist-api";
 
 type Wishlist = components["schemas"]["Wishlist"];
@@ -30,9 +30,11 @@ export class WishlistApiClient {
     endpoint: string,
     options: RequestInit = {},
     token?: string,
-    expectJson: boolean = true
+    expectJson: boolean = true,
+    retry: boolean = true
   ): Promise<T> {
     const url = `${this.baseUrl}${endpoint}`;
+    token = await freshToken(token);
 
     const headers: Record<string, string> = {
       "Content-Type": "application/json",
@@ -49,7 +51,13 @@ export class WishlistApiClient {
     });
 
     if (!response.ok) {
-      if (response.status === 401) {
+      if (response.status === 401 && token && retry) {
+        const refreshed = await refreshAccessToken(token);
+        if (refreshed) {
+          return this.request(endpoint, options, refreshed, expectJson, false);
+        }
+      }
+      if (response.status === 401 && !hasRefreshToken()) {
         logout();
       }
       throw new Error(`API request failed: ${response.status} ${response.statusText}`, {
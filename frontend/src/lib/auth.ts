@@ -4,16 +4,22 @@ import { authStore } from "./stores/auth";
 import type { components } from "$lib/api/generated/users-api";
 
 export const TOKEN_KEY = "wili_jwt";
+export const REFRESH_TOKEN_KEY = "wili_refresh_token";
 export const USER_KEY = "wili_user";
 export const JUST_LOGGED_IN_KEY = "wili_just_logged_in";
 
+// Access tokens are refreshed when they expire within this margin
+const REFRESH_MARGIN_MS = 60_000;
+
 const AUTH_API_BASE_URL =
   env.PUBLIC_AUTH_API_BASE_URL ??
   env.PUBLIC_API_BASE_URL ??
   (import.meta as any).env?.VITE_AUTH_API_BASE_URL ??
   "https://api.wili.me";
 
-type AuthResponse = components["schemas"]["AuthResponse"];
+// refreshToken is left out for sessions that end with their access token,
+// such as web logins sent by the Telegram bot
+type AuthResponse = components["schemas"]["AuthResponse"] & { refreshToken?: string };
 
 let storageListenerSet = false;
 
@@ -84,6 +90,7 @@ export function handleTelegramCallback(token: string, state: string): boolean {
   localStorage.removeItem(TELEGRAM_AUTH_STATE_KEY);
 
   localStorage.setItem(TOKEN_KEY, token);
+  localStorage.removeItem(REFRESH_TOKEN_KEY);
   localStorage.setItem(JUST_LOGGED_IN_KEY, "true");
 
   fetch(`${AUTH_API_BASE_URL}/users/me`, {
@@ -114,7 +121,7 @@ export async function exchangeCode(code: string) {
 
   const token = resp.accessToken;
   if (browser) {
-    localStorage.setItem(TOKEN_KEY, token);
+    storeTokens(resp);
     localStorage.setItem(USER_KEY, JSON.stringify(resp.user));
     localStorage.setItem(JUST_LOGGED_IN_KEY, "true");
     authStore.set({
@@ -138,7 +145,7 @@ export async function exchangeTelegramInitData(initData: string) {
 
   const token = resp.accessToken;
   if (browser) {
-    localStorage.setItem(TOKEN_KEY, token);
+    storeTokens(resp);
     localStorage.setItem(USER_KEY, JSON.stringify(resp.user));
     localStorage.setItem(JUST_LOGGED_IN_KEY, "true");
     authStore.set({
@@ -150,11 +157,116 @@ export async function exchangeTelegramInitData(initData: string) {
   }
 }
 
+function storeTokens(resp: AuthResponse) {
+  localStorage.setItem(TOKEN_KEY, resp.accessToken);
+  if (resp.refreshToken) {
+    localStorage.setItem(REFRESH_TOKEN_KEY, resp.refreshToken);
+  } else {
+    localStorage.removeItem(REFRESH_TOKEN_KEY);
+  }
+}
+
+function clearSession() {
+  localStorage.removeItem(TOKEN_KEY);
+  localStorage.removeItem(REFRESH_TOKEN_KEY);
+  localStorage.removeItem(USER_KEY);
+  localStorage.removeItem(JUST_LOGGED_IN_KEY);
+}
+
+// tokenExpiresAt reads the exp claim of a JWT, in milliseconds
+function tokenExpiresAt(token: string): number | undefined {
+  try {
+    const payload = token.split(".")[1].replace(/-/g, "+").replace(/_/g, "/");
+    const exp = JSON.parse(atob(payload)).exp;
+    return typeof exp === "number" ? exp * 1000 : undefined;
+  } catch {
+    return undefined;
+  }
+}
+
+function expiresSoon(token: string): boolean {
+  const expiresAt = tokenExpiresAt(token);
+  return expiresAt !== undefined && expiresAt - Date.now() < REFRESH_MARGIN_MS;
+}
+
+export function hasRefreshToken(): boolean {
+  return browser && localStorage.getItem(REFRESH_TOKEN_KEY) !== null;
+}
+
+let refreshing: Promise<string | undefined> | null = null;
+
+// refreshAccessToken exchanges the refresh token for new tokens and returns
+// the new access token, or undefined when there is none. staleToken is the
+// access token the caller has; when another tab already replaced it, that
+// tab's token is used, since exchanging a refresh token twice revokes the
+// session. A rejected refresh token ends the session.
+export function refreshAccessToken(staleToken?: string): Promise<string | undefined> {
+  if (!browser) return Promise.resolve(undefined);
+  if (!refreshing) {
+    const run = () => doRefresh(staleToken);
+    const locked =
+      typeof navigator !== "undefined" && navigator.locks
+        ? navigator.locks.request("wili_refresh", run)
+        : run();
+    refreshing = locked.finally(() => {
+      refreshing = null;
+    });
+  }
+  return refreshing;
+}
+
+async function doRefresh(staleToken?: string): Promise<string | undefined> {
+  const current = localStorage.getItem(TOKEN_KEY);
+  if (current && current !== staleToken && !expiresSoon(current)) {
+    authStore.update((state) => ({ ...state, token: current }));
+    return current;
+  }
+  const refreshToken = localStorage.getItem(REFRESH_TOKEN_KEY);
+  if (!refreshToken) return undefined;
+
+  let res: Response;
+  try {
+    res = await fetch(`${AUTH_API_BASE_URL}/auth/refresh`, {
+      method: "POST",
+      headers: { "Content-Type": "application/json" },
+      body: JSON.stringify({ refreshToken }),
+    });
+  } catch {
+    return undefined;
+  }
+  if (!res.ok) {
+    if (res.status === 401) {
+      clearSession();
+      authStore.set({ token: undefined, user: null, isLoading: false, justLoggedIn: false });
+    }
+    return undefined;
+  }
+
+  const resp = (await res.json()) as AuthResponse;
+  storeTokens(resp);
+  localStorage.setItem(USER_KEY, JSON.stringify(resp.user));
+  authStore.update((state) => ({ ...state, token: resp.accessToken, user: resp.user }));
+  return resp.accessToken;
+}
+
+// freshToken returns token, refreshed first when it is about to expire
+export async function freshToken(token?: string): Promise<string | undefined> {
+  if (!browser || !token || !expiresSoon(token)) return token;
+  return (await refreshAccessToken(token)) ?? token;
+}
+
 export function logout() {
   if (browser) {
-    localStorage.removeItem(TOKEN_KEY);
-    localStorage.removeItem(USER_KEY);
-    localStorage.removeItem(JUST_LOGGED_IN_KEY);
+    const refreshToken = localStorage.getItem(REFRESH_TOKEN_KEY);
+    if (refreshToken) {
+      fetch(`${AUTH_API_BASE_URL}/auth/logout`, {
+        method: "POST",
+        headers: { "Content-Type": "application/json" },
+        body: JSON.stringify({ refreshToken }),
+        keepalive: true,
+      }).catch(() => {});
+    }
+    clearSession();
   }
   authStore.set({ token: undefined, user: null, isLoading: false, justLoggedIn: false });
 }
@@ -118,6 +118,7 @@ deploy_k8s() {
     echo "Waiting for databases to be ready..."
     kubectl wait --for=condition=available --timeout=300s deployment/postgres -n wili
     kubectl wait --for=condition=available --timeout=300s deployment/mongodb -n wili
+    kubectl wait --for=condition=complete --timeout=300s job/mongodb-rs-init -n wili
     
     # Deploy services
     kubectl apply -f k8s/user-service.yaml
@@ -80,6 +80,7 @@ kubectl apply -f $TEMP_DIR/mongodb.yaml
 # Wait for databases to be ready
 kubectl wait --for=condition=available --timeout=300s deployment/postgres -n wili
 kubectl wait --for=condition=available --timeout=300s deployment/mongodb -n wili
+kubectl wait --for=condition=complete --timeout=300s job/mongodb-rs-init -n wili
 
 # Apply services
 kubectl apply -f $TEMP_DIR/user-service.yaml